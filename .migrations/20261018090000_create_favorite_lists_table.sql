@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+    CREATE TABLE favorite_lists (
+        id UUID PRIMARY KEY,
+        client_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+        name VARCHAR(100) NOT NULL,
+        created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+        updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+    );
+
+CREATE INDEX IF NOT EXISTS idx_favorite_lists_client_id ON favorite_lists (client_id);
+
+    CREATE TABLE favorite_list_items (
+        list_id UUID NOT NULL REFERENCES favorite_lists(id) ON DELETE CASCADE,
+        product_id INT NOT NULL,
+        added_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+        UNIQUE (list_id, product_id)
+    );
+
+CREATE INDEX IF NOT EXISTS idx_favorite_list_items_list_id ON favorite_list_items (list_id);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+    DROP INDEX IF EXISTS idx_favorite_list_items_list_id;
+    DROP TABLE IF EXISTS favorite_list_items;
+    DROP INDEX IF EXISTS idx_favorite_lists_client_id;
+    DROP TABLE IF EXISTS favorite_lists;
+-- +goose StatementEnd
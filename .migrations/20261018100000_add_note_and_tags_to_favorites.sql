@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+    ALTER TABLE favorites
+        ADD COLUMN note TEXT NOT NULL DEFAULT '',
+        ADD COLUMN tags TEXT[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX IF NOT EXISTS idx_favorites_tags ON favorites USING GIN (tags);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+    DROP INDEX IF EXISTS idx_favorites_tags;
+    ALTER TABLE favorites
+        DROP COLUMN IF EXISTS note,
+        DROP COLUMN IF EXISTS tags;
+-- +goose StatementEnd
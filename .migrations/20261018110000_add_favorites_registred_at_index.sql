@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+
+CREATE INDEX IF NOT EXISTS idx_favorites_client_registred_at ON favorites (client_id, registred_at DESC, product_id DESC);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+    DROP INDEX IF EXISTS idx_favorites_client_registred_at;
+-- +goose StatementEnd
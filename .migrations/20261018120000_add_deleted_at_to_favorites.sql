@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+    ALTER TABLE favorites ADD COLUMN deleted_at TIMESTAMPTZ NULL;
+
+CREATE INDEX IF NOT EXISTS idx_favorites_deleted_at ON favorites (deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+    DELETE FROM favorites WHERE deleted_at IS NOT NULL;
+    DROP INDEX IF EXISTS idx_favorites_deleted_at;
+    ALTER TABLE favorites DROP COLUMN IF EXISTS deleted_at;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+    ALTER TABLE favorites
+        ADD COLUMN price_when_favorited NUMERIC(12, 2) NULL,
+        ADD COLUMN title_when_favorited TEXT NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+    ALTER TABLE favorites
+        DROP COLUMN IF EXISTS price_when_favorited,
+        DROP COLUMN IF EXISTS title_when_favorited;
+-- +goose StatementEnd
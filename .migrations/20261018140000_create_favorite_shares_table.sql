@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+    CREATE TABLE favorite_shares (
+        token VARCHAR(64) PRIMARY KEY,
+        client_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+        created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+        expires_at TIMESTAMPTZ NULL,
+        revoked_at TIMESTAMPTZ NULL
+    );
+
+CREATE INDEX IF NOT EXISTS idx_favorite_shares_client_id ON favorite_shares (client_id);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+    DROP INDEX IF EXISTS idx_favorite_shares_client_id;
+    DROP TABLE IF EXISTS favorite_shares;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+    CREATE TABLE favorite_quotas (
+        client_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+        max_favorites INT NOT NULL CHECK (max_favorites >= 0),
+        updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+    );
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+    DROP TABLE IF EXISTS favorite_quotas;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+    CREATE TABLE outbox_events (
+        seq BIGSERIAL UNIQUE,
+        id UUID PRIMARY KEY,
+        type TEXT NOT NULL,
+        aggregate_id TEXT NOT NULL,
+        payload JSONB NOT NULL,
+        occurred_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+        published_at TIMESTAMPTZ
+    );
+
+CREATE INDEX IF NOT EXISTS idx_outbox_events_pending ON outbox_events (seq) WHERE published_at IS NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+    DROP INDEX IF EXISTS idx_outbox_events_pending;
+    DROP TABLE IF EXISTS outbox_events;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+    ALTER TABLE outbox_events ADD COLUMN actor_id UUID;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+    ALTER TABLE outbox_events DROP COLUMN IF EXISTS actor_id;
+-- +goose StatementEnd
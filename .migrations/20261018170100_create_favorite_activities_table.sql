@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+    CREATE TABLE favorite_activities (
+        id BIGSERIAL PRIMARY KEY,
+        client_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+        product_id INTEGER NOT NULL,
+        product_title TEXT NOT NULL DEFAULT '',
+        action VARCHAR(20) NOT NULL,
+        actor_id UUID NOT NULL,
+        occurred_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+    );
+
+CREATE INDEX IF NOT EXISTS idx_favorite_activities_client ON favorite_activities (client_id, id DESC);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+    DROP INDEX IF EXISTS idx_favorite_activities_client;
+    DROP TABLE IF EXISTS favorite_activities;
+-- +goose StatementEnd
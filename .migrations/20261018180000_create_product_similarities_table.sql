@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+    CREATE TABLE product_similarities (
+        product_id INTEGER NOT NULL,
+        similar_product_id INTEGER NOT NULL,
+        score DOUBLE PRECISION NOT NULL,
+        PRIMARY KEY (product_id, similar_product_id)
+    );
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+    DROP TABLE IF EXISTS product_similarities;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+    CREATE TABLE archived_favorites (
+        client_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+        product_id INT NOT NULL,
+        note TEXT NOT NULL DEFAULT '',
+        tags TEXT[] NOT NULL DEFAULT '{}',
+        price_when_favorited NUMERIC(12, 2) NULL,
+        title_when_favorited TEXT NOT NULL DEFAULT '',
+        registred_at TIMESTAMPTZ NOT NULL,
+        archived_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+        UNIQUE (client_id, product_id)
+    );
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+    DROP TABLE IF EXISTS archived_favorites;
+-- +goose StatementEnd
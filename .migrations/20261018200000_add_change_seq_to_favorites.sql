@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+    CREATE TABLE favorite_change_seqs (
+        client_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+        last_seq BIGINT NOT NULL
+    );
+
+    ALTER TABLE favorites
+        ADD COLUMN change_seq BIGINT NOT NULL DEFAULT 0;
+
+    UPDATE favorites SET change_seq = 1;
+
+    INSERT INTO favorite_change_seqs (client_id, last_seq)
+    SELECT DISTINCT client_id, 1 FROM favorites;
+
+    CREATE TABLE favorite_tombstones (
+        client_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+        product_id INT NOT NULL,
+        change_seq BIGINT NOT NULL,
+        removed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+        UNIQUE (client_id, product_id)
+    );
+
+CREATE INDEX IF NOT EXISTS idx_favorites_client_change_seq ON favorites (client_id, change_seq, product_id);
+
+CREATE INDEX IF NOT EXISTS idx_favorite_tombstones_client_change_seq ON favorite_tombstones (client_id, change_seq, product_id);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+    DROP INDEX IF EXISTS idx_favorite_tombstones_client_change_seq;
+    DROP INDEX IF EXISTS idx_favorites_client_change_seq;
+    DROP TABLE IF EXISTS favorite_tombstones;
+    ALTER TABLE favorites DROP COLUMN IF EXISTS change_seq;
+    DROP TABLE IF EXISTS favorite_change_seqs;
+-- +goose StatementEnd
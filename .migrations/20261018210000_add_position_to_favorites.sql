@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+    ALTER TABLE favorites
+        ADD COLUMN position BIGINT NOT NULL DEFAULT 0;
+
+    UPDATE favorites f
+        SET position = ranked.rank * 1024
+    FROM (
+        SELECT
+            client_id, product_id,
+            ROW_NUMBER() OVER (PARTITION BY client_id ORDER BY registred_at DESC, product_id DESC) AS rank
+        FROM favorites
+    ) ranked
+    WHERE f.client_id = ranked.client_id AND f.product_id = ranked.product_id;
+
+CREATE INDEX IF NOT EXISTS idx_favorites_client_position ON favorites (client_id, position, product_id);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+    DROP INDEX IF EXISTS idx_favorites_client_position;
+    ALTER TABLE favorites DROP COLUMN IF EXISTS position;
+-- +goose StatementEnd
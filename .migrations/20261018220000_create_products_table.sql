@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+    CREATE TABLE products (
+        id INTEGER PRIMARY KEY,
+        title TEXT NOT NULL,
+        price NUMERIC(12, 2) NOT NULL,
+        description TEXT NOT NULL DEFAULT '',
+        category TEXT NOT NULL DEFAULT '',
+        image_url TEXT NOT NULL DEFAULT '',
+        rating_rate REAL NOT NULL DEFAULT 0,
+        rating_count INTEGER NOT NULL DEFAULT 0,
+        synced_at TIMESTAMPTZ NOT NULL,
+        removed_at TIMESTAMPTZ
+    );
+
+    CREATE TABLE product_syncs (
+        id BIGSERIAL PRIMARY KEY,
+        started_at TIMESTAMPTZ NOT NULL,
+        finished_at TIMESTAMPTZ NOT NULL,
+        upserted INTEGER NOT NULL DEFAULT 0,
+        removed INTEGER NOT NULL DEFAULT 0,
+        error TEXT NOT NULL DEFAULT ''
+    );
+
+CREATE INDEX idx_product_syncs_started_at ON product_syncs (started_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+    DROP TABLE IF EXISTS product_syncs;
+    DROP TABLE IF EXISTS products;
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+    CREATE TABLE favorite_daily_activity (
+        day TIMESTAMPTZ PRIMARY KEY,
+        added INT NOT NULL DEFAULT 0,
+        removed INT NOT NULL DEFAULT 0
+    );
+
+    -- The history before the counters is only what is still on favorites, purged and restored removals are lost
+    INSERT INTO favorite_daily_activity (day, added, removed)
+    SELECT day, sum(added), sum(removed)
+    FROM (
+        SELECT date_trunc('day', registred_at) AS day, 1 AS added, 0 AS removed
+        FROM favorites
+        UNION ALL
+        SELECT date_trunc('day', deleted_at) AS day, 0 AS added, 1 AS removed
+        FROM favorites
+        WHERE deleted_at IS NOT NULL
+    ) activity
+    GROUP BY day;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+    DROP TABLE IF EXISTS favorite_daily_activity;
+-- +goose StatementEnd
@@ -6,6 +6,7 @@ import (
 	"github.com/uesleicarvalhoo/aiqfome/config"
 	"github.com/uesleicarvalhoo/aiqfome/internal/http"
 	"github.com/uesleicarvalhoo/aiqfome/internal/ioc"
+	"github.com/uesleicarvalhoo/aiqfome/internal/worker"
 	"github.com/uesleicarvalhoo/aiqfome/pkg/logger"
 	"github.com/uesleicarvalhoo/aiqfome/pkg/trace"
 )
@@ -32,10 +33,99 @@ func main() {
 	getClientFavoritesUc := ioc.GetClientFavoritesUseCase()
 	addProductToFavoritesUc := ioc.AddProductToFavoritesUseCase()
 	removeProductFromFavoritesUc := ioc.RemoveProductFromFavoritesUseCase()
+	updateFavoriteUc := ioc.UpdateFavoriteUseCase()
+	addProductsToFavoritesUc := ioc.AddProductsToFavoritesUseCase()
+	removeProductsFromFavoritesUc := ioc.RemoveProductsFromFavoritesUseCase()
+	getFavoritesTrashUc := ioc.GetFavoritesTrashUseCase()
+	restoreFavoriteUc := ioc.RestoreFavoriteUseCase()
+	getFavoritesStatsUc := ioc.GetFavoritesStatsUseCase()
+	exportClientFavoritesUc := ioc.ExportClientFavoritesUseCase()
+	importFavoritesUc := ioc.ImportFavoritesUseCase()
+	getImportJobUc := ioc.GetImportJobUseCase()
+	createFavoritesShareUc := ioc.CreateFavoritesShareUseCase()
+	getFavoritesSharesUc := ioc.GetFavoritesSharesUseCase()
+	revokeFavoritesShareUc := ioc.RevokeFavoritesShareUseCase()
+	getSharedFavoritesUc := ioc.GetSharedFavoritesUseCase()
+	getFavoritesQuotaUc := ioc.GetFavoritesQuotaUseCase()
+	setFavoritesQuotaUc := ioc.SetFavoritesQuotaUseCase()
+	removeFavoritesQuotaUc := ioc.RemoveFavoritesQuotaUseCase()
+	getFavoritesHistoryUc := ioc.GetFavoritesHistoryUseCase()
+	getRecommendationsUc := ioc.GetRecommendationsUseCase()
+	getFavoritesChangesUc := ioc.GetFavoritesChangesUseCase()
+	reorderFavoritesUc := ioc.ReorderFavoritesUseCase()
+	saveProductToFavoritesUc := ioc.SaveProductToFavoritesUseCase()
+	createFavoriteListUc := ioc.CreateFavoriteListUseCase()
+	getClientFavoriteListsUc := ioc.GetClientFavoriteListsUseCase()
+	getFavoriteListUc := ioc.GetFavoriteListUseCase()
+	renameFavoriteListUc := ioc.RenameFavoriteListUseCase()
+	deleteFavoriteListUc := ioc.DeleteFavoriteListUseCase()
+	addProductToListUc := ioc.AddProductToListUseCase()
+	removeProductFromListUc := ioc.RemoveProductFromListUseCase()
 	findClientsUc := ioc.FindClientUseCase()
 	listClientsUc := ioc.ListClientsUseCase()
 	updateClientUc := ioc.UpdateClientsUseCase()
 	deleteClientUc := ioc.DeleteClientUseCase()
+	getProductsSyncStatusUc := ioc.GetProductsSyncStatusUseCase()
+
+	purgeFavoritesTrashUc := ioc.PurgeFavoritesTrashUseCase()
+	refreshRecommendationsUc := ioc.RefreshRecommendationsUseCase()
+
+	workersCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+
+	go worker.NewPeriodic("favorites.purgeTrash", config.GetDuration("FAVORITES_TRASH_PURGE_INTERVAL"), func(ctx context.Context) error {
+		_, err := purgeFavoritesTrashUc.Execute(ctx)
+		return err
+	}).Run(workersCtx)
+
+	go worker.NewPeriodic("favorites.refreshRecommendations", config.GetDuration("FAVORITES_RECOMMENDATIONS_REFRESH_INTERVAL"), func(ctx context.Context) error {
+		_, err := refreshRecommendationsUc.Execute(ctx)
+		return err
+	}).Run(workersCtx)
+
+	// Archiving is opt-in, by default the favorites of products removed upstream are kept
+	if config.GetBool("FAVORITES_ARCHIVE_UNAVAILABLE_ENABLED") {
+		archiveUnavailableFavoritesUc := ioc.ArchiveUnavailableFavoritesUseCase()
+		go worker.NewPeriodic("favorites.archiveUnavailable", config.GetDuration("FAVORITES_ARCHIVE_UNAVAILABLE_INTERVAL"), func(ctx context.Context) error {
+			_, err := archiveUnavailableFavoritesUc.Execute(ctx)
+			return err
+		}).Run(workersCtx)
+	}
+
+	// The mirror is synced on start too, so a new one doesn't wait for the first tick to have products
+	if config.GetString("PRODUCTS_SOURCE") == ioc.ProductSourceMirror || config.GetBool("PRODUCTS_MIRROR_SYNC_ENABLED") {
+		syncProductsUc := ioc.SyncProductsUseCase()
+		syncProducts := func(ctx context.Context) error {
+			_, err := syncProductsUc.Execute(ctx)
+			return err
+		}
+
+		go func() {
+			// Failures are logged by the use case and the worker tries again on the next tick
+			_ = syncProducts(workersCtx)
+			worker.NewPeriodic("products.syncMirror", config.GetDuration("PRODUCTS_MIRROR_SYNC_INTERVAL"), syncProducts).Run(workersCtx)
+		}()
+	}
+
+	go worker.NewPeriodic("products.cacheStats", config.GetDuration("PRODUCTS_CACHE_STATS_INTERVAL"), func(ctx context.Context) error {
+		st := ioc.ProductCacheStats()
+		logger.InfoF(ctx, "products cache stats", logger.Fields{
+			"hits":   st.Hits,
+			"misses": st.Misses,
+			"stale":  st.Stale,
+		})
+
+		return nil
+	}).Run(workersCtx)
+
+	go worker.NewPeriodic("products.revalidateCache", config.GetDuration("PRODUCTS_CACHE_REVALIDATE_INTERVAL"), func(ctx context.Context) error {
+		_, err := ioc.RevalidateProductCache(ctx)
+		return err
+	}).Run(workersCtx)
+
+	outboxRelay := worker.NewOutboxRelay(ioc.EventRepository(), ioc.EventPublisher(), config.GetInt("EVENTS_RELAY_BATCH_SIZE"), config.GetDuration("EVENTS_RELAY_BATCH_TIMEOUT"), config.GetDuration("EVENTS_RETENTION"))
+	go worker.NewPeriodic("events.outboxRelay", config.GetDuration("EVENTS_RELAY_INTERVAL"), outboxRelay.Relay).Run(workersCtx)
+	go worker.NewPeriodic("events.purgePublished", config.GetDuration("EVENTS_PURGE_INTERVAL"), outboxRelay.Purge).Run(workersCtx)
 
 	err = http.StartHttpServer(http.Options{
 		ServiceName: config.GetString("SERVICE_NAME"),
@@ -49,11 +139,46 @@ func main() {
 		getClientFavoritesUc,
 		addProductToFavoritesUc,
 		removeProductFromFavoritesUc,
+		updateFavoriteUc,
+		addProductsToFavoritesUc,
+		removeProductsFromFavoritesUc,
+		getFavoritesTrashUc,
+		restoreFavoriteUc,
+		getFavoritesStatsUc,
+		exportClientFavoritesUc,
+		importFavoritesUc,
+		getImportJobUc,
+		createFavoritesShareUc,
+		getFavoritesSharesUc,
+		revokeFavoritesShareUc,
+		getSharedFavoritesUc,
+		getFavoritesQuotaUc,
+		setFavoritesQuotaUc,
+		removeFavoritesQuotaUc,
+		getFavoritesHistoryUc,
+		getRecommendationsUc,
+		getFavoritesChangesUc,
+		reorderFavoritesUc,
+		saveProductToFavoritesUc,
+		createFavoriteListUc,
+		getClientFavoriteListsUc,
+		getFavoriteListUc,
+		renameFavoriteListUc,
+		deleteFavoriteListUc,
+		addProductToListUc,
+		removeProductFromListUc,
 		findClientsUc,
 		listClientsUc,
 		updateClientUc,
 		deleteClientUc,
+		getProductsSyncStatusUc,
 	)
+
+	// The imports still running get the timeout to finish, then they are canceled and saved as failed
+	shutdownCtx, cancelShutdown := context.WithTimeout(context.Background(), config.GetDuration("BACKGROUND_JOBS_SHUTDOWN_TIMEOUT"))
+	defer cancelShutdown()
+	ioc.BackgroundJobs().Shutdown(shutdownCtx)
+
 	if err != nil {
 		panic(err)
 	}
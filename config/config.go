@@ -17,6 +17,9 @@ var config = map[string]string{
 	// Http Server
 	"HTTP_SERVER_PORT": "5000",
 
+	// Background jobs
+	"BACKGROUND_JOBS_SHUTDOWN_TIMEOUT": "30s",
+
 	// Auth
 	"ACESS_TOKEN_SECRET_KEY":      "",
 	"REFRESH_TOKEN_SECRET_KEY":    "",
@@ -46,17 +49,67 @@ var config = map[string]string{
 	"ROLE_PERMISSIONS_CACHE_DURATION": "1h",
 	"USER_CACHE_DURATION":             "5m",
 
+	// Favorites
+	"FAVORITES_MAX_BATCH_SIZE":       "50",
+	"FAVORITES_TRASH_RETENTION":      "720h",
+	"FAVORITES_TRASH_PURGE_INTERVAL": "1h",
+	"FAVORITES_STATS_CACHE_DURATION": "1h",
+	"FAVORITES_EXPORT_BATCH_SIZE":    "100",
+	"FAVORITES_IMPORT_MAX_ROWS":      "5000",
+	"FAVORITES_IMPORT_SYNC_MAX_ROWS": "100",
+	"FAVORITES_IMPORT_BATCH_SIZE":    "100",
+	"FAVORITES_IMPORT_JOB_TTL":       "24h",
+	"FAVORITES_IMPORT_JOB_TIMEOUT":   "30m",
+	"FAVORITES_QUOTA_CLIENT":         "500",
+	"FAVORITES_QUOTA_ADMIN":          "0",
+
+	"FAVORITES_RECOMMENDATIONS_CACHE_DURATION":   "1h",
+	"FAVORITES_RECOMMENDATIONS_REFRESH_INTERVAL": "1h",
+	"FAVORITES_RECOMMENDATIONS_MAX_SIMILAR":      "20",
+
+	"FAVORITES_ARCHIVE_UNAVAILABLE_ENABLED":  "false",
+	"FAVORITES_ARCHIVE_UNAVAILABLE_INTERVAL": "24h",
+
+	// Events
+	"EVENTS_PUBLISHER":           "log",
+	"EVENTS_LOG_FILE":            "",
+	"EVENTS_HTTP_URL":            "",
+	"EVENTS_HTTP_TIMEOUT":        "5s",
+	"EVENTS_RELAY_INTERVAL":      "5s",
+	"EVENTS_RELAY_BATCH_SIZE":    "100",
+	"EVENTS_RELAY_BATCH_TIMEOUT": "30s",
+	"EVENTS_RETENTION":           "168h",
+	"EVENTS_PURGE_INTERVAL":      "1h",
+
 	// Tracer
 	"TRACER_ENDPOINT": "http://localhost:9411/api/v2/spans",
 	"TRACE_ENABLED":   "false",
 
 	// HTTP Client
-	"HTTP_CLIENT_TIMEOUT": "30s",
+	"HTTP_CLIENT_TIMEOUT":               "30s",
+	"HTTP_CLIENT_MAX_RETRIES":           "2",
+	"HTTP_CLIENT_RETRY_BACKOFF":         "200ms",
+	"HTTP_CLIENT_RETRY_MAX_BACKOFF":     "5s",
+	"HTTP_CLIENT_BREAKER_THRESHOLD":     "5",
+	"HTTP_CLIENT_BREAKER_OPEN_DURATION": "30s",
 
 	// Store
 	"FAKE_STORE_API_URL":                "https://fakestoreapi.com/",
 	"FAKE_STORE_API_GET_BY_ID_ENDPOINT": "/products/{id}",
 	"FAKE_STORE_API_GET_ALL":            "/products/",
+	"FAKE_STORE_API_FIND_MULTIPLE":      "all",
+	"FAKE_STORE_API_MAX_CONCURRENCY":    "8",
+	"FAKE_STORE_API_FIND_TIMEOUT":       "10s",
+
+	"PRODUCTS_SOURCE":                    "upstream",
+	"PRODUCTS_CACHE_DURATION":            "10m",
+	"PRODUCTS_CATALOG_CACHE_DURATION":    "5m",
+	"PRODUCTS_CACHE_STATS_INTERVAL":      "5m",
+	"PRODUCTS_STALE_DURATION":            "24h",
+	"PRODUCTS_CACHE_REVALIDATE_AFTER":    "8m",
+	"PRODUCTS_CACHE_REVALIDATE_INTERVAL": "1m",
+	"PRODUCTS_MIRROR_SYNC_ENABLED":       "false",
+	"PRODUCTS_MIRROR_SYNC_INTERVAL":      "1h",
 }
 
 // GetString value of a given env var
@@ -0,0 +1,36 @@
+package event
+
+import (
+	"time"
+
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+type Type string
+
+// Event is a change on a domain written to the outbox with the change itself, so it is published even if the process dies.
+// The payload is encoded as json when the event is stored, events read from the outbox have it as json.RawMessage
+type Event struct {
+	ID          uuid.ID   `json:"id"`
+	Type        Type      `json:"type"`
+	AggregateID string    `json:"aggregateId"`
+	Payload     any       `json:"payload"`
+	ActorID     *uuid.ID  `json:"actorId,omitempty"`
+	OccurredAt  time.Time `json:"occurredAt"`
+}
+
+func New(t Type, aggregateID string, payload any) Event {
+	return Event{
+		ID:          uuid.NextID(),
+		Type:        t,
+		AggregateID: aggregateID,
+		Payload:     payload,
+		OccurredAt:  time.Now(),
+	}
+}
+
+// WithActor returns the event with the user that made the change
+func (e Event) WithActor(actorID uuid.ID) Event {
+	e.ActorID = &actorID
+	return e
+}
@@ -0,0 +1,57 @@
+package fixture
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/uesleicarvalhoo/aiqfome/event"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+type EventBuilder struct {
+	id          uuid.ID
+	eventType   event.Type
+	aggregateID string
+	payload     any
+	occurredAt  time.Time
+}
+
+func AnyEvent() EventBuilder {
+	return EventBuilder{
+		id:          uuid.NextID(),
+		eventType:   "FavoriteAdded",
+		aggregateID: uuid.NextID().String(),
+		payload:     json.RawMessage(`{"productId":1}`),
+		occurredAt:  time.Now(),
+	}
+}
+
+func (b EventBuilder) WithID(id uuid.ID) EventBuilder {
+	b.id = id
+	return b
+}
+
+func (b EventBuilder) WithType(t event.Type) EventBuilder {
+	b.eventType = t
+	return b
+}
+
+func (b EventBuilder) WithAggregateID(id string) EventBuilder {
+	b.aggregateID = id
+	return b
+}
+
+func (b EventBuilder) WithPayload(payload any) EventBuilder {
+	b.payload = payload
+	return b
+}
+
+func (b EventBuilder) Build() event.Event {
+	return event.Event{
+		ID:          b.id,
+		Type:        b.eventType,
+		AggregateID: b.aggregateID,
+		Payload:     b.payload,
+		OccurredAt:  b.occurredAt,
+	}
+}
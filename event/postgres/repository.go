@@ -0,0 +1,163 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/uesleicarvalhoo/aiqfome/event"
+)
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) event.Repository {
+	return &repository{
+		db: db,
+	}
+}
+
+// Save writes the events to the outbox, it must receive the transaction of the change that produced them
+func Save(ctx context.Context, tx *sql.Tx, ee []event.Event) error {
+	if len(ee) == 0 {
+		return nil
+	}
+
+	query := `
+	INSERT INTO outbox_events(
+		id, type, aggregate_id, payload, actor_id, occurred_at
+	) VALUES (
+		$1, $2, $3, $4, $5, $6
+	)
+	`
+
+	stmt, err := tx.PrepareContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, e := range ee {
+		payload, err := json.Marshal(e.Payload)
+		if err != nil {
+			return err
+		}
+
+		if _, err := stmt.ExecContext(ctx, e.ID, e.Type, e.AggregateID, payload, e.ActorID, e.OccurredAt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *repository) PublishPending(ctx context.Context, limit int, publish func(ctx context.Context, e event.Event) error) (int, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback() //nolint: errcheck
+
+	query := `
+		SELECT
+			id, type, aggregate_id, payload, actor_id, occurred_at
+		FROM outbox_events
+		WHERE
+			published_at IS NULL
+		ORDER BY seq
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED
+	`
+
+	rows, err := tx.QueryContext(ctx, query, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	ee, err := scanEvents(rows)
+	rows.Close()
+	if err != nil {
+		return 0, err
+	}
+
+	stmt, err := tx.PrepareContext(ctx, `
+	UPDATE outbox_events
+		SET published_at = NOW()
+	WHERE id = $1
+	`)
+	if err != nil {
+		return 0, err
+	}
+	defer stmt.Close()
+
+	// The events published before a failure are marked, so they aren't sent again by the next run
+	var published int
+	var publishErr error
+	for _, e := range ee {
+		if publishErr = publish(ctx, e); publishErr != nil {
+			break
+		}
+
+		if _, err := stmt.ExecContext(ctx, e.ID); err != nil {
+			return 0, err
+		}
+
+		published++
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return published, publishErr
+}
+
+func (r *repository) PurgePublished(ctx context.Context, publishedBefore time.Time) (int, error) {
+	query := `
+	DELETE FROM outbox_events
+	WHERE published_at < $1
+	`
+
+	res, err := r.db.ExecContext(ctx, query, publishedBefore)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(n), nil
+}
+
+func scanEvents(rows *sql.Rows) ([]event.Event, error) {
+	ee := make([]event.Event, 0)
+	for rows.Next() {
+		var (
+			e       event.Event
+			payload []byte
+		)
+		if err := rows.Scan(
+			&e.ID,
+			&e.Type,
+			&e.AggregateID,
+			&payload,
+			&e.ActorID,
+			&e.OccurredAt,
+		); err != nil {
+			return []event.Event{}, err
+		}
+
+		e.Payload = json.RawMessage(payload)
+		ee = append(ee, e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return []event.Event{}, err
+	}
+
+	return ee, nil
+}
@@ -0,0 +1,21 @@
+package event
+
+import (
+	"context"
+	"time"
+)
+
+// Repository reads the outbox, events are written by the repositories of each domain in the same transaction of the change
+type Repository interface {
+	// PublishPending locks the pending events skipping the ones held by another relay and marks the published ones in the same transaction,
+	// it stops at the first failure and returns how many were published. With several relays the order is only kept inside each batch.
+	// The events stay locked while publish runs, so publish should be bounded by the caller
+	PublishPending(ctx context.Context, limit int, publish func(ctx context.Context, e Event) error) (int, error)
+	// PurgePublished deletes the events published before the given moment and returns how many were deleted
+	PurgePublished(ctx context.Context, publishedBefore time.Time) (int, error)
+}
+
+// Publisher delivers the events to downstream systems, an event may be delivered more than once
+type Publisher interface {
+	Publish(ctx context.Context, e Event) error
+}
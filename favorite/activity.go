@@ -0,0 +1,63 @@
+package favorite
+
+import (
+	"time"
+
+	"github.com/uesleicarvalhoo/aiqfome/event"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+type Action string
+
+const (
+	ActionAdded    Action = "added"
+	ActionRemoved  Action = "removed"
+	ActionRestored Action = "restored"
+	ActionArchived Action = "archived"
+)
+
+var eventActions = map[event.Type]Action{
+	EventAdded:    ActionAdded,
+	EventRemoved:  ActionRemoved,
+	EventRestored: ActionRestored,
+	EventArchived: ActionArchived,
+}
+
+// Activity is an entry of the append-only history of the client favorites
+type Activity struct {
+	ID           int64     `json:"id"`
+	ClientID     uuid.ID   `json:"clientId"`
+	ProductID    int       `json:"productId"`
+	ProductTitle string    `json:"productTitle"`
+	Action       Action    `json:"action"`
+	ActorID      uuid.ID   `json:"actorId"`
+	OccurredAt   time.Time `json:"occurredAt"`
+}
+
+// ActivityFromEvent returns the activity recorded by a favorite event, other events have none.
+// Events without actor were made by the client itself, a zero actor means the system
+func ActivityFromEvent(e event.Event) (Activity, bool) {
+	action, ok := eventActions[e.Type]
+	if !ok {
+		return Activity{}, false
+	}
+
+	f, ok := e.Payload.(Favorite)
+	if !ok {
+		return Activity{}, false
+	}
+
+	actorID := f.ClientID
+	if e.ActorID != nil {
+		actorID = *e.ActorID
+	}
+
+	return Activity{
+		ClientID:     f.ClientID,
+		ProductID:    f.ProductID,
+		ProductTitle: f.TitleWhenFavorited,
+		Action:       action,
+		ActorID:      actorID,
+		OccurredAt:   e.OccurredAt,
+	}, true
+}
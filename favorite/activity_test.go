@@ -0,0 +1,74 @@
+package favorite_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uesleicarvalhoo/aiqfome/event"
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/favorite/fixture"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/user"
+)
+
+func TestActivityFromEvent(t *testing.T) {
+	t.Parallel()
+
+	clientID := uuid.NextID()
+	adminID := uuid.NextID()
+	f := fixture.AnyFavorite().WithClientID(clientID).WithProductID(7).Build()
+
+	testCases := []struct {
+		about           string
+		event           event.Event
+		expectedOk      bool
+		expectedAction  favorite.Action
+		expectedActorID uuid.ID
+	}{
+		{
+			about: "when it isn't a favorite event",
+			event: event.New(user.EventSignedUp, clientID.String(), user.User{ID: clientID}),
+		},
+		{
+			about:           "when the client made the change",
+			event:           f.Event(favorite.EventRemoved),
+			expectedOk:      true,
+			expectedAction:  favorite.ActionRemoved,
+			expectedActorID: clientID,
+		},
+		{
+			about:           "when an admin made the change on behalf of the client",
+			event:           f.Event(favorite.EventAdded).WithActor(adminID),
+			expectedOk:      true,
+			expectedAction:  favorite.ActionAdded,
+			expectedActorID: adminID,
+		},
+		{
+			about:           "when the system made the change",
+			event:           f.Event(favorite.EventArchived).WithActor(uuid.Nil),
+			expectedOk:      true,
+			expectedAction:  favorite.ActionArchived,
+			expectedActorID: uuid.Nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			a, ok := favorite.ActivityFromEvent(tc.event)
+
+			assert.Equal(t, tc.expectedOk, ok)
+			if !tc.expectedOk {
+				return
+			}
+
+			assert.Equal(t, clientID, a.ClientID)
+			assert.Equal(t, 7, a.ProductID)
+			assert.Equal(t, tc.expectedAction, a.Action)
+			assert.Equal(t, tc.expectedActorID, a.ActorID)
+			assert.Equal(t, tc.event.OccurredAt, a.OccurredAt)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package favorite
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+)
+
+type Direction string
+
+const (
+	DirectionNext Direction = "next"
+	DirectionPrev Direction = "prev"
+)
+
+// Cursor points to a favorite on the listing ordered by (registred_at, product_id) desc
+type Cursor struct {
+	RegistredAt time.Time
+	ProductID   int
+	Direction   Direction
+}
+
+type cursorPayload struct {
+	RegistredAt int64     `json:"r"`
+	ProductID   int       `json:"p"`
+	Direction   Direction `json:"d"`
+}
+
+func NewCursor(f Favorite, d Direction) Cursor {
+	return Cursor{
+		RegistredAt: f.RegistredAt,
+		ProductID:   f.ProductID,
+		Direction:   d,
+	}
+}
+
+func (c Cursor) IsZero() bool {
+	return c.ProductID == 0 && c.RegistredAt.IsZero()
+}
+
+func (c Cursor) Encode() string {
+	b, _ := json.Marshal(cursorPayload{
+		RegistredAt: c.RegistredAt.UnixMicro(),
+		ProductID:   c.ProductID,
+		Direction:   c.Direction,
+	})
+
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func DecodeCursor(s string) (Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	var p cursorPayload
+	if err := json.Unmarshal(b, &p); err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	if p.ProductID == 0 || (p.Direction != DirectionNext && p.Direction != DirectionPrev) {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	return Cursor{
+		RegistredAt: time.UnixMicro(p.RegistredAt),
+		ProductID:   p.ProductID,
+		Direction:   p.Direction,
+	}, nil
+}
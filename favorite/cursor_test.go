@@ -0,0 +1,70 @@
+package favorite_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/favorite/fixture"
+)
+
+func TestDecodeCursor(t *testing.T) {
+	t.Parallel()
+
+	f := fixture.AnyFavorite().
+		WithProductID(42).
+		WithRegistredAt(time.Now().Truncate(time.Microsecond)).
+		Build()
+
+	testCases := []struct {
+		about          string
+		cursor         string
+		expectedCursor favorite.Cursor
+		expectedError  error
+	}{
+		{
+			about:         "when cursor is not base64",
+			cursor:        "%%%",
+			expectedError: favorite.ErrInvalidCursor,
+		},
+		{
+			about:         "when cursor is not a valid payload",
+			cursor:        "aW52YWxpZA",
+			expectedError: favorite.ErrInvalidCursor,
+		},
+		{
+			about:         "when cursor direction is invalid",
+			cursor:        "eyJyIjoxLCJwIjoxLCJkIjoidXAifQ",
+			expectedError: favorite.ErrInvalidCursor,
+		},
+		{
+			about:          "when cursor is valid",
+			cursor:         favorite.NewCursor(f, favorite.DirectionPrev).Encode(),
+			expectedCursor: favorite.NewCursor(f, favorite.DirectionPrev),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Action
+			res, err := favorite.DecodeCursor(tc.cursor)
+
+			// Assert
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				assert.Equal(t, favorite.Cursor{}, res)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedCursor.ProductID, res.ProductID)
+			assert.Equal(t, tc.expectedCursor.Direction, res.Direction)
+			assert.True(t, tc.expectedCursor.RegistredAt.Equal(res.RegistredAt))
+		})
+	}
+}
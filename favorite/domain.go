@@ -1,16 +1,33 @@
 package favorite
 
 import (
+	"fmt"
+	"slices"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
 	"github.com/uesleicarvalhoo/aiqfome/pkg/validator"
 )
 
+const (
+	NoteMaxLength = 500
+	TagMaxLength  = 30
+	MaxTags       = 10
+)
+
 type Favorite struct {
-	ClientID    uuid.ID   `json:"clientId"`
-	ProductID   int       `json:"productId"`
-	RegistredAt time.Time `json:"registredAt"`
+	ClientID           uuid.ID    `json:"clientId"`
+	ProductID          int        `json:"productId"`
+	Note               string     `json:"note"`
+	Tags               []string   `json:"tags"`
+	PriceWhenFavorited *float32   `json:"priceWhenFavorited,omitempty"`
+	TitleWhenFavorited string     `json:"titleWhenFavorited,omitempty"`
+	RegistredAt        time.Time  `json:"registredAt"`
+	DeletedAt          *time.Time `json:"deletedAt,omitempty"`
+	// Position is the rank on the client manual ordering, lower first
+	Position int64 `json:"position"`
 }
 
 func (f Favorite) validate() error {
@@ -24,13 +41,70 @@ func (f Favorite) validate() error {
 		v.AddError("productId", "campo obrigatório")
 	}
 
+	if utf8.RuneCountInString(f.Note) > NoteMaxLength {
+		v.AddError("note", fmt.Sprintf("deve ter no máximo %d caracteres", NoteMaxLength))
+	}
+
+	if len(f.Tags) > MaxTags {
+		v.AddError("tags", fmt.Sprintf("deve ter no máximo %d tags", MaxTags))
+	}
+
+	for _, t := range f.Tags {
+		if utf8.RuneCountInString(t) > TagMaxLength {
+			v.AddError("tags", fmt.Sprintf("cada tag deve ter no máximo %d caracteres", TagMaxLength))
+			break
+		}
+	}
+
 	return v.Validate()
 }
 
+// Annotate replaces note and tags, the favorite is kept untouched if the result is invalid
+func (f *Favorite) Annotate(note string, tags []string) error {
+	annotated := *f
+	annotated.Note = strings.TrimSpace(note)
+	annotated.Tags = normalizeTags(tags)
+
+	if err := annotated.validate(); err != nil {
+		return err
+	}
+
+	*f = annotated
+
+	return nil
+}
+
+// Snapshot keeps the product title and price from the moment it was favorited
+func (f *Favorite) Snapshot(title string, price float32) {
+	f.TitleWhenFavorited = title
+	f.PriceWhenFavorited = &price
+}
+
+// NormalizeTag returns the tag in the same format it is stored
+func NormalizeTag(tag string) string {
+	return strings.ToLower(strings.TrimSpace(tag))
+}
+
+func normalizeTags(tags []string) []string {
+	nn := make([]string, 0, len(tags))
+
+	for _, t := range tags {
+		t = NormalizeTag(t)
+		if t == "" || slices.Contains(nn, t) {
+			continue
+		}
+
+		nn = append(nn, t)
+	}
+
+	return nn
+}
+
 func New(clientID uuid.ID, productID int) (Favorite, error) {
 	f := Favorite{
 		ClientID:    clientID,
 		ProductID:   productID,
+		Tags:        []string{},
 		RegistredAt: time.Now(),
 	}
 
@@ -1,11 +1,14 @@
 package favorite_test
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/favorite/fixture"
 	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
 )
 
@@ -67,3 +70,97 @@ func TestNew(t *testing.T) {
 		})
 	}
 }
+
+func TestFavorite_Annotate(t *testing.T) {
+	t.Parallel()
+
+	favoriteBuilder := fixture.AnyFavorite().
+		WithNote("nota antiga").
+		WithTags([]string{"antiga"})
+
+	tooManyTags := make([]string, 0, favorite.MaxTags+1)
+	for i := 0; i <= favorite.MaxTags; i++ {
+		tooManyTags = append(tooManyTags, fmt.Sprintf("tag-%d", i))
+	}
+
+	testCases := []struct {
+		about         string
+		note          string
+		tags          []string
+		expectedNote  string
+		expectedTags  []string
+		expectedError string
+	}{
+		{
+			about:         "when note is too long",
+			note:          strings.Repeat("a", favorite.NoteMaxLength+1),
+			tags:          []string{},
+			expectedNote:  "nota antiga",
+			expectedTags:  []string{"antiga"},
+			expectedError: "[AQF002] note: deve ter no máximo 500 caracteres",
+		},
+		{
+			about:         "when there are too many tags",
+			note:          "",
+			tags:          tooManyTags,
+			expectedNote:  "nota antiga",
+			expectedTags:  []string{"antiga"},
+			expectedError: "[AQF002] tags: deve ter no máximo 10 tags",
+		},
+		{
+			about:         "when a tag is too long",
+			note:          "",
+			tags:          []string{strings.Repeat("a", favorite.TagMaxLength+1)},
+			expectedNote:  "nota antiga",
+			expectedTags:  []string{"antiga"},
+			expectedError: "[AQF002] tags: cada tag deve ter no máximo 30 caracteres",
+		},
+		{
+			about:        "when values are valid",
+			note:         "  ideia para o jantar ",
+			tags:         []string{" Jantar", "jantar", "", "Família"},
+			expectedNote: "ideia para o jantar",
+			expectedTags: []string{"jantar", "família"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			f := favoriteBuilder.Build()
+
+			// Action
+			err := f.Annotate(tc.note, tc.tags)
+
+			// Assert
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tc.expectedNote, f.Note)
+			assert.Equal(t, tc.expectedTags, f.Tags)
+		})
+	}
+}
+
+func TestFavorite_Snapshot(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	f := fixture.AnyFavorite().Build()
+
+	// Action
+	f.Snapshot("Notebook", 109.95)
+
+	// Assert
+	assert.Equal(t, "Notebook", f.TitleWhenFavorited)
+	if assert.NotNil(t, f.PriceWhenFavorited) {
+		assert.Equal(t, float32(109.95), *f.PriceWhenFavorited)
+	}
+}
@@ -1,11 +1,17 @@
 package favorite
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
 )
 
+var (
+	ErrInvalidCursor    = errors.New("invalid cursor")
+	ErrInvalidSyncToken = errors.New("invalid sync token")
+)
+
 type ErrFavoriteNotFound struct {
 	ClientID  uuid.ID
 	ProductID int
@@ -14,3 +20,70 @@ type ErrFavoriteNotFound struct {
 func (e *ErrFavoriteNotFound) Error() string {
 	return fmt.Sprintf("client '%s' don't have the product with id '%d' on their favorites", e.ClientID.String(), e.ProductID)
 }
+
+type ErrAlreadyFavorite struct {
+	ClientID  uuid.ID
+	ProductID int
+}
+
+func (e *ErrAlreadyFavorite) Error() string {
+	return fmt.Sprintf("client '%s' already has the product with id '%d' on their favorites", e.ClientID.String(), e.ProductID)
+}
+
+type ErrListNotFound struct {
+	ClientID uuid.ID
+	ListID   uuid.ID
+}
+
+func (e *ErrListNotFound) Error() string {
+	return fmt.Sprintf("client '%s' don't have a list with id '%s'", e.ClientID.String(), e.ListID.String())
+}
+
+type ErrListItemNotFound struct {
+	ListID    uuid.ID
+	ProductID int
+}
+
+func (e *ErrListItemNotFound) Error() string {
+	return fmt.Sprintf("list '%s' don't have the product with id '%d'", e.ListID.String(), e.ProductID)
+}
+
+type ErrListItemAlreadyExists struct {
+	ListID    uuid.ID
+	ProductID int
+}
+
+func (e *ErrListItemAlreadyExists) Error() string {
+	return fmt.Sprintf("list '%s' already has the product with id '%d'", e.ListID.String(), e.ProductID)
+}
+
+type ErrShareNotFound struct {
+	Token string
+}
+
+func (e *ErrShareNotFound) Error() string {
+	return fmt.Sprintf("share with token '%s' not found", e.Token)
+}
+
+type ErrQuotaNotFound struct {
+	ClientID uuid.ID
+}
+
+func (e *ErrQuotaNotFound) Error() string {
+	return fmt.Sprintf("client '%s' don't have a favorites quota", e.ClientID.String())
+}
+
+type ErrQuotaExceeded struct {
+	ClientID uuid.ID
+	Limit    int
+	Count    int
+}
+
+func (e *ErrQuotaExceeded) Error() string {
+	return fmt.Sprintf("client '%s' already has %d of %d favorites", e.ClientID.String(), e.Count, e.Limit)
+}
+
+// Available returns how many favorites the client can still add
+func (e *ErrQuotaExceeded) Available() int {
+	return max(e.Limit-e.Count, 0)
+}
@@ -0,0 +1,25 @@
+package favorite
+
+import "github.com/uesleicarvalhoo/aiqfome/event"
+
+const (
+	EventAdded    event.Type = "FavoriteAdded"
+	EventRemoved  event.Type = "FavoriteRemoved"
+	EventRestored event.Type = "FavoriteRestored"
+	EventArchived event.Type = "FavoriteArchived"
+)
+
+// Event returns an event of the given type with the favorite as payload, the client is the aggregate
+func (f Favorite) Event(t event.Type) event.Event {
+	return event.New(t, f.ClientID.String(), f)
+}
+
+// Events returns an event of the given type for each favorite
+func Events(t event.Type, ff []Favorite) []event.Event {
+	ee := make([]event.Event, 0, len(ff))
+	for _, f := range ff {
+		ee = append(ee, f.Event(t))
+	}
+
+	return ee
+}
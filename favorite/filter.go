@@ -0,0 +1,16 @@
+package favorite
+
+type OrderField string
+
+const (
+	OrderByProductID   OrderField = ""
+	OrderByRegistredAt OrderField = "registred_at"
+	OrderByPosition    OrderField = "position"
+)
+
+// Filter of favorites listing, zero values are ignored
+type Filter struct {
+	Tag   string
+	Order OrderField
+	Desc  bool
+}
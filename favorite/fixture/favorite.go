@@ -8,15 +8,23 @@ import (
 )
 
 type FavoriteBuilder struct {
-	clientID    uuid.ID
-	productID   int
-	registredAt time.Time
+	clientID           uuid.ID
+	productID          int
+	note               string
+	tags               []string
+	priceWhenFavorited *float32
+	titleWhenFavorited string
+	registredAt        time.Time
+	deletedAt          *time.Time
+	position           int64
 }
 
 func AnyFavorite() FavoriteBuilder {
 	return FavoriteBuilder{
 		clientID:    uuid.NextID(),
 		productID:   1,
+		note:        "",
+		tags:        []string{},
 		registredAt: time.Now(),
 	}
 }
@@ -31,15 +39,47 @@ func (b FavoriteBuilder) WithProductID(pid int) FavoriteBuilder {
 	return b
 }
 
+func (b FavoriteBuilder) WithNote(note string) FavoriteBuilder {
+	b.note = note
+	return b
+}
+
+func (b FavoriteBuilder) WithTags(tags []string) FavoriteBuilder {
+	b.tags = tags
+	return b
+}
+
+func (b FavoriteBuilder) WithSnapshot(title string, price float32) FavoriteBuilder {
+	b.titleWhenFavorited = title
+	b.priceWhenFavorited = &price
+	return b
+}
+
 func (b FavoriteBuilder) WithRegistredAt(t time.Time) FavoriteBuilder {
 	b.registredAt = t
 	return b
 }
 
+func (b FavoriteBuilder) WithDeletedAt(t *time.Time) FavoriteBuilder {
+	b.deletedAt = t
+	return b
+}
+
+func (b FavoriteBuilder) WithPosition(position int64) FavoriteBuilder {
+	b.position = position
+	return b
+}
+
 func (b FavoriteBuilder) Build() favorite.Favorite {
 	return favorite.Favorite{
-		ClientID:    b.clientID,
-		ProductID:   b.productID,
-		RegistredAt: b.registredAt,
+		ClientID:           b.clientID,
+		ProductID:          b.productID,
+		Note:               b.note,
+		Tags:               b.tags,
+		PriceWhenFavorited: b.priceWhenFavorited,
+		TitleWhenFavorited: b.titleWhenFavorited,
+		RegistredAt:        b.registredAt,
+		DeletedAt:          b.deletedAt,
+		Position:           b.position,
 	}
 }
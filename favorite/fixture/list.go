@@ -0,0 +1,61 @@
+package fixture
+
+import (
+	"time"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+type ListBuilder struct {
+	id        uuid.ID
+	clientID  uuid.ID
+	name      string
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+func AnyList() ListBuilder {
+	return ListBuilder{
+		id:        uuid.NextID(),
+		clientID:  uuid.NextID(),
+		name:      "Almoço",
+		createdAt: time.Now(),
+		updatedAt: time.Now(),
+	}
+}
+
+func (b ListBuilder) WithID(id uuid.ID) ListBuilder {
+	b.id = id
+	return b
+}
+
+func (b ListBuilder) WithClientID(id uuid.ID) ListBuilder {
+	b.clientID = id
+	return b
+}
+
+func (b ListBuilder) WithName(name string) ListBuilder {
+	b.name = name
+	return b
+}
+
+func (b ListBuilder) WithCreatedAt(t time.Time) ListBuilder {
+	b.createdAt = t
+	return b
+}
+
+func (b ListBuilder) WithUpdatedAt(t time.Time) ListBuilder {
+	b.updatedAt = t
+	return b
+}
+
+func (b ListBuilder) Build() favorite.List {
+	return favorite.List{
+		ID:        b.id,
+		ClientID:  b.clientID,
+		Name:      b.name,
+		CreatedAt: b.createdAt,
+		UpdatedAt: b.updatedAt,
+	}
+}
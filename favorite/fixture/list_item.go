@@ -0,0 +1,45 @@
+package fixture
+
+import (
+	"time"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+type ListItemBuilder struct {
+	listID    uuid.ID
+	productID int
+	addedAt   time.Time
+}
+
+func AnyListItem() ListItemBuilder {
+	return ListItemBuilder{
+		listID:    uuid.NextID(),
+		productID: 1,
+		addedAt:   time.Now(),
+	}
+}
+
+func (b ListItemBuilder) WithListID(id uuid.ID) ListItemBuilder {
+	b.listID = id
+	return b
+}
+
+func (b ListItemBuilder) WithProductID(pid int) ListItemBuilder {
+	b.productID = pid
+	return b
+}
+
+func (b ListItemBuilder) WithAddedAt(t time.Time) ListItemBuilder {
+	b.addedAt = t
+	return b
+}
+
+func (b ListItemBuilder) Build() favorite.ListItem {
+	return favorite.ListItem{
+		ListID:    b.listID,
+		ProductID: b.productID,
+		AddedAt:   b.addedAt,
+	}
+}
@@ -0,0 +1,60 @@
+package fixture
+
+import (
+	"crypto/rand"
+	"time"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+type ShareBuilder struct {
+	token     string
+	clientID  uuid.ID
+	createdAt time.Time
+	expiresAt *time.Time
+	revokedAt *time.Time
+}
+
+func AnyShare() ShareBuilder {
+	return ShareBuilder{
+		token:     rand.Text(),
+		clientID:  uuid.NextID(),
+		createdAt: time.Now(),
+	}
+}
+
+func (b ShareBuilder) WithToken(token string) ShareBuilder {
+	b.token = token
+	return b
+}
+
+func (b ShareBuilder) WithClientID(id uuid.ID) ShareBuilder {
+	b.clientID = id
+	return b
+}
+
+func (b ShareBuilder) WithCreatedAt(t time.Time) ShareBuilder {
+	b.createdAt = t
+	return b
+}
+
+func (b ShareBuilder) WithExpiresAt(t *time.Time) ShareBuilder {
+	b.expiresAt = t
+	return b
+}
+
+func (b ShareBuilder) WithRevokedAt(t *time.Time) ShareBuilder {
+	b.revokedAt = t
+	return b
+}
+
+func (b ShareBuilder) Build() favorite.Share {
+	return favorite.Share{
+		Token:     b.token,
+		ClientID:  b.clientID,
+		CreatedAt: b.createdAt,
+		ExpiresAt: b.expiresAt,
+		RevokedAt: b.revokedAt,
+	}
+}
@@ -0,0 +1,107 @@
+package favorite
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/validator"
+)
+
+const ListNameMaxLength = 100
+
+type List struct {
+	ID        uuid.ID   `json:"id"`
+	ClientID  uuid.ID   `json:"clientId"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+func (l List) validate() error {
+	v := validator.New()
+
+	if l.ID.IsZero() {
+		v.AddError("id", "campo obrigatório")
+	}
+
+	if l.ClientID.IsZero() {
+		v.AddError("clientId", "campo obrigatório")
+	}
+
+	if l.Name == "" {
+		v.AddError("name", "campo obrigatório")
+	} else if utf8.RuneCountInString(l.Name) > ListNameMaxLength {
+		v.AddError("name", fmt.Sprintf("deve ter no máximo %d caracteres", ListNameMaxLength))
+	}
+
+	return v.Validate()
+}
+
+func (l *List) Rename(name string) error {
+	renamed := *l
+	renamed.Name = strings.TrimSpace(name)
+	renamed.UpdatedAt = time.Now()
+
+	if err := renamed.validate(); err != nil {
+		return err
+	}
+
+	*l = renamed
+
+	return nil
+}
+
+func NewList(id, clientID uuid.ID, name string) (List, error) {
+	now := time.Now()
+
+	l := List{
+		ID:        id,
+		ClientID:  clientID,
+		Name:      strings.TrimSpace(name),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := l.validate(); err != nil {
+		return List{}, err
+	}
+
+	return l, nil
+}
+
+type ListItem struct {
+	ListID    uuid.ID   `json:"listId"`
+	ProductID int       `json:"productId"`
+	AddedAt   time.Time `json:"addedAt"`
+}
+
+func (i ListItem) validate() error {
+	v := validator.New()
+
+	if i.ListID.IsZero() {
+		v.AddError("listId", "campo obrigatório")
+	}
+
+	if i.ProductID == 0 {
+		v.AddError("productId", "campo obrigatório")
+	}
+
+	return v.Validate()
+}
+
+func NewListItem(listID uuid.ID, productID int) (ListItem, error) {
+	i := ListItem{
+		ListID:    listID,
+		ProductID: productID,
+		AddedAt:   time.Now(),
+	}
+
+	if err := i.validate(); err != nil {
+		return ListItem{}, err
+	}
+
+	return i, nil
+}
@@ -0,0 +1,179 @@
+package favorite_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/favorite/fixture"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+func TestNewList(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		about         string
+		id            uuid.ID
+		clientID      uuid.ID
+		name          string
+		expectedName  string
+		expectedError string
+	}{
+		{
+			about:         "when id and clientID are invalid",
+			id:            uuid.Nil,
+			clientID:      uuid.Nil,
+			name:          "Almoço",
+			expectedError: "[AQF002] id: campo obrigatório; clientId: campo obrigatório",
+		},
+		{
+			about:         "when name is blank",
+			id:            uuid.NextID(),
+			clientID:      uuid.NextID(),
+			name:          "   ",
+			expectedError: "[AQF002] name: campo obrigatório",
+		},
+		{
+			about:         "when name is too long",
+			id:            uuid.NextID(),
+			clientID:      uuid.NextID(),
+			name:          strings.Repeat("a", favorite.ListNameMaxLength+1),
+			expectedError: "[AQF002] name: deve ter no máximo 100 caracteres",
+		},
+		{
+			about:        "when all values are valid",
+			id:           uuid.NextID(),
+			clientID:     uuid.NextID(),
+			name:         "  Presentes ",
+			expectedName: "Presentes",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Action
+			res, err := favorite.NewList(tc.id, tc.clientID, tc.name)
+
+			// Assert
+			if tc.expectedError != "" {
+				assert.Equal(t, favorite.List{}, res)
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.id, res.ID)
+			assert.Equal(t, tc.clientID, res.ClientID)
+			assert.Equal(t, tc.expectedName, res.Name)
+			assert.WithinDuration(t, time.Now(), res.CreatedAt, time.Second*1)
+			assert.Equal(t, res.CreatedAt, res.UpdatedAt)
+		})
+	}
+}
+
+func TestList_Rename(t *testing.T) {
+	t.Parallel()
+
+	updatedAt := time.Now().Add(-time.Hour)
+	listBuilder := fixture.AnyList().
+		WithName("Almoço").
+		WithUpdatedAt(updatedAt)
+
+	testCases := []struct {
+		about         string
+		name          string
+		expectedName  string
+		expectedError string
+	}{
+		{
+			about:         "when name is blank",
+			name:          "",
+			expectedName:  "Almoço",
+			expectedError: "[AQF002] name: campo obrigatório",
+		},
+		{
+			about:        "when name is valid",
+			name:         "Jantar",
+			expectedName: "Jantar",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			l := listBuilder.Build()
+
+			// Action
+			err := l.Rename(tc.name)
+
+			// Assert
+			assert.Equal(t, tc.expectedName, l.Name)
+
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				assert.Equal(t, updatedAt, l.UpdatedAt)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.WithinDuration(t, time.Now(), l.UpdatedAt, time.Second*1)
+		})
+	}
+}
+
+func TestNewListItem(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		about         string
+		listID        uuid.ID
+		productID     int
+		expectedError string
+	}{
+		{
+			about:         "when both listID and productID are invalid",
+			listID:        uuid.Nil,
+			productID:     0,
+			expectedError: "[AQF002] listId: campo obrigatório; productId: campo obrigatório",
+		},
+		{
+			about:     "when all values are valid",
+			listID:    uuid.NextID(),
+			productID: 42,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Action
+			res, err := favorite.NewListItem(tc.listID, tc.productID)
+
+			// Assert
+			if tc.expectedError != "" {
+				assert.Equal(t, favorite.ListItem{}, res)
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.listID, res.ListID)
+			assert.Equal(t, tc.productID, res.ProductID)
+			assert.WithinDuration(t, time.Now(), res.AddedAt, time.Second*1)
+		})
+	}
+}
@@ -8,6 +8,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 	favorite "github.com/uesleicarvalhoo/aiqfome/favorite"
 
+	time "time"
+
 	uuid "github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
 )
 
@@ -16,6 +18,184 @@ type Reader struct {
 	mock.Mock
 }
 
+// AllByClientID provides a mock function with given fields: ctx, clientID, filter
+func (_m *Reader) AllByClientID(ctx context.Context, clientID uuid.ID, filter favorite.Filter) ([]favorite.Favorite, error) {
+	ret := _m.Called(ctx, clientID, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AllByClientID")
+	}
+
+	var r0 []favorite.Favorite
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, favorite.Filter) ([]favorite.Favorite, error)); ok {
+		return rf(ctx, clientID, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, favorite.Filter) []favorite.Favorite); ok {
+		r0 = rf(ctx, clientID, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]favorite.Favorite)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.ID, favorite.Filter) error); ok {
+		r1 = rf(ctx, clientID, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AllByProductIDs provides a mock function with given fields: ctx, productIDs
+func (_m *Reader) AllByProductIDs(ctx context.Context, productIDs []int) ([]favorite.Favorite, error) {
+	ret := _m.Called(ctx, productIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AllByProductIDs")
+	}
+
+	var r0 []favorite.Favorite
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int) ([]favorite.Favorite, error)); ok {
+		return rf(ctx, productIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int) []favorite.Favorite); ok {
+		r0 = rf(ctx, productIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]favorite.Favorite)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int) error); ok {
+		r1 = rf(ctx, productIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChangesByClientID provides a mock function with given fields: ctx, clientID, after, limit
+func (_m *Reader) ChangesByClientID(ctx context.Context, clientID uuid.ID, after favorite.SyncToken, limit int) ([]favorite.Change, error) {
+	ret := _m.Called(ctx, clientID, after, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangesByClientID")
+	}
+
+	var r0 []favorite.Change
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, favorite.SyncToken, int) ([]favorite.Change, error)); ok {
+		return rf(ctx, clientID, after, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, favorite.SyncToken, int) []favorite.Change); ok {
+		r0 = rf(ctx, clientID, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]favorite.Change)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.ID, favorite.SyncToken, int) error); ok {
+		r1 = rf(ctx, clientID, after, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountByClientID provides a mock function with given fields: ctx, clientID
+func (_m *Reader) CountByClientID(ctx context.Context, clientID uuid.ID) (int, error) {
+	ret := _m.Called(ctx, clientID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByClientID")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID) (int, error)); ok {
+		return rf(ctx, clientID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID) int); ok {
+		r0 = rf(ctx, clientID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.ID) error); ok {
+		r1 = rf(ctx, clientID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountByProduct provides a mock function with given fields: ctx
+func (_m *Reader) CountByProduct(ctx context.Context) ([]favorite.ProductCount, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByProduct")
+	}
+
+	var r0 []favorite.ProductCount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]favorite.ProductCount, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []favorite.ProductCount); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]favorite.ProductCount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DailyActivity provides a mock function with given fields: ctx, since
+func (_m *Reader) DailyActivity(ctx context.Context, since time.Time) ([]favorite.DailyActivity, error) {
+	ret := _m.Called(ctx, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DailyActivity")
+	}
+
+	var r0 []favorite.DailyActivity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]favorite.DailyActivity, error)); ok {
+		return rf(ctx, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []favorite.DailyActivity); ok {
+		r0 = rf(ctx, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]favorite.DailyActivity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Find provides a mock function with given fields: ctx, clientID, productID
 func (_m *Reader) Find(ctx context.Context, clientID uuid.ID, productID int) (favorite.Favorite, error) {
 	ret := _m.Called(ctx, clientID, productID)
@@ -44,25 +224,225 @@ func (_m *Reader) Find(ctx context.Context, clientID uuid.ID, productID int) (fa
 	return r0, r1
 }
 
-// PaginateByClientID provides a mock function with given fields: ctx, clientID, page, pageSize
-func (_m *Reader) PaginateByClientID(ctx context.Context, clientID uuid.ID, page int, pageSize int) ([]favorite.Favorite, int, error) {
-	ret := _m.Called(ctx, clientID, page, pageSize)
+// FindList provides a mock function with given fields: ctx, clientID, listID
+func (_m *Reader) FindList(ctx context.Context, clientID uuid.ID, listID uuid.ID) (favorite.List, error) {
+	ret := _m.Called(ctx, clientID, listID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for PaginateByClientID")
+		panic("no return value specified for FindList")
+	}
+
+	var r0 favorite.List
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, uuid.ID) (favorite.List, error)); ok {
+		return rf(ctx, clientID, listID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, uuid.ID) favorite.List); ok {
+		r0 = rf(ctx, clientID, listID)
+	} else {
+		r0 = ret.Get(0).(favorite.List)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.ID, uuid.ID) error); ok {
+		r1 = rf(ctx, clientID, listID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindListItem provides a mock function with given fields: ctx, listID, productID
+func (_m *Reader) FindListItem(ctx context.Context, listID uuid.ID, productID int) (favorite.ListItem, error) {
+	ret := _m.Called(ctx, listID, productID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindListItem")
+	}
+
+	var r0 favorite.ListItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, int) (favorite.ListItem, error)); ok {
+		return rf(ctx, listID, productID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, int) favorite.ListItem); ok {
+		r0 = rf(ctx, listID, productID)
+	} else {
+		r0 = ret.Get(0).(favorite.ListItem)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.ID, int) error); ok {
+		r1 = rf(ctx, listID, productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindMultiple provides a mock function with given fields: ctx, clientID, productIDs
+func (_m *Reader) FindMultiple(ctx context.Context, clientID uuid.ID, productIDs []int) ([]favorite.Favorite, error) {
+	ret := _m.Called(ctx, clientID, productIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindMultiple")
 	}
 
 	var r0 []favorite.Favorite
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, []int) ([]favorite.Favorite, error)); ok {
+		return rf(ctx, clientID, productIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, []int) []favorite.Favorite); ok {
+		r0 = rf(ctx, clientID, productIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]favorite.Favorite)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.ID, []int) error); ok {
+		r1 = rf(ctx, clientID, productIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindQuota provides a mock function with given fields: ctx, clientID
+func (_m *Reader) FindQuota(ctx context.Context, clientID uuid.ID) (favorite.Quota, error) {
+	ret := _m.Called(ctx, clientID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindQuota")
+	}
+
+	var r0 favorite.Quota
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID) (favorite.Quota, error)); ok {
+		return rf(ctx, clientID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID) favorite.Quota); ok {
+		r0 = rf(ctx, clientID)
+	} else {
+		r0 = ret.Get(0).(favorite.Quota)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.ID) error); ok {
+		r1 = rf(ctx, clientID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindShare provides a mock function with given fields: ctx, token
+func (_m *Reader) FindShare(ctx context.Context, token string) (favorite.Share, error) {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindShare")
+	}
+
+	var r0 favorite.Share
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (favorite.Share, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) favorite.Share); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Get(0).(favorite.Share)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindTrashed provides a mock function with given fields: ctx, clientID, productID, deletedAfter
+func (_m *Reader) FindTrashed(ctx context.Context, clientID uuid.ID, productID int, deletedAfter time.Time) (favorite.Favorite, error) {
+	ret := _m.Called(ctx, clientID, productID, deletedAfter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTrashed")
+	}
+
+	var r0 favorite.Favorite
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, int, time.Time) (favorite.Favorite, error)); ok {
+		return rf(ctx, clientID, productID, deletedAfter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, int, time.Time) favorite.Favorite); ok {
+		r0 = rf(ctx, clientID, productID, deletedAfter)
+	} else {
+		r0 = ret.Get(0).(favorite.Favorite)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.ID, int, time.Time) error); ok {
+		r1 = rf(ctx, clientID, productID, deletedAfter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListsByClientID provides a mock function with given fields: ctx, clientID
+func (_m *Reader) ListsByClientID(ctx context.Context, clientID uuid.ID) ([]favorite.List, error) {
+	ret := _m.Called(ctx, clientID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListsByClientID")
+	}
+
+	var r0 []favorite.List
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID) ([]favorite.List, error)); ok {
+		return rf(ctx, clientID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID) []favorite.List); ok {
+		r0 = rf(ctx, clientID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]favorite.List)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.ID) error); ok {
+		r1 = rf(ctx, clientID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaginateActivities provides a mock function with given fields: ctx, clientID, page, pageSize
+func (_m *Reader) PaginateActivities(ctx context.Context, clientID uuid.ID, page int, pageSize int) ([]favorite.Activity, int, error) {
+	ret := _m.Called(ctx, clientID, page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PaginateActivities")
+	}
+
+	var r0 []favorite.Activity
 	var r1 int
 	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, int, int) ([]favorite.Favorite, int, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, int, int) ([]favorite.Activity, int, error)); ok {
 		return rf(ctx, clientID, page, pageSize)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, int, int) []favorite.Favorite); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, int, int) []favorite.Activity); ok {
 		r0 = rf(ctx, clientID, page, pageSize)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]favorite.Favorite)
+			r0 = ret.Get(0).([]favorite.Activity)
 		}
 	}
 
@@ -81,6 +461,207 @@ func (_m *Reader) PaginateByClientID(ctx context.Context, clientID uuid.ID, page
 	return r0, r1, r2
 }
 
+// PaginateByClientID provides a mock function with given fields: ctx, clientID, filter, page, pageSize
+func (_m *Reader) PaginateByClientID(ctx context.Context, clientID uuid.ID, filter favorite.Filter, page int, pageSize int) ([]favorite.Favorite, int, error) {
+	ret := _m.Called(ctx, clientID, filter, page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PaginateByClientID")
+	}
+
+	var r0 []favorite.Favorite
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, favorite.Filter, int, int) ([]favorite.Favorite, int, error)); ok {
+		return rf(ctx, clientID, filter, page, pageSize)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, favorite.Filter, int, int) []favorite.Favorite); ok {
+		r0 = rf(ctx, clientID, filter, page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]favorite.Favorite)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.ID, favorite.Filter, int, int) int); ok {
+		r1 = rf(ctx, clientID, filter, page, pageSize)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.ID, favorite.Filter, int, int) error); ok {
+		r2 = rf(ctx, clientID, filter, page, pageSize)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// PaginateListItems provides a mock function with given fields: ctx, listID, page, pageSize
+func (_m *Reader) PaginateListItems(ctx context.Context, listID uuid.ID, page int, pageSize int) ([]favorite.ListItem, int, error) {
+	ret := _m.Called(ctx, listID, page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PaginateListItems")
+	}
+
+	var r0 []favorite.ListItem
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, int, int) ([]favorite.ListItem, int, error)); ok {
+		return rf(ctx, listID, page, pageSize)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, int, int) []favorite.ListItem); ok {
+		r0 = rf(ctx, listID, page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]favorite.ListItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.ID, int, int) int); ok {
+		r1 = rf(ctx, listID, page, pageSize)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.ID, int, int) error); ok {
+		r2 = rf(ctx, listID, page, pageSize)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// PaginateTrash provides a mock function with given fields: ctx, clientID, deletedAfter, page, pageSize
+func (_m *Reader) PaginateTrash(ctx context.Context, clientID uuid.ID, deletedAfter time.Time, page int, pageSize int) ([]favorite.Favorite, int, error) {
+	ret := _m.Called(ctx, clientID, deletedAfter, page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PaginateTrash")
+	}
+
+	var r0 []favorite.Favorite
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, time.Time, int, int) ([]favorite.Favorite, int, error)); ok {
+		return rf(ctx, clientID, deletedAfter, page, pageSize)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, time.Time, int, int) []favorite.Favorite); ok {
+		r0 = rf(ctx, clientID, deletedAfter, page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]favorite.Favorite)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.ID, time.Time, int, int) int); ok {
+		r1 = rf(ctx, clientID, deletedAfter, page, pageSize)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.ID, time.Time, int, int) error); ok {
+		r2 = rf(ctx, clientID, deletedAfter, page, pageSize)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// RecommendByClientID provides a mock function with given fields: ctx, clientID, limit
+func (_m *Reader) RecommendByClientID(ctx context.Context, clientID uuid.ID, limit int) ([]favorite.Recommendation, error) {
+	ret := _m.Called(ctx, clientID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecommendByClientID")
+	}
+
+	var r0 []favorite.Recommendation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, int) ([]favorite.Recommendation, error)); ok {
+		return rf(ctx, clientID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, int) []favorite.Recommendation); ok {
+		r0 = rf(ctx, clientID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]favorite.Recommendation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.ID, int) error); ok {
+		r1 = rf(ctx, clientID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ScrollByClientID provides a mock function with given fields: ctx, clientID, filter, cursor, limit
+func (_m *Reader) ScrollByClientID(ctx context.Context, clientID uuid.ID, filter favorite.Filter, cursor favorite.Cursor, limit int) ([]favorite.Favorite, error) {
+	ret := _m.Called(ctx, clientID, filter, cursor, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScrollByClientID")
+	}
+
+	var r0 []favorite.Favorite
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, favorite.Filter, favorite.Cursor, int) ([]favorite.Favorite, error)); ok {
+		return rf(ctx, clientID, filter, cursor, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, favorite.Filter, favorite.Cursor, int) []favorite.Favorite); ok {
+		r0 = rf(ctx, clientID, filter, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]favorite.Favorite)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.ID, favorite.Filter, favorite.Cursor, int) error); ok {
+		r1 = rf(ctx, clientID, filter, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SharesByClientID provides a mock function with given fields: ctx, clientID
+func (_m *Reader) SharesByClientID(ctx context.Context, clientID uuid.ID) ([]favorite.Share, error) {
+	ret := _m.Called(ctx, clientID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SharesByClientID")
+	}
+
+	var r0 []favorite.Share
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID) ([]favorite.Share, error)); ok {
+		return rf(ctx, clientID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID) []favorite.Share); ok {
+		r0 = rf(ctx, clientID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]favorite.Share)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.ID) error); ok {
+		r1 = rf(ctx, clientID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewReader creates a new instance of Reader. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewReader(t interface {
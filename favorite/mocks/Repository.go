@@ -5,9 +5,13 @@ package mocks
 import (
 	context "context"
 
-	mock "github.com/stretchr/testify/mock"
+	event "github.com/uesleicarvalhoo/aiqfome/event"
 	favorite "github.com/uesleicarvalhoo/aiqfome/favorite"
 
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
 	uuid "github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
 )
 
@@ -16,17 +20,317 @@ type Repository struct {
 	mock.Mock
 }
 
-// Create provides a mock function with given fields: ctx, f
-func (_m *Repository) Create(ctx context.Context, f favorite.Favorite) error {
-	ret := _m.Called(ctx, f)
+// AddListItem provides a mock function with given fields: ctx, i
+func (_m *Repository) AddListItem(ctx context.Context, i favorite.ListItem) error {
+	ret := _m.Called(ctx, i)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for AddListItem")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, favorite.Favorite) error); ok {
-		r0 = rf(ctx, f)
+	if rf, ok := ret.Get(0).(func(context.Context, favorite.ListItem) error); ok {
+		r0 = rf(ctx, i)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AllByClientID provides a mock function with given fields: ctx, clientID, filter
+func (_m *Repository) AllByClientID(ctx context.Context, clientID uuid.ID, filter favorite.Filter) ([]favorite.Favorite, error) {
+	ret := _m.Called(ctx, clientID, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AllByClientID")
+	}
+
+	var r0 []favorite.Favorite
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, favorite.Filter) ([]favorite.Favorite, error)); ok {
+		return rf(ctx, clientID, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, favorite.Filter) []favorite.Favorite); ok {
+		r0 = rf(ctx, clientID, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]favorite.Favorite)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.ID, favorite.Filter) error); ok {
+		r1 = rf(ctx, clientID, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AllByProductIDs provides a mock function with given fields: ctx, productIDs
+func (_m *Repository) AllByProductIDs(ctx context.Context, productIDs []int) ([]favorite.Favorite, error) {
+	ret := _m.Called(ctx, productIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AllByProductIDs")
+	}
+
+	var r0 []favorite.Favorite
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int) ([]favorite.Favorite, error)); ok {
+		return rf(ctx, productIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int) []favorite.Favorite); ok {
+		r0 = rf(ctx, productIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]favorite.Favorite)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int) error); ok {
+		r1 = rf(ctx, productIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Archive provides a mock function with given fields: ctx, ff, ee
+func (_m *Repository) Archive(ctx context.Context, ff []favorite.Favorite, ee ...event.Event) error {
+	_va := make([]interface{}, len(ee))
+	for _i := range ee {
+		_va[_i] = ee[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, ff)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Archive")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []favorite.Favorite, ...event.Event) error); ok {
+		r0 = rf(ctx, ff, ee...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ChangesByClientID provides a mock function with given fields: ctx, clientID, after, limit
+func (_m *Repository) ChangesByClientID(ctx context.Context, clientID uuid.ID, after favorite.SyncToken, limit int) ([]favorite.Change, error) {
+	ret := _m.Called(ctx, clientID, after, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangesByClientID")
+	}
+
+	var r0 []favorite.Change
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, favorite.SyncToken, int) ([]favorite.Change, error)); ok {
+		return rf(ctx, clientID, after, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, favorite.SyncToken, int) []favorite.Change); ok {
+		r0 = rf(ctx, clientID, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]favorite.Change)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.ID, favorite.SyncToken, int) error); ok {
+		r1 = rf(ctx, clientID, after, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountByClientID provides a mock function with given fields: ctx, clientID
+func (_m *Repository) CountByClientID(ctx context.Context, clientID uuid.ID) (int, error) {
+	ret := _m.Called(ctx, clientID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByClientID")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID) (int, error)); ok {
+		return rf(ctx, clientID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID) int); ok {
+		r0 = rf(ctx, clientID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.ID) error); ok {
+		r1 = rf(ctx, clientID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountByProduct provides a mock function with given fields: ctx
+func (_m *Repository) CountByProduct(ctx context.Context) ([]favorite.ProductCount, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByProduct")
+	}
+
+	var r0 []favorite.ProductCount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]favorite.ProductCount, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []favorite.ProductCount); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]favorite.ProductCount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateList provides a mock function with given fields: ctx, l
+func (_m *Repository) CreateList(ctx context.Context, l favorite.List) error {
+	ret := _m.Called(ctx, l)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateList")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, favorite.List) error); ok {
+		r0 = rf(ctx, l)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateShare provides a mock function with given fields: ctx, s
+func (_m *Repository) CreateShare(ctx context.Context, s favorite.Share) error {
+	ret := _m.Called(ctx, s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateShare")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, favorite.Share) error); ok {
+		r0 = rf(ctx, s)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateWithinQuota provides a mock function with given fields: ctx, clientID, ff, limit, ee
+func (_m *Repository) CreateWithinQuota(ctx context.Context, clientID uuid.ID, ff []favorite.Favorite, limit int, ee ...event.Event) error {
+	_va := make([]interface{}, len(ee))
+	for _i := range ee {
+		_va[_i] = ee[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, clientID, ff, limit)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWithinQuota")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, []favorite.Favorite, int, ...event.Event) error); ok {
+		r0 = rf(ctx, clientID, ff, limit, ee...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DailyActivity provides a mock function with given fields: ctx, since
+func (_m *Repository) DailyActivity(ctx context.Context, since time.Time) ([]favorite.DailyActivity, error) {
+	ret := _m.Called(ctx, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DailyActivity")
+	}
+
+	var r0 []favorite.DailyActivity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]favorite.DailyActivity, error)); ok {
+		return rf(ctx, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []favorite.DailyActivity); ok {
+		r0 = rf(ctx, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]favorite.DailyActivity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteList provides a mock function with given fields: ctx, l
+func (_m *Repository) DeleteList(ctx context.Context, l favorite.List) error {
+	ret := _m.Called(ctx, l)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteList")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, favorite.List) error); ok {
+		r0 = rf(ctx, l)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteQuota provides a mock function with given fields: ctx, clientID
+func (_m *Repository) DeleteQuota(ctx context.Context, clientID uuid.ID) error {
+	ret := _m.Called(ctx, clientID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteQuota")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID) error); ok {
+		r0 = rf(ctx, clientID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -62,59 +366,716 @@ func (_m *Repository) Find(ctx context.Context, clientID uuid.ID, productID int)
 	return r0, r1
 }
 
-// PaginateByClientID provides a mock function with given fields: ctx, clientID, page, pageSize
-func (_m *Repository) PaginateByClientID(ctx context.Context, clientID uuid.ID, page int, pageSize int) ([]favorite.Favorite, int, error) {
-	ret := _m.Called(ctx, clientID, page, pageSize)
+// FindList provides a mock function with given fields: ctx, clientID, listID
+func (_m *Repository) FindList(ctx context.Context, clientID uuid.ID, listID uuid.ID) (favorite.List, error) {
+	ret := _m.Called(ctx, clientID, listID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for PaginateByClientID")
+		panic("no return value specified for FindList")
+	}
+
+	var r0 favorite.List
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, uuid.ID) (favorite.List, error)); ok {
+		return rf(ctx, clientID, listID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, uuid.ID) favorite.List); ok {
+		r0 = rf(ctx, clientID, listID)
+	} else {
+		r0 = ret.Get(0).(favorite.List)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.ID, uuid.ID) error); ok {
+		r1 = rf(ctx, clientID, listID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindListItem provides a mock function with given fields: ctx, listID, productID
+func (_m *Repository) FindListItem(ctx context.Context, listID uuid.ID, productID int) (favorite.ListItem, error) {
+	ret := _m.Called(ctx, listID, productID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindListItem")
+	}
+
+	var r0 favorite.ListItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, int) (favorite.ListItem, error)); ok {
+		return rf(ctx, listID, productID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, int) favorite.ListItem); ok {
+		r0 = rf(ctx, listID, productID)
+	} else {
+		r0 = ret.Get(0).(favorite.ListItem)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.ID, int) error); ok {
+		r1 = rf(ctx, listID, productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindMultiple provides a mock function with given fields: ctx, clientID, productIDs
+func (_m *Repository) FindMultiple(ctx context.Context, clientID uuid.ID, productIDs []int) ([]favorite.Favorite, error) {
+	ret := _m.Called(ctx, clientID, productIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindMultiple")
 	}
 
 	var r0 []favorite.Favorite
-	var r1 int
-	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, int, int) ([]favorite.Favorite, int, error)); ok {
-		return rf(ctx, clientID, page, pageSize)
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, []int) ([]favorite.Favorite, error)); ok {
+		return rf(ctx, clientID, productIDs)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, int, int) []favorite.Favorite); ok {
-		r0 = rf(ctx, clientID, page, pageSize)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, []int) []favorite.Favorite); ok {
+		r0 = rf(ctx, clientID, productIDs)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]favorite.Favorite)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.ID, int, int) int); ok {
-		r1 = rf(ctx, clientID, page, pageSize)
-	} else {
-		r1 = ret.Get(1).(int)
-	}
-
-	if rf, ok := ret.Get(2).(func(context.Context, uuid.ID, int, int) error); ok {
-		r2 = rf(ctx, clientID, page, pageSize)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.ID, []int) error); ok {
+		r1 = rf(ctx, clientID, productIDs)
 	} else {
-		r2 = ret.Error(2)
+		r1 = ret.Error(1)
 	}
 
-	return r0, r1, r2
+	return r0, r1
 }
 
-// Remove provides a mock function with given fields: ctx, f
-func (_m *Repository) Remove(ctx context.Context, f favorite.Favorite) error {
-	ret := _m.Called(ctx, f)
+// FindQuota provides a mock function with given fields: ctx, clientID
+func (_m *Repository) FindQuota(ctx context.Context, clientID uuid.ID) (favorite.Quota, error) {
+	ret := _m.Called(ctx, clientID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Remove")
+		panic("no return value specified for FindQuota")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, favorite.Favorite) error); ok {
-		r0 = rf(ctx, f)
+	var r0 favorite.Quota
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID) (favorite.Quota, error)); ok {
+		return rf(ctx, clientID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID) favorite.Quota); ok {
+		r0 = rf(ctx, clientID)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(favorite.Quota)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.ID) error); ok {
+		r1 = rf(ctx, clientID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindShare provides a mock function with given fields: ctx, token
+func (_m *Repository) FindShare(ctx context.Context, token string) (favorite.Share, error) {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindShare")
+	}
+
+	var r0 favorite.Share
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (favorite.Share, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) favorite.Share); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Get(0).(favorite.Share)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindTrashed provides a mock function with given fields: ctx, clientID, productID, deletedAfter
+func (_m *Repository) FindTrashed(ctx context.Context, clientID uuid.ID, productID int, deletedAfter time.Time) (favorite.Favorite, error) {
+	ret := _m.Called(ctx, clientID, productID, deletedAfter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTrashed")
+	}
+
+	var r0 favorite.Favorite
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, int, time.Time) (favorite.Favorite, error)); ok {
+		return rf(ctx, clientID, productID, deletedAfter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, int, time.Time) favorite.Favorite); ok {
+		r0 = rf(ctx, clientID, productID, deletedAfter)
+	} else {
+		r0 = ret.Get(0).(favorite.Favorite)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.ID, int, time.Time) error); ok {
+		r1 = rf(ctx, clientID, productID, deletedAfter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListsByClientID provides a mock function with given fields: ctx, clientID
+func (_m *Repository) ListsByClientID(ctx context.Context, clientID uuid.ID) ([]favorite.List, error) {
+	ret := _m.Called(ctx, clientID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListsByClientID")
+	}
+
+	var r0 []favorite.List
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID) ([]favorite.List, error)); ok {
+		return rf(ctx, clientID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID) []favorite.List); ok {
+		r0 = rf(ctx, clientID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]favorite.List)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.ID) error); ok {
+		r1 = rf(ctx, clientID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaginateActivities provides a mock function with given fields: ctx, clientID, page, pageSize
+func (_m *Repository) PaginateActivities(ctx context.Context, clientID uuid.ID, page int, pageSize int) ([]favorite.Activity, int, error) {
+	ret := _m.Called(ctx, clientID, page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PaginateActivities")
+	}
+
+	var r0 []favorite.Activity
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, int, int) ([]favorite.Activity, int, error)); ok {
+		return rf(ctx, clientID, page, pageSize)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, int, int) []favorite.Activity); ok {
+		r0 = rf(ctx, clientID, page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]favorite.Activity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.ID, int, int) int); ok {
+		r1 = rf(ctx, clientID, page, pageSize)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.ID, int, int) error); ok {
+		r2 = rf(ctx, clientID, page, pageSize)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// PaginateByClientID provides a mock function with given fields: ctx, clientID, filter, page, pageSize
+func (_m *Repository) PaginateByClientID(ctx context.Context, clientID uuid.ID, filter favorite.Filter, page int, pageSize int) ([]favorite.Favorite, int, error) {
+	ret := _m.Called(ctx, clientID, filter, page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PaginateByClientID")
+	}
+
+	var r0 []favorite.Favorite
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, favorite.Filter, int, int) ([]favorite.Favorite, int, error)); ok {
+		return rf(ctx, clientID, filter, page, pageSize)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, favorite.Filter, int, int) []favorite.Favorite); ok {
+		r0 = rf(ctx, clientID, filter, page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]favorite.Favorite)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.ID, favorite.Filter, int, int) int); ok {
+		r1 = rf(ctx, clientID, filter, page, pageSize)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.ID, favorite.Filter, int, int) error); ok {
+		r2 = rf(ctx, clientID, filter, page, pageSize)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// PaginateListItems provides a mock function with given fields: ctx, listID, page, pageSize
+func (_m *Repository) PaginateListItems(ctx context.Context, listID uuid.ID, page int, pageSize int) ([]favorite.ListItem, int, error) {
+	ret := _m.Called(ctx, listID, page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PaginateListItems")
+	}
+
+	var r0 []favorite.ListItem
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, int, int) ([]favorite.ListItem, int, error)); ok {
+		return rf(ctx, listID, page, pageSize)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, int, int) []favorite.ListItem); ok {
+		r0 = rf(ctx, listID, page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]favorite.ListItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.ID, int, int) int); ok {
+		r1 = rf(ctx, listID, page, pageSize)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.ID, int, int) error); ok {
+		r2 = rf(ctx, listID, page, pageSize)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// PaginateTrash provides a mock function with given fields: ctx, clientID, deletedAfter, page, pageSize
+func (_m *Repository) PaginateTrash(ctx context.Context, clientID uuid.ID, deletedAfter time.Time, page int, pageSize int) ([]favorite.Favorite, int, error) {
+	ret := _m.Called(ctx, clientID, deletedAfter, page, pageSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PaginateTrash")
+	}
+
+	var r0 []favorite.Favorite
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, time.Time, int, int) ([]favorite.Favorite, int, error)); ok {
+		return rf(ctx, clientID, deletedAfter, page, pageSize)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, time.Time, int, int) []favorite.Favorite); ok {
+		r0 = rf(ctx, clientID, deletedAfter, page, pageSize)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]favorite.Favorite)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.ID, time.Time, int, int) int); ok {
+		r1 = rf(ctx, clientID, deletedAfter, page, pageSize)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.ID, time.Time, int, int) error); ok {
+		r2 = rf(ctx, clientID, deletedAfter, page, pageSize)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// PurgeTrash provides a mock function with given fields: ctx, deletedBefore
+func (_m *Repository) PurgeTrash(ctx context.Context, deletedBefore time.Time) (int, error) {
+	ret := _m.Called(ctx, deletedBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeTrash")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int, error)); ok {
+		return rf(ctx, deletedBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int); ok {
+		r0 = rf(ctx, deletedBefore)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, deletedBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RecommendByClientID provides a mock function with given fields: ctx, clientID, limit
+func (_m *Repository) RecommendByClientID(ctx context.Context, clientID uuid.ID, limit int) ([]favorite.Recommendation, error) {
+	ret := _m.Called(ctx, clientID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecommendByClientID")
+	}
+
+	var r0 []favorite.Recommendation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, int) ([]favorite.Recommendation, error)); ok {
+		return rf(ctx, clientID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, int) []favorite.Recommendation); ok {
+		r0 = rf(ctx, clientID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]favorite.Recommendation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.ID, int) error); ok {
+		r1 = rf(ctx, clientID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RefreshSimilarities provides a mock function with given fields: ctx, maxPerProduct
+func (_m *Repository) RefreshSimilarities(ctx context.Context, maxPerProduct int) (int, error) {
+	ret := _m.Called(ctx, maxPerProduct)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshSimilarities")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (int, error)); ok {
+		return rf(ctx, maxPerProduct)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) int); ok {
+		r0 = rf(ctx, maxPerProduct)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, maxPerProduct)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Remove provides a mock function with given fields: ctx, f, ee
+func (_m *Repository) Remove(ctx context.Context, f favorite.Favorite, ee ...event.Event) error {
+	_va := make([]interface{}, len(ee))
+	for _i := range ee {
+		_va[_i] = ee[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, f)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Remove")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, favorite.Favorite, ...event.Event) error); ok {
+		r0 = rf(ctx, f, ee...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoveListItem provides a mock function with given fields: ctx, i
+func (_m *Repository) RemoveListItem(ctx context.Context, i favorite.ListItem) error {
+	ret := _m.Called(ctx, i)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveListItem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, favorite.ListItem) error); ok {
+		r0 = rf(ctx, i)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoveMany provides a mock function with given fields: ctx, ff, ee
+func (_m *Repository) RemoveMany(ctx context.Context, ff []favorite.Favorite, ee ...event.Event) error {
+	_va := make([]interface{}, len(ee))
+	for _i := range ee {
+		_va[_i] = ee[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, ff)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveMany")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []favorite.Favorite, ...event.Event) error); ok {
+		r0 = rf(ctx, ff, ee...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RestoreWithinQuota provides a mock function with given fields: ctx, f, limit, ee
+func (_m *Repository) RestoreWithinQuota(ctx context.Context, f favorite.Favorite, limit int, ee ...event.Event) error {
+	_va := make([]interface{}, len(ee))
+	for _i := range ee {
+		_va[_i] = ee[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, f, limit)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreWithinQuota")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, favorite.Favorite, int, ...event.Event) error); ok {
+		r0 = rf(ctx, f, limit, ee...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RevokeShare provides a mock function with given fields: ctx, s
+func (_m *Repository) RevokeShare(ctx context.Context, s favorite.Share) error {
+	ret := _m.Called(ctx, s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeShare")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, favorite.Share) error); ok {
+		r0 = rf(ctx, s)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SaveQuota provides a mock function with given fields: ctx, q
+func (_m *Repository) SaveQuota(ctx context.Context, q favorite.Quota) error {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveQuota")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, favorite.Quota) error); ok {
+		r0 = rf(ctx, q)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ScrollByClientID provides a mock function with given fields: ctx, clientID, filter, cursor, limit
+func (_m *Repository) ScrollByClientID(ctx context.Context, clientID uuid.ID, filter favorite.Filter, cursor favorite.Cursor, limit int) ([]favorite.Favorite, error) {
+	ret := _m.Called(ctx, clientID, filter, cursor, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScrollByClientID")
+	}
+
+	var r0 []favorite.Favorite
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, favorite.Filter, favorite.Cursor, int) ([]favorite.Favorite, error)); ok {
+		return rf(ctx, clientID, filter, cursor, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, favorite.Filter, favorite.Cursor, int) []favorite.Favorite); ok {
+		r0 = rf(ctx, clientID, filter, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]favorite.Favorite)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.ID, favorite.Filter, favorite.Cursor, int) error); ok {
+		r1 = rf(ctx, clientID, filter, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SharesByClientID provides a mock function with given fields: ctx, clientID
+func (_m *Repository) SharesByClientID(ctx context.Context, clientID uuid.ID) ([]favorite.Share, error) {
+	ret := _m.Called(ctx, clientID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SharesByClientID")
+	}
+
+	var r0 []favorite.Share
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID) ([]favorite.Share, error)); ok {
+		return rf(ctx, clientID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID) []favorite.Share); ok {
+		r0 = rf(ctx, clientID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]favorite.Share)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.ID) error); ok {
+		r1 = rf(ctx, clientID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, f
+func (_m *Repository) Update(ctx context.Context, f favorite.Favorite) error {
+	ret := _m.Called(ctx, f)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, favorite.Favorite) error); ok {
+		r0 = rf(ctx, f)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateList provides a mock function with given fields: ctx, l
+func (_m *Repository) UpdateList(ctx context.Context, l favorite.List) error {
+	ret := _m.Called(ctx, l)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateList")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, favorite.List) error); ok {
+		r0 = rf(ctx, l)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdatePositions provides a mock function with given fields: ctx, clientID, ff
+func (_m *Repository) UpdatePositions(ctx context.Context, clientID uuid.ID, ff []favorite.Favorite) error {
+	ret := _m.Called(ctx, clientID, ff)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePositions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, []favorite.Favorite) error); ok {
+		r0 = rf(ctx, clientID, ff)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpsertWithinQuota provides a mock function with given fields: ctx, f, limit, ee
+func (_m *Repository) UpsertWithinQuota(ctx context.Context, f favorite.Favorite, limit int, ee ...event.Event) (bool, error) {
+	_va := make([]interface{}, len(ee))
+	for _i := range ee {
+		_va[_i] = ee[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, f, limit)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertWithinQuota")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, favorite.Favorite, int, ...event.Event) (bool, error)); ok {
+		return rf(ctx, f, limit, ee...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, favorite.Favorite, int, ...event.Event) bool); ok {
+		r0 = rf(ctx, f, limit, ee...)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, favorite.Favorite, int, ...event.Event) error); ok {
+		r1 = rf(ctx, f, limit, ee...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // NewRepository creates a new instance of Repository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
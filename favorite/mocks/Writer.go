@@ -5,8 +5,14 @@ package mocks
 import (
 	context "context"
 
-	mock "github.com/stretchr/testify/mock"
+	event "github.com/uesleicarvalhoo/aiqfome/event"
 	favorite "github.com/uesleicarvalhoo/aiqfome/favorite"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
 )
 
 // Writer is an autogenerated mock type for the Writer type
@@ -14,17 +20,17 @@ type Writer struct {
 	mock.Mock
 }
 
-// Create provides a mock function with given fields: ctx, f
-func (_m *Writer) Create(ctx context.Context, f favorite.Favorite) error {
-	ret := _m.Called(ctx, f)
+// AddListItem provides a mock function with given fields: ctx, i
+func (_m *Writer) AddListItem(ctx context.Context, i favorite.ListItem) error {
+	ret := _m.Called(ctx, i)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Create")
+		panic("no return value specified for AddListItem")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, favorite.Favorite) error); ok {
-		r0 = rf(ctx, f)
+	if rf, ok := ret.Get(0).(func(context.Context, favorite.ListItem) error); ok {
+		r0 = rf(ctx, i)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -32,14 +38,321 @@ func (_m *Writer) Create(ctx context.Context, f favorite.Favorite) error {
 	return r0
 }
 
-// Remove provides a mock function with given fields: ctx, f
-func (_m *Writer) Remove(ctx context.Context, f favorite.Favorite) error {
-	ret := _m.Called(ctx, f)
+// Archive provides a mock function with given fields: ctx, ff, ee
+func (_m *Writer) Archive(ctx context.Context, ff []favorite.Favorite, ee ...event.Event) error {
+	_va := make([]interface{}, len(ee))
+	for _i := range ee {
+		_va[_i] = ee[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, ff)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Archive")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []favorite.Favorite, ...event.Event) error); ok {
+		r0 = rf(ctx, ff, ee...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateList provides a mock function with given fields: ctx, l
+func (_m *Writer) CreateList(ctx context.Context, l favorite.List) error {
+	ret := _m.Called(ctx, l)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateList")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, favorite.List) error); ok {
+		r0 = rf(ctx, l)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateShare provides a mock function with given fields: ctx, s
+func (_m *Writer) CreateShare(ctx context.Context, s favorite.Share) error {
+	ret := _m.Called(ctx, s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateShare")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, favorite.Share) error); ok {
+		r0 = rf(ctx, s)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateWithinQuota provides a mock function with given fields: ctx, clientID, ff, limit, ee
+func (_m *Writer) CreateWithinQuota(ctx context.Context, clientID uuid.ID, ff []favorite.Favorite, limit int, ee ...event.Event) error {
+	_va := make([]interface{}, len(ee))
+	for _i := range ee {
+		_va[_i] = ee[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, clientID, ff, limit)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWithinQuota")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, []favorite.Favorite, int, ...event.Event) error); ok {
+		r0 = rf(ctx, clientID, ff, limit, ee...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteList provides a mock function with given fields: ctx, l
+func (_m *Writer) DeleteList(ctx context.Context, l favorite.List) error {
+	ret := _m.Called(ctx, l)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteList")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, favorite.List) error); ok {
+		r0 = rf(ctx, l)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteQuota provides a mock function with given fields: ctx, clientID
+func (_m *Writer) DeleteQuota(ctx context.Context, clientID uuid.ID) error {
+	ret := _m.Called(ctx, clientID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteQuota")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID) error); ok {
+		r0 = rf(ctx, clientID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PurgeTrash provides a mock function with given fields: ctx, deletedBefore
+func (_m *Writer) PurgeTrash(ctx context.Context, deletedBefore time.Time) (int, error) {
+	ret := _m.Called(ctx, deletedBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeTrash")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int, error)); ok {
+		return rf(ctx, deletedBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int); ok {
+		r0 = rf(ctx, deletedBefore)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, deletedBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RefreshSimilarities provides a mock function with given fields: ctx, maxPerProduct
+func (_m *Writer) RefreshSimilarities(ctx context.Context, maxPerProduct int) (int, error) {
+	ret := _m.Called(ctx, maxPerProduct)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshSimilarities")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (int, error)); ok {
+		return rf(ctx, maxPerProduct)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) int); ok {
+		r0 = rf(ctx, maxPerProduct)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, maxPerProduct)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Remove provides a mock function with given fields: ctx, f, ee
+func (_m *Writer) Remove(ctx context.Context, f favorite.Favorite, ee ...event.Event) error {
+	_va := make([]interface{}, len(ee))
+	for _i := range ee {
+		_va[_i] = ee[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, f)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Remove")
 	}
 
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, favorite.Favorite, ...event.Event) error); ok {
+		r0 = rf(ctx, f, ee...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoveListItem provides a mock function with given fields: ctx, i
+func (_m *Writer) RemoveListItem(ctx context.Context, i favorite.ListItem) error {
+	ret := _m.Called(ctx, i)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveListItem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, favorite.ListItem) error); ok {
+		r0 = rf(ctx, i)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoveMany provides a mock function with given fields: ctx, ff, ee
+func (_m *Writer) RemoveMany(ctx context.Context, ff []favorite.Favorite, ee ...event.Event) error {
+	_va := make([]interface{}, len(ee))
+	for _i := range ee {
+		_va[_i] = ee[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, ff)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveMany")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []favorite.Favorite, ...event.Event) error); ok {
+		r0 = rf(ctx, ff, ee...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RestoreWithinQuota provides a mock function with given fields: ctx, f, limit, ee
+func (_m *Writer) RestoreWithinQuota(ctx context.Context, f favorite.Favorite, limit int, ee ...event.Event) error {
+	_va := make([]interface{}, len(ee))
+	for _i := range ee {
+		_va[_i] = ee[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, f, limit)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreWithinQuota")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, favorite.Favorite, int, ...event.Event) error); ok {
+		r0 = rf(ctx, f, limit, ee...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RevokeShare provides a mock function with given fields: ctx, s
+func (_m *Writer) RevokeShare(ctx context.Context, s favorite.Share) error {
+	ret := _m.Called(ctx, s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeShare")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, favorite.Share) error); ok {
+		r0 = rf(ctx, s)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SaveQuota provides a mock function with given fields: ctx, q
+func (_m *Writer) SaveQuota(ctx context.Context, q favorite.Quota) error {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveQuota")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, favorite.Quota) error); ok {
+		r0 = rf(ctx, q)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Update provides a mock function with given fields: ctx, f
+func (_m *Writer) Update(ctx context.Context, f favorite.Favorite) error {
+	ret := _m.Called(ctx, f)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
 	var r0 error
 	if rf, ok := ret.Get(0).(func(context.Context, favorite.Favorite) error); ok {
 		r0 = rf(ctx, f)
@@ -50,6 +363,77 @@ func (_m *Writer) Remove(ctx context.Context, f favorite.Favorite) error {
 	return r0
 }
 
+// UpdateList provides a mock function with given fields: ctx, l
+func (_m *Writer) UpdateList(ctx context.Context, l favorite.List) error {
+	ret := _m.Called(ctx, l)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateList")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, favorite.List) error); ok {
+		r0 = rf(ctx, l)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdatePositions provides a mock function with given fields: ctx, clientID, ff
+func (_m *Writer) UpdatePositions(ctx context.Context, clientID uuid.ID, ff []favorite.Favorite) error {
+	ret := _m.Called(ctx, clientID, ff)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePositions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID, []favorite.Favorite) error); ok {
+		r0 = rf(ctx, clientID, ff)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpsertWithinQuota provides a mock function with given fields: ctx, f, limit, ee
+func (_m *Writer) UpsertWithinQuota(ctx context.Context, f favorite.Favorite, limit int, ee ...event.Event) (bool, error) {
+	_va := make([]interface{}, len(ee))
+	for _i := range ee {
+		_va[_i] = ee[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, f, limit)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertWithinQuota")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, favorite.Favorite, int, ...event.Event) (bool, error)); ok {
+		return rf(ctx, f, limit, ee...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, favorite.Favorite, int, ...event.Event) bool); ok {
+		r0 = rf(ctx, f, limit, ee...)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, favorite.Favorite, int, ...event.Event) error); ok {
+		r1 = rf(ctx, f, limit, ee...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewWriter creates a new instance of Writer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewWriter(t interface {
@@ -0,0 +1,161 @@
+package favorite
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+// PositionGap is the distance between the positions of neighbor favorites, moves take a position in between
+// so only the moved favorite is written until the gap runs out
+const PositionGap int64 = 1024
+
+// Ordering is the manual ordering of the client favorites, it tracks the favorites that changed position
+type Ordering struct {
+	clientID uuid.ID
+	ff       []Favorite
+	changed  map[int]bool
+}
+
+// NewOrdering returns the ordering of the favorites, they must be all the client favorites
+func NewOrdering(clientID uuid.ID, ff []Favorite) *Ordering {
+	o := &Ordering{
+		clientID: clientID,
+		ff:       slices.Clone(ff),
+		changed:  make(map[int]bool),
+	}
+
+	o.sort()
+
+	return o
+}
+
+// Arrange orders the given favorites as listed, using the positions they already take
+func (o *Ordering) Arrange(productIDs []int) error {
+	idxs := make([]int, 0, len(productIDs))
+	for _, id := range productIDs {
+		idx, err := o.index(id)
+		if err != nil {
+			return err
+		}
+
+		idxs = append(idxs, idx)
+	}
+
+	positions := make([]int64, 0, len(idxs))
+	for _, idx := range idxs {
+		positions = append(positions, o.ff[idx].Position)
+	}
+
+	slices.Sort(positions)
+
+	for i, idx := range idxs {
+		if o.ff[idx].Position != positions[i] {
+			o.ff[idx].Position = positions[i]
+			o.changed[o.ff[idx].ProductID] = true
+		}
+	}
+
+	o.sort()
+
+	return nil
+}
+
+// Move places the favorite right before the target one, or right after when after is set
+func (o *Ordering) Move(productID, targetID int, after bool) error {
+	from, err := o.index(productID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := o.index(targetID); err != nil {
+		return err
+	}
+
+	if productID == targetID {
+		return nil
+	}
+
+	f := o.ff[from]
+	o.ff = slices.Delete(o.ff, from, from+1)
+
+	to, _ := o.index(targetID)
+	if after {
+		to++
+	}
+
+	o.ff = slices.Insert(o.ff, to, f)
+	o.changed[productID] = true
+
+	var prev, next *Favorite
+	if to > 0 {
+		prev = &o.ff[to-1]
+	}
+
+	if to < len(o.ff)-1 {
+		next = &o.ff[to+1]
+	}
+
+	switch {
+	case prev == nil && next == nil:
+	case prev == nil:
+		o.ff[to].Position = next.Position - PositionGap
+	case next == nil:
+		o.ff[to].Position = prev.Position + PositionGap
+	case next.Position-prev.Position > 1:
+		o.ff[to].Position = prev.Position + (next.Position-prev.Position)/2
+	default:
+		o.rebalance()
+	}
+
+	return nil
+}
+
+// Favorites returns the favorites in order
+func (o *Ordering) Favorites() []Favorite {
+	return slices.Clone(o.ff)
+}
+
+// Changed returns the favorites that changed position, in order
+func (o *Ordering) Changed() []Favorite {
+	ff := make([]Favorite, 0, len(o.changed))
+	for _, f := range o.ff {
+		if o.changed[f.ProductID] {
+			ff = append(ff, f)
+		}
+	}
+
+	return ff
+}
+
+// rebalance spreads all favorites by the gap again, when there is no room left between two of them
+func (o *Ordering) rebalance() {
+	for i := range o.ff {
+		position := int64(i+1) * PositionGap
+		if o.ff[i].Position != position {
+			o.ff[i].Position = position
+			o.changed[o.ff[i].ProductID] = true
+		}
+	}
+}
+
+func (o *Ordering) index(productID int) (int, error) {
+	idx := slices.IndexFunc(o.ff, func(f Favorite) bool {
+		return f.ProductID == productID
+	})
+	if idx < 0 {
+		return -1, &ErrFavoriteNotFound{
+			ClientID:  o.clientID,
+			ProductID: productID,
+		}
+	}
+
+	return idx, nil
+}
+
+func (o *Ordering) sort() {
+	slices.SortStableFunc(o.ff, func(a, b Favorite) int {
+		return cmp.Or(cmp.Compare(a.Position, b.Position), cmp.Compare(a.ProductID, b.ProductID))
+	})
+}
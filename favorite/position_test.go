@@ -0,0 +1,145 @@
+package favorite_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/favorite/fixture"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+func TestOrdering(t *testing.T) {
+	t.Parallel()
+
+	clientID := uuid.NextID()
+	builder := fixture.AnyFavorite().WithClientID(clientID)
+
+	favorites := func(positions ...int64) []favorite.Favorite {
+		ff := make([]favorite.Favorite, 0, len(positions))
+		for i, p := range positions {
+			ff = append(ff, builder.WithProductID(i+1).WithPosition(p).Build())
+		}
+
+		return ff
+	}
+
+	type move struct {
+		productID int
+		targetID  int
+		after     bool
+	}
+
+	testCases := []struct {
+		about             string
+		favorites         []favorite.Favorite
+		arrange           []int
+		moves             []move
+		expectedErr       string
+		expectedOrder     []int
+		expectedPositions map[int]int64
+	}{
+		{
+			about:       "when arranging a product that isn't a favorite",
+			favorites:   favorites(1024, 2048),
+			arrange:     []int{2, 9},
+			expectedErr: "don't have the product with id '9' on their favorites",
+		},
+		{
+			about:             "when arranging the listed favorites swap their positions",
+			favorites:         favorites(1024, 2048, 3072),
+			arrange:           []int{3, 1},
+			expectedOrder:     []int{3, 2, 1},
+			expectedPositions: map[int]int64{3: 1024, 1: 3072},
+		},
+		{
+			about:       "when moving before a product that isn't a favorite",
+			favorites:   favorites(1024, 2048),
+			moves:       []move{{productID: 1, targetID: 9}},
+			expectedErr: "don't have the product with id '9' on their favorites",
+		},
+		{
+			about:             "when moving to the top",
+			favorites:         favorites(1024, 2048, 3072),
+			moves:             []move{{productID: 3, targetID: 1}},
+			expectedOrder:     []int{3, 1, 2},
+			expectedPositions: map[int]int64{3: 0},
+		},
+		{
+			about:             "when moving to the bottom",
+			favorites:         favorites(1024, 2048, 3072),
+			moves:             []move{{productID: 1, targetID: 3, after: true}},
+			expectedOrder:     []int{2, 3, 1},
+			expectedPositions: map[int]int64{1: 4096},
+		},
+		{
+			about:             "when moving between two favorites only the moved one changes",
+			favorites:         favorites(1024, 2048, 3072),
+			moves:             []move{{productID: 3, targetID: 1, after: true}},
+			expectedOrder:     []int{1, 3, 2},
+			expectedPositions: map[int]int64{3: 1536},
+		},
+		{
+			about:             "when there is no room between two favorites they are spread again",
+			favorites:         favorites(10, 11, 12),
+			moves:             []move{{productID: 3, targetID: 2}},
+			expectedOrder:     []int{1, 3, 2},
+			expectedPositions: map[int]int64{1: 1024, 3: 2048, 2: 3072},
+		},
+		{
+			about:         "when moving next to itself nothing changes",
+			favorites:     favorites(1024, 2048),
+			moves:         []move{{productID: 1, targetID: 1}},
+			expectedOrder: []int{1, 2},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			o := favorite.NewOrdering(clientID, tc.favorites)
+
+			// Action
+			var err error
+			if tc.arrange != nil {
+				err = o.Arrange(tc.arrange)
+			}
+
+			for _, m := range tc.moves {
+				if err = o.Move(m.productID, m.targetID, m.after); err != nil {
+					break
+				}
+			}
+
+			// Assert
+			if tc.expectedErr != "" {
+				assert.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+
+			assert.NoError(t, err)
+
+			order := make([]int, 0, len(tc.expectedOrder))
+			for _, f := range o.Favorites() {
+				order = append(order, f.ProductID)
+			}
+
+			assert.Equal(t, tc.expectedOrder, order)
+
+			changed := make(map[int]int64, len(tc.expectedPositions))
+			for _, f := range o.Changed() {
+				changed[f.ProductID] = f.Position
+			}
+
+			if len(tc.expectedPositions) == 0 {
+				assert.Empty(t, changed)
+				return
+			}
+
+			assert.Equal(t, tc.expectedPositions, changed)
+		})
+	}
+}
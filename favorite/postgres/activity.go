@@ -0,0 +1,87 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/uesleicarvalhoo/aiqfome/event"
+	eventPostgres "github.com/uesleicarvalhoo/aiqfome/event/postgres"
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+// saveEvents writes the events to the outbox and the activities they record to the history, both in the transaction of the change
+func saveEvents(ctx context.Context, tx *sql.Tx, ee []event.Event) error {
+	if err := eventPostgres.Save(ctx, tx, ee); err != nil {
+		return err
+	}
+
+	query := `
+	INSERT INTO favorite_activities(
+		client_id, product_id, product_title, action, actor_id, occurred_at
+	) VALUES (
+		$1, $2, $3, $4, $5, $6
+	)
+	`
+
+	for _, e := range ee {
+		a, ok := favorite.ActivityFromEvent(e)
+		if !ok {
+			continue
+		}
+
+		if _, err := tx.ExecContext(ctx, query, a.ClientID, a.ProductID, a.ProductTitle, a.Action, a.ActorID, a.OccurredAt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *repository) PaginateActivities(ctx context.Context, clientID uuid.ID, page, pageSize int) ([]favorite.Activity, int, error) {
+	query := `
+		SELECT
+			id, client_id, product_id, product_title, action, actor_id, occurred_at
+		FROM favorite_activities
+		WHERE client_id = $1
+		ORDER BY id DESC
+		LIMIT $2 OFFSET $3
+	`
+
+	queryCount := `SELECT count(*) FROM favorite_activities WHERE client_id = $1`
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, queryCount, clientID).Scan(&total); err != nil {
+		return []favorite.Activity{}, 0, err
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, clientID, pageSize, page*pageSize)
+	if err != nil {
+		return []favorite.Activity{}, 0, err
+	}
+	defer rows.Close()
+
+	aa := make([]favorite.Activity, 0, pageSize)
+	for rows.Next() {
+		var a favorite.Activity
+		if err := rows.Scan(
+			&a.ID,
+			&a.ClientID,
+			&a.ProductID,
+			&a.ProductTitle,
+			&a.Action,
+			&a.ActorID,
+			&a.OccurredAt,
+		); err != nil {
+			return []favorite.Activity{}, 0, err
+		}
+
+		aa = append(aa, a)
+	}
+
+	if err := rows.Err(); err != nil {
+		return []favorite.Activity{}, 0, err
+	}
+
+	return aa, total, nil
+}
@@ -0,0 +1,111 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgtype"
+	"github.com/uesleicarvalhoo/aiqfome/event"
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+)
+
+func (r *repository) AllByProductIDs(ctx context.Context, productIDs []int) ([]favorite.Favorite, error) {
+	query := `
+		SELECT
+			client_id, product_id, note, tags, price_when_favorited, title_when_favorited, registred_at
+		FROM favorites
+		WHERE
+			product_id = ANY($1)
+			AND deleted_at IS NULL
+		ORDER BY product_id, client_id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, int4Array(productIDs))
+	if err != nil {
+		return []favorite.Favorite{}, err
+	}
+	defer rows.Close()
+
+	ff := make([]favorite.Favorite, 0)
+	for rows.Next() {
+		var f favorite.Favorite
+		var tags pgtype.TextArray
+		if err := rows.Scan(
+			&f.ClientID,
+			&f.ProductID,
+			&f.Note,
+			&tags,
+			&f.PriceWhenFavorited,
+			&f.TitleWhenFavorited,
+			&f.RegistredAt,
+		); err != nil {
+			return []favorite.Favorite{}, err
+		}
+
+		if err := tags.AssignTo(&f.Tags); err != nil {
+			return []favorite.Favorite{}, err
+		}
+
+		ff = append(ff, f)
+	}
+
+	if err := rows.Err(); err != nil {
+		return []favorite.Favorite{}, err
+	}
+
+	return ff, nil
+}
+
+func (r *repository) Archive(ctx context.Context, ff []favorite.Favorite, ee ...event.Event) error {
+	query := `
+	WITH` + changeSeqCTE + `,
+	archived AS (
+		DELETE FROM favorites
+		WHERE client_id = $1 AND product_id = $2 AND deleted_at IS NULL
+		RETURNING client_id, product_id, note, tags, price_when_favorited, title_when_favorited, registred_at
+	),
+	tombstone AS (
+		INSERT INTO favorite_tombstones (client_id, product_id, change_seq)
+		SELECT client_id, product_id, (SELECT last_seq FROM seq) FROM archived
+		ON CONFLICT (client_id, product_id) DO UPDATE SET
+			change_seq = EXCLUDED.change_seq,
+			removed_at = NOW()
+	),
+	changed AS (
+		SELECT product_id FROM archived
+	),` + bumpChangeSeqCTE + `
+	INSERT INTO archived_favorites
+		(client_id, product_id, note, tags, price_when_favorited, title_when_favorited, registred_at)
+	SELECT * FROM archived
+	ON CONFLICT (client_id, product_id) DO UPDATE SET
+		note = EXCLUDED.note,
+		tags = EXCLUDED.tags,
+		price_when_favorited = EXCLUDED.price_when_favorited,
+		title_when_favorited = EXCLUDED.title_when_favorited,
+		registred_at = EXCLUDED.registred_at,
+		archived_at = NOW()
+	`
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() //nolint: errcheck
+
+	stmt, err := tx.PrepareContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, f := range ff {
+		if _, err := stmt.ExecContext(ctx, f.ClientID, f.ProductID); err != nil {
+			return err
+		}
+	}
+
+	if err := saveEvents(ctx, tx, ee); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,138 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/jackc/pgtype"
+	"github.com/uesleicarvalhoo/aiqfome/event"
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+func (r *repository) FindMultiple(ctx context.Context, clientID uuid.ID, productIDs []int) ([]favorite.Favorite, error) {
+	query := `
+		SELECT
+			client_id, product_id, note, tags, price_when_favorited, title_when_favorited, registred_at, position
+		FROM favorites
+		WHERE
+			client_id = $1
+			AND product_id = ANY($2)
+			AND deleted_at IS NULL
+		ORDER BY product_id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, clientID, int4Array(productIDs))
+	if err != nil {
+		return []favorite.Favorite{}, err
+	}
+	defer rows.Close()
+
+	ff := make([]favorite.Favorite, 0, len(productIDs))
+	for rows.Next() {
+		var f favorite.Favorite
+		var tags pgtype.TextArray
+		if err := rows.Scan(
+			&f.ClientID,
+			&f.ProductID,
+			&f.Note,
+			&tags,
+			&f.PriceWhenFavorited,
+			&f.TitleWhenFavorited,
+			&f.RegistredAt,
+			&f.Position,
+		); err != nil {
+			return []favorite.Favorite{}, err
+		}
+
+		if err := tags.AssignTo(&f.Tags); err != nil {
+			return []favorite.Favorite{}, err
+		}
+
+		ff = append(ff, f)
+	}
+
+	if err := rows.Err(); err != nil {
+		return []favorite.Favorite{}, err
+	}
+
+	return ff, nil
+}
+
+func createMany(ctx context.Context, tx *sql.Tx, ff []favorite.Favorite) error {
+	stmt, err := tx.PrepareContext(ctx, createFavoriteQuery)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, f := range ff {
+		if _, err := stmt.ExecContext(ctx, f.ClientID, f.ProductID, f.Note, textArray(f.Tags), f.PriceWhenFavorited, f.TitleWhenFavorited, f.RegistredAt, favorite.PositionGap); err != nil {
+			if isUniqueViolation(err) {
+				return &favorite.ErrAlreadyFavorite{
+					ClientID:  f.ClientID,
+					ProductID: f.ProductID,
+				}
+			}
+			return err
+		}
+	}
+
+	return countActivity(ctx, tx, len(ff), 0)
+}
+
+func (r *repository) RemoveMany(ctx context.Context, ff []favorite.Favorite, ee ...event.Event) error {
+	query := `
+	WITH` + changeSeqCTE + `,
+	changed AS (
+		UPDATE favorites
+			SET deleted_at = NOW(), change_seq = (SELECT last_seq FROM seq)
+		WHERE client_id = $1 AND product_id = $2 AND deleted_at IS NULL
+		RETURNING product_id
+	),` + bumpChangeSeqCTE + `
+	SELECT count(*) FROM changed
+	`
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() //nolint: errcheck
+
+	stmt, err := tx.PrepareContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	var removed int
+	for _, f := range ff {
+		var n int
+		if err := stmt.QueryRowContext(ctx, f.ClientID, f.ProductID).Scan(&n); err != nil {
+			return err
+		}
+
+		removed += n
+	}
+
+	if err := countActivity(ctx, tx, 0, removed); err != nil {
+		return err
+	}
+
+	if err := saveEvents(ctx, tx, ee); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func int4Array(ii []int) pgtype.Int4Array {
+	var arr pgtype.Int4Array
+	if ii == nil {
+		ii = []int{}
+	}
+
+	_ = arr.Set(ii)
+
+	return arr
+}
@@ -0,0 +1,89 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+
+	"github.com/jackc/pgtype"
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+func (r *repository) ScrollByClientID(ctx context.Context, clientID uuid.ID, filter favorite.Filter, cursor favorite.Cursor, limit int) ([]favorite.Favorite, error) {
+	queryNext := `
+		SELECT
+			client_id, product_id, note, tags, price_when_favorited, title_when_favorited, registred_at, position
+		FROM favorites
+		WHERE
+			client_id = $1
+			AND deleted_at IS NULL
+			AND ($2::TEXT = '' OR $2::TEXT = ANY(tags))
+			AND ($3::TIMESTAMPTZ IS NULL OR (registred_at, product_id) < ($3::TIMESTAMPTZ, $4::INT))
+		ORDER BY registred_at DESC, product_id DESC
+		LIMIT $5
+	`
+
+	queryPrev := `
+		SELECT
+			client_id, product_id, note, tags, price_when_favorited, title_when_favorited, registred_at, position
+		FROM favorites
+		WHERE
+			client_id = $1
+			AND deleted_at IS NULL
+			AND ($2::TEXT = '' OR $2::TEXT = ANY(tags))
+			AND (registred_at, product_id) > ($3::TIMESTAMPTZ, $4::INT)
+		ORDER BY registred_at ASC, product_id ASC
+		LIMIT $5
+	`
+
+	query := queryNext
+	if cursor.Direction == favorite.DirectionPrev {
+		query = queryPrev
+	}
+
+	var after sql.NullTime
+	if !cursor.IsZero() {
+		after = sql.NullTime{Time: cursor.RegistredAt, Valid: true}
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, clientID, filter.Tag, after, cursor.ProductID, limit)
+	if err != nil {
+		return []favorite.Favorite{}, err
+	}
+	defer rows.Close()
+
+	ff := make([]favorite.Favorite, 0, limit)
+	for rows.Next() {
+		var f favorite.Favorite
+		var tags pgtype.TextArray
+		if err := rows.Scan(
+			&f.ClientID,
+			&f.ProductID,
+			&f.Note,
+			&tags,
+			&f.PriceWhenFavorited,
+			&f.TitleWhenFavorited,
+			&f.RegistredAt,
+			&f.Position,
+		); err != nil {
+			return []favorite.Favorite{}, err
+		}
+
+		if err := tags.AssignTo(&f.Tags); err != nil {
+			return []favorite.Favorite{}, err
+		}
+
+		ff = append(ff, f)
+	}
+
+	if err := rows.Err(); err != nil {
+		return []favorite.Favorite{}, err
+	}
+
+	if cursor.Direction == favorite.DirectionPrev {
+		slices.Reverse(ff)
+	}
+
+	return ff, nil
+}
@@ -0,0 +1,237 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+func (r *repository) FindList(ctx context.Context, clientID, listID uuid.ID) (favorite.List, error) {
+	query := `
+		SELECT
+			id, client_id, name, created_at, updated_at
+		FROM favorite_lists
+		WHERE
+			id = $1
+			AND client_id = $2
+		`
+
+	var l favorite.List
+	if err := r.db.QueryRowContext(ctx, query, listID, clientID).Scan(
+		&l.ID,
+		&l.ClientID,
+		&l.Name,
+		&l.CreatedAt,
+		&l.UpdatedAt,
+	); err != nil {
+		if err == sql.ErrNoRows {
+			return favorite.List{}, &favorite.ErrListNotFound{
+				ClientID: clientID,
+				ListID:   listID,
+			}
+		}
+		return favorite.List{}, err
+	}
+
+	return l, nil
+}
+
+func (r *repository) ListsByClientID(ctx context.Context, clientID uuid.ID) ([]favorite.List, error) {
+	query := `
+		SELECT
+			id, client_id, name, created_at, updated_at
+		FROM favorite_lists
+		WHERE
+			client_id = $1
+		ORDER BY created_at
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, clientID)
+	if err != nil {
+		return []favorite.List{}, err
+	}
+	defer rows.Close()
+
+	ll := make([]favorite.List, 0)
+	for rows.Next() {
+		var l favorite.List
+		if err := rows.Scan(
+			&l.ID,
+			&l.ClientID,
+			&l.Name,
+			&l.CreatedAt,
+			&l.UpdatedAt,
+		); err != nil {
+			return []favorite.List{}, err
+		}
+
+		ll = append(ll, l)
+	}
+
+	if err := rows.Err(); err != nil {
+		return []favorite.List{}, err
+	}
+
+	return ll, nil
+}
+
+func (r *repository) FindListItem(ctx context.Context, listID uuid.ID, productID int) (favorite.ListItem, error) {
+	query := `
+		SELECT
+			list_id, product_id, added_at
+		FROM favorite_list_items
+		WHERE
+			list_id = $1
+			AND product_id = $2
+		`
+
+	var i favorite.ListItem
+	if err := r.db.QueryRowContext(ctx, query, listID, productID).Scan(
+		&i.ListID,
+		&i.ProductID,
+		&i.AddedAt,
+	); err != nil {
+		if err == sql.ErrNoRows {
+			return favorite.ListItem{}, &favorite.ErrListItemNotFound{
+				ListID:    listID,
+				ProductID: productID,
+			}
+		}
+		return favorite.ListItem{}, err
+	}
+
+	return i, nil
+}
+
+func (r *repository) PaginateListItems(ctx context.Context, listID uuid.ID, page, pageSize int) ([]favorite.ListItem, int, error) {
+	query := `
+		SELECT
+			list_id, product_id, added_at
+		FROM favorite_list_items
+		WHERE
+			list_id = $1
+		ORDER BY added_at, product_id
+		LIMIT $2 OFFSET $3
+	`
+
+	queryCount := `
+		SELECT count(*) FROM favorite_list_items
+		WHERE list_id = $1
+	`
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, queryCount, listID).Scan(&total); err != nil {
+		return []favorite.ListItem{}, 0, err
+	}
+
+	offset := page * pageSize
+
+	rows, err := r.db.QueryContext(ctx, query, listID, pageSize, offset)
+	if err != nil {
+		return []favorite.ListItem{}, 0, err
+	}
+	defer rows.Close()
+
+	ii := make([]favorite.ListItem, 0, pageSize)
+	for rows.Next() {
+		var i favorite.ListItem
+		if err := rows.Scan(
+			&i.ListID,
+			&i.ProductID,
+			&i.AddedAt,
+		); err != nil {
+			return []favorite.ListItem{}, 0, err
+		}
+
+		ii = append(ii, i)
+	}
+
+	if err := rows.Err(); err != nil {
+		return []favorite.ListItem{}, 0, err
+	}
+
+	return ii, total, nil
+}
+
+func (r *repository) CreateList(ctx context.Context, l favorite.List) error {
+	query := `
+	INSERT INTO favorite_lists(
+		id, client_id, name, created_at, updated_at
+	) VALUES (
+		$1, $2, $3, $4, $5
+	)
+	`
+
+	_, err := r.db.ExecContext(ctx, query, l.ID, l.ClientID, l.Name, l.CreatedAt, l.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repository) UpdateList(ctx context.Context, l favorite.List) error {
+	query := `
+	UPDATE favorite_lists
+		SET name = $3, updated_at = $4
+	WHERE id = $1 AND client_id = $2
+	`
+
+	_, err := r.db.ExecContext(ctx, query, l.ID, l.ClientID, l.Name, l.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repository) DeleteList(ctx context.Context, l favorite.List) error {
+	query := `
+	DELETE FROM favorite_lists WHERE id = $1 AND client_id = $2
+	`
+
+	_, err := r.db.ExecContext(ctx, query, l.ID, l.ClientID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repository) AddListItem(ctx context.Context, i favorite.ListItem) error {
+	query := `
+	INSERT INTO favorite_list_items(
+		list_id, product_id, added_at
+	) VALUES (
+		$1, $2, $3
+	)
+	`
+
+	_, err := r.db.ExecContext(ctx, query, i.ListID, i.ProductID, i.AddedAt)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return &favorite.ErrListItemAlreadyExists{
+				ListID:    i.ListID,
+				ProductID: i.ProductID,
+			}
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *repository) RemoveListItem(ctx context.Context, i favorite.ListItem) error {
+	query := `
+	DELETE FROM favorite_list_items WHERE list_id = $1 AND product_id = $2
+	`
+
+	_, err := r.db.ExecContext(ctx, query, i.ListID, i.ProductID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
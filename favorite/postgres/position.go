@@ -0,0 +1,41 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+func (r *repository) UpdatePositions(ctx context.Context, clientID uuid.ID, ff []favorite.Favorite) error {
+	query := `
+	WITH` + changeSeqCTE + `,
+	changed AS (
+		UPDATE favorites
+			SET position = $3, change_seq = (SELECT last_seq FROM seq)
+		WHERE client_id = $1 AND product_id = $2 AND deleted_at IS NULL
+		RETURNING product_id
+	),` + bumpChangeSeqCTE + `
+	SELECT count(*) FROM changed
+	`
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() //nolint: errcheck
+
+	stmt, err := tx.PrepareContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, f := range ff {
+		if _, err := stmt.ExecContext(ctx, clientID, f.ProductID, f.Position); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
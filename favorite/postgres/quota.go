@@ -0,0 +1,217 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/uesleicarvalhoo/aiqfome/event"
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+func (r *repository) CountByClientID(ctx context.Context, clientID uuid.ID) (int, error) {
+	return countByClientID(ctx, r.db, clientID)
+}
+
+type queryRower interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+func countByClientID(ctx context.Context, db queryRower, clientID uuid.ID) (int, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM favorites
+		WHERE
+			client_id = $1
+			AND deleted_at IS NULL
+	`
+
+	var count int
+	if err := db.QueryRowContext(ctx, query, clientID).Scan(&count); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *repository) FindQuota(ctx context.Context, clientID uuid.ID) (favorite.Quota, error) {
+	query := `
+		SELECT
+			client_id, max_favorites, updated_at
+		FROM favorite_quotas
+		WHERE
+			client_id = $1
+		`
+
+	var q favorite.Quota
+	if err := r.db.QueryRowContext(ctx, query, clientID).Scan(
+		&q.ClientID,
+		&q.MaxFavorites,
+		&q.UpdatedAt,
+	); err != nil {
+		if err == sql.ErrNoRows {
+			return favorite.Quota{}, &favorite.ErrQuotaNotFound{
+				ClientID: clientID,
+			}
+		}
+		return favorite.Quota{}, err
+	}
+
+	return q, nil
+}
+
+func (r *repository) CreateWithinQuota(ctx context.Context, clientID uuid.ID, ff []favorite.Favorite, limit int, ee ...event.Event) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() //nolint: errcheck
+
+	// Concurrent requests of the same client wait here until the transaction ends, so the count can't get stale
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock(hashtext($1::TEXT))", clientID.String()); err != nil {
+		return err
+	}
+
+	if limit > 0 {
+		count, err := countByClientID(ctx, tx, clientID)
+		if err != nil {
+			return err
+		}
+
+		if count+len(ff) > limit {
+			return &favorite.ErrQuotaExceeded{
+				ClientID: clientID,
+				Limit:    limit,
+				Count:    count,
+			}
+		}
+	}
+
+	if err := createMany(ctx, tx, ff); err != nil {
+		return err
+	}
+
+	if err := saveEvents(ctx, tx, ee); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *repository) UpsertWithinQuota(ctx context.Context, f favorite.Favorite, limit int, ee ...event.Event) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback() //nolint: errcheck
+
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock(hashtext($1::TEXT))", f.ClientID.String()); err != nil {
+		return false, err
+	}
+
+	var created bool
+	if err := tx.QueryRowContext(ctx, upsertFavoriteQuery,
+		f.ClientID, f.ProductID, f.Note, textArray(f.Tags), f.PriceWhenFavorited, f.TitleWhenFavorited, f.RegistredAt, favorite.PositionGap,
+	).Scan(&created); err != nil {
+		return false, err
+	}
+
+	if !created {
+		return false, tx.Commit()
+	}
+
+	if err := countActivity(ctx, tx, 1, 0); err != nil {
+		return false, err
+	}
+
+	if limit > 0 {
+		count, err := countByClientID(ctx, tx, f.ClientID)
+		if err != nil {
+			return false, err
+		}
+
+		if count > limit {
+			return false, &favorite.ErrQuotaExceeded{
+				ClientID: f.ClientID,
+				Limit:    limit,
+				Count:    count - 1,
+			}
+		}
+	}
+
+	if err := saveEvents(ctx, tx, ee); err != nil {
+		return false, err
+	}
+
+	return true, tx.Commit()
+}
+
+func (r *repository) RestoreWithinQuota(ctx context.Context, f favorite.Favorite, limit int, ee ...event.Event) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() //nolint: errcheck
+
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock(hashtext($1::TEXT))", f.ClientID.String()); err != nil {
+		return err
+	}
+
+	if limit > 0 {
+		count, err := countByClientID(ctx, tx, f.ClientID)
+		if err != nil {
+			return err
+		}
+
+		if count+1 > limit {
+			return &favorite.ErrQuotaExceeded{
+				ClientID: f.ClientID,
+				Limit:    limit,
+				Count:    count,
+			}
+		}
+	}
+
+	if err := restore(ctx, tx, f); err != nil {
+		return err
+	}
+
+	if err := saveEvents(ctx, tx, ee); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *repository) SaveQuota(ctx context.Context, q favorite.Quota) error {
+	query := `
+	INSERT INTO favorite_quotas(
+		client_id, max_favorites, updated_at
+	) VALUES (
+		$1, $2, $3
+	)
+	ON CONFLICT (client_id) DO UPDATE
+		SET max_favorites = EXCLUDED.max_favorites, updated_at = EXCLUDED.updated_at
+	`
+
+	_, err := r.db.ExecContext(ctx, query, q.ClientID, q.MaxFavorites, q.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repository) DeleteQuota(ctx context.Context, clientID uuid.ID) error {
+	query := `
+	DELETE FROM favorite_quotas
+	WHERE client_id = $1
+	`
+
+	_, err := r.db.ExecContext(ctx, query, clientID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+func (r *repository) RecommendByClientID(ctx context.Context, clientID uuid.ID, limit int) ([]favorite.Recommendation, error) {
+	query := `
+		SELECT
+			s.similar_product_id, SUM(s.score) AS score
+		FROM product_similarities s
+		JOIN favorites f ON f.product_id = s.product_id AND f.client_id = $1 AND f.deleted_at IS NULL
+		WHERE NOT EXISTS (
+			SELECT 1 FROM favorites o
+			WHERE o.client_id = $1 AND o.product_id = s.similar_product_id AND o.deleted_at IS NULL
+		)
+		GROUP BY s.similar_product_id
+		ORDER BY score DESC, s.similar_product_id
+		LIMIT $2
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, clientID, limit)
+	if err != nil {
+		return []favorite.Recommendation{}, err
+	}
+	defer rows.Close()
+
+	rr := make([]favorite.Recommendation, 0, limit)
+	for rows.Next() {
+		var rc favorite.Recommendation
+		if err := rows.Scan(&rc.ProductID, &rc.Score); err != nil {
+			return []favorite.Recommendation{}, err
+		}
+
+		rr = append(rr, rc)
+	}
+
+	if err := rows.Err(); err != nil {
+		return []favorite.Recommendation{}, err
+	}
+
+	return rr, nil
+}
+
+// RefreshSimilarities scores each pair of products by the cosine similarity of the clients who favorited them,
+// the matrix is replaced in a transaction so readers never see it half built
+func (r *repository) RefreshSimilarities(ctx context.Context, maxPerProduct int) (int, error) {
+	query := `
+	WITH totals AS (
+		SELECT product_id, COUNT(*)::DOUBLE PRECISION AS total
+		FROM favorites
+		WHERE deleted_at IS NULL
+		GROUP BY product_id
+	)
+	INSERT INTO product_similarities (product_id, similar_product_id, score)
+	SELECT product_id, similar_product_id, score
+	FROM (
+		SELECT
+			a.product_id,
+			b.product_id AS similar_product_id,
+			COUNT(*) / SQRT(ca.total * cb.total) AS score,
+			ROW_NUMBER() OVER (
+				PARTITION BY a.product_id
+				ORDER BY COUNT(*) / SQRT(ca.total * cb.total) DESC, b.product_id
+			) AS similarity_rank
+		FROM favorites a
+		JOIN favorites b ON b.client_id = a.client_id AND b.product_id <> a.product_id AND b.deleted_at IS NULL
+		JOIN totals ca ON ca.product_id = a.product_id
+		JOIN totals cb ON cb.product_id = b.product_id
+		WHERE a.deleted_at IS NULL
+		GROUP BY a.product_id, b.product_id, ca.total, cb.total
+	) pairs
+	WHERE similarity_rank <= $1
+	`
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback() //nolint: errcheck
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM product_similarities`); err != nil {
+		return 0, err
+	}
+
+	res, err := tx.ExecContext(ctx, query, maxPerProduct)
+	if err != nil {
+		return 0, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(affected), tx.Commit()
+}
@@ -3,11 +3,66 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"fmt"
 
+	"github.com/jackc/pgtype"
+	"github.com/uesleicarvalhoo/aiqfome/event"
 	"github.com/uesleicarvalhoo/aiqfome/favorite"
 	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
 )
 
+// restoreFavoriteCTE restores the favorite when it is in the trash, either way it goes to the top of the manual ordering,
+// $8 places it the gap above the first favorite
+const restoreFavoriteCTE = `
+	WITH` + changeSeqCTE + `,
+	top AS (
+		SELECT COALESCE(MIN(position), 0) - $8::BIGINT AS position
+		FROM favorites
+		WHERE client_id = $1 AND deleted_at IS NULL
+	),
+	restored AS (
+		UPDATE favorites
+			SET
+				deleted_at = NULL,
+				price_when_favorited = COALESCE(price_when_favorited, $5::NUMERIC),
+				title_when_favorited = COALESCE(NULLIF(title_when_favorited, ''), $6::TEXT),
+				change_seq = (SELECT last_seq FROM seq),
+				position = (SELECT position FROM top)
+		WHERE client_id = $1 AND product_id = $2 AND deleted_at IS NOT NULL
+		RETURNING product_id
+	)`
+
+const insertFavoriteQuery = `
+	INSERT INTO favorites(
+		client_id, product_id, note, tags, price_when_favorited, title_when_favorited, registred_at, change_seq, position
+	)
+	SELECT $1, $2, $3::TEXT, $4::TEXT[], $5::NUMERIC, $6::TEXT, $7::TIMESTAMPTZ, (SELECT last_seq FROM seq), (SELECT position FROM top)
+	WHERE NOT EXISTS (SELECT 1 FROM restored)
+	`
+
+// favoriteChangedQuery ends the restore or insert of a favorite, it returns whether the favorite was restored or inserted
+const favoriteChangedQuery = `,
+	changed AS (
+		SELECT product_id FROM restored
+		UNION ALL
+		SELECT product_id FROM inserted
+	),` + bumpChangeSeqCTE + `
+	SELECT EXISTS (SELECT 1 FROM changed)
+	`
+
+// createFavoriteQuery restores the favorite when it is in the trash, otherwise inserts it
+const createFavoriteQuery = restoreFavoriteCTE + `,
+	inserted AS (` + insertFavoriteQuery + `
+		RETURNING product_id
+	)` + favoriteChangedQuery
+
+// upsertFavoriteQuery works like createFavoriteQuery but keeps the favorite as is when it already exists
+const upsertFavoriteQuery = restoreFavoriteCTE + `,
+	inserted AS (` + insertFavoriteQuery + `
+		ON CONFLICT (client_id, product_id) DO NOTHING
+		RETURNING product_id
+	)` + favoriteChangedQuery
+
 type repository struct {
 	db *sql.DB
 }
@@ -21,18 +76,25 @@ func NewRepository(db *sql.DB) favorite.Repository {
 func (r *repository) Find(ctx context.Context, clientID uuid.ID, productID int) (favorite.Favorite, error) {
 	query := `
 		SELECT
-			client_id, product_id, registred_at
+			client_id, product_id, note, tags, price_when_favorited, title_when_favorited, registred_at, position
 		FROM favorites
 		WHERE
 			client_id = $1
 			AND product_id = $2
+			AND deleted_at IS NULL
 		`
 
 	var f favorite.Favorite
+	var tags pgtype.TextArray
 	if err := r.db.QueryRowContext(ctx, query, clientID, productID).Scan(
 		&f.ClientID,
 		&f.ProductID,
+		&f.Note,
+		&tags,
+		&f.PriceWhenFavorited,
+		&f.TitleWhenFavorited,
 		&f.RegistredAt,
+		&f.Position,
 	); err != nil {
 		if err == sql.ErrNoRows {
 			return favorite.Favorite{}, &favorite.ErrFavoriteNotFound{
@@ -43,64 +105,140 @@ func (r *repository) Find(ctx context.Context, clientID uuid.ID, productID int)
 		return favorite.Favorite{}, err
 	}
 
+	if err := tags.AssignTo(&f.Tags); err != nil {
+		return favorite.Favorite{}, err
+	}
+
 	return f, nil
 }
 
-func (r *repository) PaginateByClientID(ctx context.Context, clientID uuid.ID, page, pageSize int) ([]favorite.Favorite, int, error) {
+func (r *repository) PaginateByClientID(ctx context.Context, clientID uuid.ID, filter favorite.Filter, page, pageSize int) ([]favorite.Favorite, int, error) {
 	query := `
 		SELECT
-			client_id, product_id, registred_at
+			client_id, product_id, note, tags, price_when_favorited, title_when_favorited, registred_at, position
 		FROM favorites
 		WHERE
 			client_id = $1
-		ORDER BY product_id
-		LIMIT $2 OFFSET $3
+			AND deleted_at IS NULL
+			AND ($2::TEXT = '' OR $2::TEXT = ANY(tags))
+		ORDER BY ` + orderBy(filter) + `
+		LIMIT $3 OFFSET $4
 	`
 
 	queryCount := `
 		SELECT count(*) FROM favorites
-		WHERE client_id = $1
+		WHERE
+			client_id = $1
+			AND deleted_at IS NULL
+			AND ($2::TEXT = '' OR $2::TEXT = ANY(tags))
 	`
 
 	var total int
-	if err := r.db.QueryRow(queryCount, clientID).Scan(&total); err != nil {
+	if err := r.db.QueryRowContext(ctx, queryCount, clientID, filter.Tag).Scan(&total); err != nil {
 		return []favorite.Favorite{}, 0, err
 	}
 
 	offset := page * pageSize
 
-	rows, err := r.db.QueryContext(ctx, query, clientID, pageSize, offset)
+	rows, err := r.db.QueryContext(ctx, query, clientID, filter.Tag, pageSize, offset)
 	if err != nil {
 		return []favorite.Favorite{}, 0, err
 	}
+	defer rows.Close()
 
 	var ff []favorite.Favorite
 	for rows.Next() {
 		var f favorite.Favorite
+		var tags pgtype.TextArray
 		if err := rows.Scan(
 			&f.ClientID,
 			&f.ProductID,
+			&f.Note,
+			&tags,
+			&f.PriceWhenFavorited,
+			&f.TitleWhenFavorited,
 			&f.RegistredAt,
+			&f.Position,
 		); err != nil {
 			return []favorite.Favorite{}, 0, err
 		}
 
+		if err := tags.AssignTo(&f.Tags); err != nil {
+			return []favorite.Favorite{}, 0, err
+		}
+
 		ff = append(ff, f)
 	}
 
+	if err := rows.Err(); err != nil {
+		return []favorite.Favorite{}, 0, err
+	}
+
 	return ff, total, nil
 }
 
-func (r *repository) Create(ctx context.Context, f favorite.Favorite) error {
+func (r *repository) AllByClientID(ctx context.Context, clientID uuid.ID, filter favorite.Filter) ([]favorite.Favorite, error) {
 	query := `
-	INSERT INTO favorites(
-		client_id, product_id, registred_at
-	) VALUES (
-	 $1, $2, $3
-	 )
+		SELECT
+			client_id, product_id, note, tags, price_when_favorited, title_when_favorited, registred_at, position
+		FROM favorites
+		WHERE
+			client_id = $1
+			AND deleted_at IS NULL
+			AND ($2::TEXT = '' OR $2::TEXT = ANY(tags))
+		ORDER BY ` + orderBy(filter) + `
 	`
 
-	_, err := r.db.ExecContext(ctx, query, f.ClientID, f.ProductID, f.RegistredAt)
+	rows, err := r.db.QueryContext(ctx, query, clientID, filter.Tag)
+	if err != nil {
+		return []favorite.Favorite{}, err
+	}
+	defer rows.Close()
+
+	ff := make([]favorite.Favorite, 0)
+	for rows.Next() {
+		var f favorite.Favorite
+		var tags pgtype.TextArray
+		if err := rows.Scan(
+			&f.ClientID,
+			&f.ProductID,
+			&f.Note,
+			&tags,
+			&f.PriceWhenFavorited,
+			&f.TitleWhenFavorited,
+			&f.RegistredAt,
+			&f.Position,
+		); err != nil {
+			return []favorite.Favorite{}, err
+		}
+
+		if err := tags.AssignTo(&f.Tags); err != nil {
+			return []favorite.Favorite{}, err
+		}
+
+		ff = append(ff, f)
+	}
+
+	if err := rows.Err(); err != nil {
+		return []favorite.Favorite{}, err
+	}
+
+	return ff, nil
+}
+
+func (r *repository) Update(ctx context.Context, f favorite.Favorite) error {
+	query := `
+	WITH` + changeSeqCTE + `,
+	changed AS (
+		UPDATE favorites
+			SET note = $3, tags = $4, change_seq = (SELECT last_seq FROM seq)
+		WHERE client_id = $1 AND product_id = $2 AND deleted_at IS NULL
+		RETURNING product_id
+	),` + bumpChangeSeqCTE + `
+	SELECT count(*) FROM changed
+	`
+
+	_, err := r.db.ExecContext(ctx, query, f.ClientID, f.ProductID, f.Note, textArray(f.Tags))
 	if err != nil {
 		return err
 	}
@@ -108,15 +246,63 @@ func (r *repository) Create(ctx context.Context, f favorite.Favorite) error {
 	return nil
 }
 
-func (r *repository) Remove(ctx context.Context, f favorite.Favorite) error {
+func (r *repository) Remove(ctx context.Context, f favorite.Favorite, ee ...event.Event) error {
 	query := `
-	DELETE FROM favorites WHERE client_id = $1 and product_id = $2
+	WITH` + changeSeqCTE + `,
+	changed AS (
+		UPDATE favorites
+			SET deleted_at = NOW(), change_seq = (SELECT last_seq FROM seq)
+		WHERE client_id = $1 AND product_id = $2 AND deleted_at IS NULL
+		RETURNING product_id
+	),` + bumpChangeSeqCTE + `
+	SELECT count(*) FROM changed
 	`
 
-	_, err := r.db.ExecContext(ctx, query, f.ClientID, f.ProductID)
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
+	defer tx.Rollback() //nolint: errcheck
 
-	return nil
+	var removed int
+	if err := tx.QueryRowContext(ctx, query, f.ClientID, f.ProductID).Scan(&removed); err != nil {
+		return err
+	}
+
+	if err := countActivity(ctx, tx, 0, removed); err != nil {
+		return err
+	}
+
+	if err := saveEvents(ctx, tx, ee); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func textArray(ss []string) pgtype.TextArray {
+	var arr pgtype.TextArray
+	if ss == nil {
+		ss = []string{}
+	}
+
+	_ = arr.Set(ss)
+
+	return arr
+}
+
+func orderBy(filter favorite.Filter) string {
+	direction := "ASC"
+	if filter.Desc {
+		direction = "DESC"
+	}
+
+	switch filter.Order {
+	case favorite.OrderByRegistredAt:
+		return fmt.Sprintf("registred_at %s, product_id %s", direction, direction)
+	case favorite.OrderByPosition:
+		return fmt.Sprintf("position %s, product_id %s", direction, direction)
+	default:
+		return fmt.Sprintf("product_id %s", direction)
+	}
 }
@@ -3,19 +3,24 @@ package postgres_test
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"slices"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"github.com/uesleicarvalhoo/aiqfome/event"
+	postgresEvent "github.com/uesleicarvalhoo/aiqfome/event/postgres"
 	"github.com/uesleicarvalhoo/aiqfome/favorite"
 	"github.com/uesleicarvalhoo/aiqfome/favorite/fixture"
 	"github.com/uesleicarvalhoo/aiqfome/favorite/postgres"
 	"github.com/uesleicarvalhoo/aiqfome/internal/infra/database"
 	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
 	"github.com/uesleicarvalhoo/aiqfome/test"
+	"github.com/uesleicarvalhoo/aiqfome/user"
 	fixtureUser "github.com/uesleicarvalhoo/aiqfome/user/fixture"
 	postgresUser "github.com/uesleicarvalhoo/aiqfome/user/postgres"
 )
@@ -28,6 +33,11 @@ type TestSuitePostgresRepository struct {
 	repo      favorite.Repository
 }
 
+// create adds the favorites without a quota, they may belong to several clients
+func (s *TestSuitePostgresRepository) create(ff ...favorite.Favorite) error {
+	return s.repo.CreateWithinQuota(s.ctx, ff[0].ClientID, ff, 0)
+}
+
 func TestFavoriteRepository(t *testing.T) {
 	t.Parallel()
 
@@ -101,7 +111,7 @@ func (s *TestSuitePostgresRepository) TestCRUD() {
 			about:    "when product already is vinculated to client",
 			favorite: favoriteBuilder.Build(),
 			setup: func() {
-				require.NoError(s.T(), s.repo.Create(s.ctx, favoriteBuilder.Build()), "failed to create favorite")
+				require.NoError(s.T(), s.create(favoriteBuilder.Build()), "failed to create favorite")
 			},
 			expectedErr: "SQLSTATE 23505",
 			teardown: func() {
@@ -115,6 +125,10 @@ func (s *TestSuitePostgresRepository) TestCRUD() {
 				require.NoError(s.T(), s.repo.Remove(s.ctx, favoriteBuilder.Build()), "failed to remove favorite before create it")
 			},
 		},
+		{
+			about:    "when favorite has the product snapshot",
+			favorite: favoriteBuilder.WithProductID(2).WithSnapshot("Notebook", 109.95).Build(),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -126,7 +140,7 @@ func (s *TestSuitePostgresRepository) TestCRUD() {
 				defer tc.teardown()
 			}
 
-			err := s.repo.Create(s.ctx, tc.favorite)
+			err := s.create(tc.favorite)
 
 			if tc.expectedErr != "" {
 				assert.ErrorContains(t, err, tc.expectedErr)
@@ -137,6 +151,8 @@ func (s *TestSuitePostgresRepository) TestCRUD() {
 
 				assert.Equal(s.T(), tc.favorite.ClientID, found.ClientID)
 				assert.Equal(s.T(), tc.favorite.ProductID, found.ProductID)
+				assert.Equal(s.T(), tc.favorite.PriceWhenFavorited, found.PriceWhenFavorited)
+				assert.Equal(s.T(), tc.favorite.TitleWhenFavorited, found.TitleWhenFavorited)
 			}
 		})
 	}
@@ -157,6 +173,7 @@ func (s *TestSuitePostgresRepository) TestPaginateByClientID() {
 		setup             func()
 		teardown          func()
 		clientID          uuid.ID
+		filter            favorite.Filter
 		page              int
 		pageSize          int
 		expectedTotal     int
@@ -179,7 +196,7 @@ func (s *TestSuitePostgresRepository) TestPaginateByClientID() {
 			expectedTotal:     0,
 			expectedFavorites: []favorite.Favorite{},
 			setup: func() {
-				require.NoError(s.T(), s.repo.Create(s.ctx, favoriteBuilder.WithClientID(anotherUsr.ID).Build()), "failed to create favorite")
+				require.NoError(s.T(), s.create(favoriteBuilder.WithClientID(anotherUsr.ID).Build()), "failed to create favorite")
 			},
 			teardown: func() {
 				require.NoError(s.T(), s.repo.Remove(s.ctx, favoriteBuilder.WithClientID(anotherUsr.ID).Build()), "failed to remove favorite before create it")
@@ -196,10 +213,10 @@ func (s *TestSuitePostgresRepository) TestPaginateByClientID() {
 				favoriteBuilder.WithProductID(2).Build(),
 			},
 			setup: func() {
-				require.NoError(s.T(), s.repo.Create(s.ctx, favoriteBuilder.WithProductID(1).Build()), "failed to create favorite")
-				require.NoError(s.T(), s.repo.Create(s.ctx, favoriteBuilder.WithProductID(2).Build()), "failed to create favorite")
-				require.NoError(s.T(), s.repo.Create(s.ctx, favoriteBuilder.WithProductID(3).Build()), "failed to create favorite")
-				require.NoError(s.T(), s.repo.Create(s.ctx, favoriteBuilder.WithProductID(4).Build()), "failed to create favorite")
+				require.NoError(s.T(), s.create(favoriteBuilder.WithProductID(1).Build()), "failed to create favorite")
+				require.NoError(s.T(), s.create(favoriteBuilder.WithProductID(2).Build()), "failed to create favorite")
+				require.NoError(s.T(), s.create(favoriteBuilder.WithProductID(3).Build()), "failed to create favorite")
+				require.NoError(s.T(), s.create(favoriteBuilder.WithProductID(4).Build()), "failed to create favorite")
 			},
 			teardown: func() {
 				require.NoError(s.T(), s.repo.Remove(s.ctx, favoriteBuilder.WithProductID(1).Build()), "failed to remove favorite before create it")
@@ -208,6 +225,25 @@ func (s *TestSuitePostgresRepository) TestPaginateByClientID() {
 				require.NoError(s.T(), s.repo.Remove(s.ctx, favoriteBuilder.WithProductID(4).Build()), "failed to remove favorite before create it")
 			},
 		},
+		{
+			about:         "when filtering by tag",
+			clientID:      usr.ID,
+			filter:        favorite.Filter{Tag: "presentes"},
+			page:          0,
+			pageSize:      10,
+			expectedTotal: 1,
+			expectedFavorites: []favorite.Favorite{
+				favoriteBuilder.WithProductID(2).Build(),
+			},
+			setup: func() {
+				require.NoError(s.T(), s.create(favoriteBuilder.WithProductID(1).WithTags([]string{"jantar"}).Build()), "failed to create favorite")
+				require.NoError(s.T(), s.create(favoriteBuilder.WithProductID(2).WithTags([]string{"jantar", "presentes"}).Build()), "failed to create favorite")
+			},
+			teardown: func() {
+				require.NoError(s.T(), s.repo.Remove(s.ctx, favoriteBuilder.WithProductID(1).Build()), "failed to remove favorite before create it")
+				require.NoError(s.T(), s.repo.Remove(s.ctx, favoriteBuilder.WithProductID(2).Build()), "failed to remove favorite before create it")
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -219,7 +255,7 @@ func (s *TestSuitePostgresRepository) TestPaginateByClientID() {
 				defer tc.teardown()
 			}
 
-			found, total, err := s.repo.PaginateByClientID(s.ctx, tc.clientID, tc.page, tc.pageSize)
+			found, total, err := s.repo.PaginateByClientID(s.ctx, tc.clientID, tc.filter, tc.page, tc.pageSize)
 
 			if tc.expectedErr != "" {
 				assert.ErrorContains(t, err, tc.expectedErr)
@@ -241,3 +277,906 @@ func (s *TestSuitePostgresRepository) TestPaginateByClientID() {
 		})
 	}
 }
+
+func (s *TestSuitePostgresRepository) TestUpdate() {
+	usr := fixtureUser.AnyUser().WithEmail("update@email.com").Build()
+	require.NoError(s.T(), postgresUser.NewRepository(s.db).Create(s.ctx, usr), "failed to setup user")
+
+	f := fixture.AnyFavorite().WithClientID(usr.ID).Build()
+	require.NoError(s.T(), s.create(f), "failed to create favorite")
+
+	require.NoError(s.T(), f.Annotate("presente da mãe", []string{"presentes", "família"}))
+	require.NoError(s.T(), s.repo.Update(s.ctx, f))
+
+	found, err := s.repo.Find(s.ctx, usr.ID, f.ProductID)
+	require.NoError(s.T(), err, "failed to retrieve favorite")
+
+	assert.Equal(s.T(), "presente da mãe", found.Note)
+	assert.Equal(s.T(), []string{"presentes", "família"}, found.Tags)
+}
+
+func (s *TestSuitePostgresRepository) TestScrollByClientID() {
+	usr := fixtureUser.AnyUser().WithEmail("scroll@email.com").Build()
+	require.NoError(s.T(), postgresUser.NewRepository(s.db).Create(s.ctx, usr), "failed to setup user")
+
+	now := time.Now().Truncate(time.Microsecond)
+	favoriteBuilder := fixture.AnyFavorite().WithClientID(usr.ID)
+
+	ff := []favorite.Favorite{
+		favoriteBuilder.WithProductID(1).WithRegistredAt(now).Build(),
+		favoriteBuilder.WithProductID(2).WithRegistredAt(now.Add(-time.Minute)).Build(),
+		favoriteBuilder.WithProductID(3).WithRegistredAt(now.Add(-time.Minute)).Build(),
+		favoriteBuilder.WithProductID(4).WithRegistredAt(now.Add(-time.Hour)).Build(),
+	}
+	require.NoError(s.T(), s.create(ff...), "failed to create favorites")
+
+	productIDs := func(ff []favorite.Favorite) []int {
+		ids := make([]int, 0, len(ff))
+		for _, f := range ff {
+			ids = append(ids, f.ProductID)
+		}
+
+		return ids
+	}
+
+	testCases := []struct {
+		about       string
+		cursor      favorite.Cursor
+		limit       int
+		expectedIDs []int
+	}{
+		{
+			about:       "when there is no cursor",
+			cursor:      favorite.Cursor{Direction: favorite.DirectionNext},
+			limit:       2,
+			expectedIDs: []int{1, 3},
+		},
+		{
+			about:       "when scrolling forward from a cursor",
+			cursor:      favorite.NewCursor(ff[2], favorite.DirectionNext),
+			limit:       2,
+			expectedIDs: []int{2, 4},
+		},
+		{
+			about:       "when scrolling backwards from a cursor",
+			cursor:      favorite.NewCursor(ff[3], favorite.DirectionPrev),
+			limit:       2,
+			expectedIDs: []int{3, 2},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.about, func(t *testing.T) {
+			found, err := s.repo.ScrollByClientID(s.ctx, usr.ID, favorite.Filter{}, tc.cursor, tc.limit)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedIDs, productIDs(found))
+		})
+	}
+
+	s.T().Run("when listing all favorites by registred_at", func(t *testing.T) {
+		found, err := s.repo.AllByClientID(s.ctx, usr.ID, favorite.Filter{Order: favorite.OrderByRegistredAt})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{4, 2, 3, 1}, productIDs(found))
+	})
+
+	s.T().Run("when scrolling the positions are returned", func(t *testing.T) {
+		moved := []favorite.Favorite{ff[0], ff[3]}
+		moved[0].Position = 3 * favorite.PositionGap
+		moved[1].Position = favorite.PositionGap
+		require.NoError(t, s.repo.UpdatePositions(s.ctx, usr.ID, moved))
+
+		found, err := s.repo.ScrollByClientID(s.ctx, usr.ID, favorite.Filter{}, favorite.Cursor{Direction: favorite.DirectionNext}, 4)
+		require.NoError(t, err)
+
+		positions := make(map[int]int64, len(found))
+		for _, f := range found {
+			positions[f.ProductID] = f.Position
+		}
+
+		assert.Equal(t, 3*favorite.PositionGap, positions[1])
+		assert.Equal(t, favorite.PositionGap, positions[4])
+
+		batch, err := s.repo.FindMultiple(s.ctx, usr.ID, []int{1, 4})
+		require.NoError(t, err)
+		require.Len(t, batch, 2)
+		assert.Equal(t, 3*favorite.PositionGap, batch[0].Position)
+		assert.Equal(t, favorite.PositionGap, batch[1].Position)
+	})
+}
+
+func (s *TestSuitePostgresRepository) TestBatch() {
+	usr := fixtureUser.AnyUser().WithEmail("batch@email.com").Build()
+	require.NoError(s.T(), postgresUser.NewRepository(s.db).Create(s.ctx, usr), "failed to setup user")
+
+	favoriteBuilder := fixture.AnyFavorite().WithClientID(usr.ID)
+
+	s.T().Run("when one of the favorites fails nothing is created", func(t *testing.T) {
+		err := s.create([]favorite.Favorite{
+			favoriteBuilder.WithProductID(1).Build(),
+			favoriteBuilder.WithProductID(1).Build(),
+		}...)
+		var exists *favorite.ErrAlreadyFavorite
+		assert.ErrorAs(t, err, &exists)
+
+		found, err := s.repo.FindMultiple(s.ctx, usr.ID, []int{1})
+		require.NoError(t, err)
+		assert.Empty(t, found)
+	})
+
+	s.T().Run("when everything is fine", func(t *testing.T) {
+		ff := []favorite.Favorite{
+			favoriteBuilder.WithProductID(1).Build(),
+			favoriteBuilder.WithProductID(2).Build(),
+		}
+		require.NoError(t, s.create(ff...))
+
+		found, err := s.repo.FindMultiple(s.ctx, usr.ID, []int{1, 2, 3})
+		require.NoError(t, err)
+		assert.Len(t, found, 2)
+
+		require.NoError(t, s.repo.RemoveMany(s.ctx, ff))
+
+		found, err = s.repo.FindMultiple(s.ctx, usr.ID, []int{1, 2, 3})
+		require.NoError(t, err)
+		assert.Empty(t, found)
+	})
+}
+
+func (s *TestSuitePostgresRepository) TestTrash() {
+	usr := fixtureUser.AnyUser().WithEmail("trash@email.com").Build()
+	require.NoError(s.T(), postgresUser.NewRepository(s.db).Create(s.ctx, usr), "failed to setup user")
+
+	registredAt := time.Now().Add(-time.Hour).Truncate(time.Microsecond)
+	fav := fixture.AnyFavorite().
+		WithClientID(usr.ID).
+		WithProductID(1).
+		WithNote("presente").
+		WithRegistredAt(registredAt).
+		Build()
+	require.NoError(s.T(), s.create(fav), "failed to create favorite")
+
+	cutoff := time.Now().Add(-time.Hour)
+
+	s.T().Run("when favorite is removed it goes to the trash", func(t *testing.T) {
+		require.NoError(t, s.repo.Remove(s.ctx, fav))
+
+		_, err := s.repo.Find(s.ctx, usr.ID, fav.ProductID)
+		var notFound *favorite.ErrFavoriteNotFound
+		assert.ErrorAs(t, err, &notFound)
+
+		trashed, err := s.repo.FindTrashed(s.ctx, usr.ID, fav.ProductID, cutoff)
+		require.NoError(t, err)
+		assert.NotNil(t, trashed.DeletedAt)
+
+		ff, total, err := s.repo.PaginateTrash(s.ctx, usr.ID, cutoff, 0, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 1, total)
+		assert.Len(t, ff, 1)
+	})
+
+	s.T().Run("when favorite is restored it keeps the original data", func(t *testing.T) {
+		require.NoError(t, s.repo.RestoreWithinQuota(s.ctx, fav, 0))
+
+		found, err := s.repo.Find(s.ctx, usr.ID, fav.ProductID)
+		require.NoError(t, err)
+		assert.Equal(t, "presente", found.Note)
+		assert.True(t, registredAt.Equal(found.RegistredAt))
+		assert.Nil(t, found.DeletedAt)
+	})
+
+	s.T().Run("when a trashed favorite is created again it is restored", func(t *testing.T) {
+		require.NoError(t, s.repo.Remove(s.ctx, fav))
+		require.NoError(t, s.create(fixture.AnyFavorite().WithClientID(usr.ID).WithProductID(1).Build()))
+
+		found, err := s.repo.Find(s.ctx, usr.ID, fav.ProductID)
+		require.NoError(t, err)
+		assert.True(t, registredAt.Equal(found.RegistredAt))
+	})
+
+	s.T().Run("when trash is purged only expired favorites are deleted", func(t *testing.T) {
+		require.NoError(t, s.repo.Remove(s.ctx, fav))
+
+		purged, err := s.repo.PurgeTrash(s.ctx, cutoff)
+		require.NoError(t, err)
+		assert.Equal(t, 0, purged)
+
+		purged, err = s.repo.PurgeTrash(s.ctx, time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, 1, purged)
+
+		_, err = s.repo.FindTrashed(s.ctx, usr.ID, fav.ProductID, cutoff)
+		var notFound *favorite.ErrFavoriteNotFound
+		assert.ErrorAs(t, err, &notFound)
+	})
+}
+
+func (s *TestSuitePostgresRepository) TestStats() {
+	userRepo := postgresUser.NewRepository(s.db)
+	usr1 := fixtureUser.AnyUser().WithEmail("stats1@email.com").Build()
+	usr2 := fixtureUser.AnyUser().WithEmail("stats2@email.com").Build()
+	require.NoError(s.T(), userRepo.Create(s.ctx, usr1), "failed to setup user")
+	require.NoError(s.T(), userRepo.Create(s.ctx, usr2), "failed to setup user")
+
+	removed := fixture.AnyFavorite().WithClientID(usr2.ID).WithProductID(3).Build()
+	require.NoError(s.T(), s.create([]favorite.Favorite{
+		fixture.AnyFavorite().WithClientID(usr1.ID).WithProductID(1).Build(),
+		fixture.AnyFavorite().WithClientID(usr2.ID).WithProductID(1).Build(),
+		fixture.AnyFavorite().WithClientID(usr1.ID).WithProductID(2).Build(),
+		removed,
+	}...), "failed to setup favorites")
+	require.NoError(s.T(), s.repo.Remove(s.ctx, removed), "failed to remove favorite")
+
+	s.T().Run("when counting by product removed favorites are ignored", func(t *testing.T) {
+		cc, err := s.repo.CountByProduct(s.ctx)
+		require.NoError(t, err)
+		assert.Equal(t, []favorite.ProductCount{
+			{ProductID: 1, Count: 2},
+			{ProductID: 2, Count: 1},
+		}, cc)
+	})
+
+	s.T().Run("when getting daily activity", func(t *testing.T) {
+		aa, err := s.repo.DailyActivity(s.ctx, time.Now().AddDate(0, 0, -1))
+		require.NoError(t, err)
+		require.NotEmpty(t, aa)
+
+		var added, removed int
+		for _, a := range aa {
+			added += a.Added
+			removed += a.Removed
+		}
+
+		assert.Equal(t, 4, added)
+		assert.Equal(t, 1, removed)
+	})
+
+	s.T().Run("when the trash is purged the daily activity is kept", func(t *testing.T) {
+		require.NoError(t, s.repo.RestoreWithinQuota(s.ctx, removed, 0), "failed to restore favorite")
+		require.NoError(t, s.repo.Remove(s.ctx, removed), "failed to remove favorite")
+		_, err := s.repo.PurgeTrash(s.ctx, time.Now().Add(time.Minute))
+		require.NoError(t, err, "failed to purge trash")
+
+		aa, err := s.repo.DailyActivity(s.ctx, time.Now().AddDate(0, 0, -1))
+		require.NoError(t, err)
+
+		var added, removed int
+		for _, a := range aa {
+			added += a.Added
+			removed += a.Removed
+		}
+
+		assert.Equal(t, 5, added)
+		assert.Equal(t, 2, removed)
+	})
+}
+
+func (s *TestSuitePostgresRepository) TestLists() {
+	usr := fixtureUser.AnyUser().WithEmail("lists@email.com").Build()
+	require.NoError(s.T(), postgresUser.NewRepository(s.db).Create(s.ctx, usr), "failed to setup user")
+
+	list := fixture.AnyList().WithClientID(usr.ID).Build()
+	require.NoError(s.T(), s.repo.CreateList(s.ctx, list), "failed to create list")
+
+	s.T().Run("when list belongs to another client", func(t *testing.T) {
+		_, err := s.repo.FindList(s.ctx, uuid.NextID(), list.ID)
+
+		var notFound *favorite.ErrListNotFound
+		assert.ErrorAs(t, err, &notFound)
+	})
+
+	s.T().Run("when list is renamed", func(t *testing.T) {
+		renamed := list
+		require.NoError(t, renamed.Rename("Presentes"))
+		require.NoError(t, s.repo.UpdateList(s.ctx, renamed))
+
+		found, err := s.repo.FindList(s.ctx, usr.ID, list.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Presentes", found.Name)
+
+		ll, err := s.repo.ListsByClientID(s.ctx, usr.ID)
+		require.NoError(t, err)
+		assert.Len(t, ll, 1)
+	})
+
+	s.T().Run("when products are added to the list", func(t *testing.T) {
+		itemBuilder := fixture.AnyListItem().WithListID(list.ID)
+
+		require.NoError(t, s.repo.AddListItem(s.ctx, itemBuilder.WithProductID(1).Build()))
+		require.NoError(t, s.repo.AddListItem(s.ctx, itemBuilder.WithProductID(2).Build()))
+		assert.ErrorAs(t, s.repo.AddListItem(s.ctx, itemBuilder.WithProductID(1).Build()), new(*favorite.ErrListItemAlreadyExists))
+
+		items, total, err := s.repo.PaginateListItems(s.ctx, list.ID, 0, 1)
+		require.NoError(t, err)
+		assert.Equal(t, 2, total)
+		assert.Len(t, items, 1)
+
+		require.NoError(t, s.repo.RemoveListItem(s.ctx, itemBuilder.WithProductID(1).Build()))
+
+		_, err = s.repo.FindListItem(s.ctx, list.ID, 1)
+		var notFound *favorite.ErrListItemNotFound
+		assert.ErrorAs(t, err, &notFound)
+	})
+
+	s.T().Run("when list is deleted", func(t *testing.T) {
+		require.NoError(t, s.repo.DeleteList(s.ctx, list))
+
+		_, err := s.repo.FindListItem(s.ctx, list.ID, 2)
+		var notFound *favorite.ErrListItemNotFound
+		assert.ErrorAs(t, err, &notFound)
+	})
+}
+
+func (s *TestSuitePostgresRepository) TestShares() {
+	usr := fixtureUser.AnyUser().WithEmail("shares@email.com").Build()
+	require.NoError(s.T(), postgresUser.NewRepository(s.db).Create(s.ctx, usr), "failed to setup user")
+
+	now := time.Now().Truncate(time.Millisecond)
+	builder := fixture.AnyShare().WithClientID(usr.ID)
+
+	older := builder.WithCreatedAt(now.Add(-time.Hour)).Build()
+	newer := builder.WithCreatedAt(now).WithExpiresAt(test.Ptr(now.Add(time.Hour))).Build()
+
+	require.NoError(s.T(), s.repo.CreateShare(s.ctx, older), "failed to create share")
+	require.NoError(s.T(), s.repo.CreateShare(s.ctx, newer), "failed to create share")
+
+	s.T().Run("when share doesn't exist", func(t *testing.T) {
+		_, err := s.repo.FindShare(s.ctx, "unknown")
+
+		var notFound *favorite.ErrShareNotFound
+		assert.ErrorAs(t, err, &notFound)
+	})
+
+	s.T().Run("when share exists", func(t *testing.T) {
+		found, err := s.repo.FindShare(s.ctx, newer.Token)
+		require.NoError(t, err)
+		assert.Equal(t, usr.ID, found.ClientID)
+		require.NotNil(t, found.ExpiresAt)
+		assert.True(t, newer.ExpiresAt.Equal(*found.ExpiresAt))
+		assert.Nil(t, found.RevokedAt)
+	})
+
+	s.T().Run("when shares are listed", func(t *testing.T) {
+		ss, err := s.repo.SharesByClientID(s.ctx, usr.ID)
+		require.NoError(t, err)
+		require.Len(t, ss, 2)
+		assert.Equal(t, newer.Token, ss[0].Token)
+		assert.Equal(t, older.Token, ss[1].Token)
+	})
+
+	s.T().Run("when share is revoked", func(t *testing.T) {
+		revoked := older
+		revoked.Revoke()
+		require.NoError(t, s.repo.RevokeShare(s.ctx, revoked))
+
+		found, err := s.repo.FindShare(s.ctx, older.Token)
+		require.NoError(t, err)
+		assert.NotNil(t, found.RevokedAt)
+
+		ss, err := s.repo.SharesByClientID(s.ctx, usr.ID)
+		require.NoError(t, err)
+		require.Len(t, ss, 1)
+		assert.Equal(t, newer.Token, ss[0].Token)
+	})
+}
+
+func (s *TestSuitePostgresRepository) TestQuota() {
+	usr := fixtureUser.AnyUser().WithEmail("quota@email.com").Build()
+	require.NoError(s.T(), postgresUser.NewRepository(s.db).Create(s.ctx, usr), "failed to setup user")
+
+	favoriteBuilder := fixture.AnyFavorite().WithClientID(usr.ID)
+
+	s.T().Run("when quota doesn't exist", func(t *testing.T) {
+		_, err := s.repo.FindQuota(s.ctx, usr.ID)
+
+		var notFound *favorite.ErrQuotaNotFound
+		assert.ErrorAs(t, err, &notFound)
+	})
+
+	s.T().Run("when quota is saved", func(t *testing.T) {
+		q, err := favorite.NewQuota(usr.ID, 2)
+		require.NoError(t, err)
+		require.NoError(t, s.repo.SaveQuota(s.ctx, q))
+
+		q.MaxFavorites = 3
+		require.NoError(t, s.repo.SaveQuota(s.ctx, q))
+
+		found, err := s.repo.FindQuota(s.ctx, usr.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 3, found.MaxFavorites)
+	})
+
+	s.T().Run("when favorites are within the quota", func(t *testing.T) {
+		ff := []favorite.Favorite{
+			favoriteBuilder.WithProductID(1).Build(),
+			favoriteBuilder.WithProductID(2).Build(),
+		}
+		require.NoError(t, s.repo.CreateWithinQuota(s.ctx, usr.ID, ff, 3))
+
+		count, err := s.repo.CountByClientID(s.ctx, usr.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+	})
+
+	s.T().Run("when favorites exceed the quota nothing is created", func(t *testing.T) {
+		err := s.repo.CreateWithinQuota(s.ctx, usr.ID, []favorite.Favorite{
+			favoriteBuilder.WithProductID(3).Build(),
+			favoriteBuilder.WithProductID(4).Build(),
+		}, 3)
+
+		var exceeded *favorite.ErrQuotaExceeded
+		require.ErrorAs(t, err, &exceeded)
+		assert.Equal(t, 2, exceeded.Count)
+		assert.Equal(t, 1, exceeded.Available())
+
+		count, err := s.repo.CountByClientID(s.ctx, usr.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+	})
+
+	s.T().Run("when limit is unlimited", func(t *testing.T) {
+		require.NoError(t, s.repo.CreateWithinQuota(s.ctx, usr.ID, []favorite.Favorite{
+			favoriteBuilder.WithProductID(3).Build(),
+			favoriteBuilder.WithProductID(4).Build(),
+		}, 0))
+	})
+
+	s.T().Run("when restoring from the trash would exceed the quota", func(t *testing.T) {
+		f := favoriteBuilder.WithProductID(4).Build()
+		require.NoError(t, s.repo.Remove(s.ctx, f))
+
+		err := s.repo.RestoreWithinQuota(s.ctx, f, 3)
+
+		var exceeded *favorite.ErrQuotaExceeded
+		require.ErrorAs(t, err, &exceeded)
+		assert.Equal(t, 3, exceeded.Count)
+
+		_, err = s.repo.Find(s.ctx, usr.ID, 4)
+		assert.Error(t, err, "the favorite is kept on the trash")
+
+		require.NoError(t, s.repo.RestoreWithinQuota(s.ctx, f, 4))
+
+		count, err := s.repo.CountByClientID(s.ctx, usr.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 4, count)
+	})
+
+	s.T().Run("when quota is deleted", func(t *testing.T) {
+		require.NoError(t, s.repo.DeleteQuota(s.ctx, usr.ID))
+
+		_, err := s.repo.FindQuota(s.ctx, usr.ID)
+
+		var notFound *favorite.ErrQuotaNotFound
+		assert.ErrorAs(t, err, &notFound)
+	})
+}
+
+// pendingEvents reads the events not published yet straight from the outbox, in the order they were written
+func (s *TestSuitePostgresRepository) pendingEvents(t *testing.T) []event.Event {
+	rows, err := s.db.QueryContext(s.ctx, "SELECT type, aggregate_id FROM outbox_events WHERE published_at IS NULL ORDER BY seq")
+	require.NoError(t, err)
+	defer rows.Close()
+
+	ee := make([]event.Event, 0)
+	for rows.Next() {
+		var e event.Event
+		require.NoError(t, rows.Scan(&e.Type, &e.AggregateID))
+		ee = append(ee, e)
+	}
+	require.NoError(t, rows.Err())
+
+	return ee
+}
+
+func (s *TestSuitePostgresRepository) TestEvents() {
+	usr := fixtureUser.AnyUser().WithEmail("events@email.com").Build()
+	require.NoError(s.T(), postgresUser.NewRepository(s.db).Create(s.ctx, usr, usr.Event(user.EventSignedUp)), "failed to setup user")
+
+	outbox := postgresEvent.NewRepository(s.db)
+	f := fixture.AnyFavorite().WithClientID(usr.ID).WithProductID(1).Build()
+
+	s.T().Run("when changes are saved the events are written to the outbox", func(t *testing.T) {
+		require.NoError(t, s.repo.CreateWithinQuota(s.ctx, usr.ID, []favorite.Favorite{f}, 0, f.Event(favorite.EventAdded)))
+		require.NoError(t, s.repo.Remove(s.ctx, f, f.Event(favorite.EventRemoved)))
+
+		ee := s.pendingEvents(t)
+		require.Len(t, ee, 3)
+		assert.Equal(t, user.EventSignedUp, ee[0].Type)
+		assert.Equal(t, favorite.EventAdded, ee[1].Type)
+		assert.Equal(t, favorite.EventRemoved, ee[2].Type)
+		assert.Equal(t, usr.ID.String(), ee[2].AggregateID)
+	})
+
+	s.T().Run("when publish fails the next events are kept pending", func(t *testing.T) {
+		n, err := outbox.PublishPending(s.ctx, 10, func(_ context.Context, e event.Event) error {
+			if e.Type == favorite.EventRemoved {
+				return errors.New("publisher unavailable")
+			}
+			return nil
+		})
+		assert.EqualError(t, err, "publisher unavailable")
+		assert.Equal(t, 2, n)
+
+		ee := s.pendingEvents(t)
+		require.Len(t, ee, 1)
+		assert.Equal(t, favorite.EventRemoved, ee[0].Type)
+	})
+
+	s.T().Run("when the events are locked by another relay they are skipped", func(t *testing.T) {
+		tx, err := s.db.BeginTx(s.ctx, nil)
+		require.NoError(t, err)
+		defer tx.Rollback() //nolint: errcheck
+
+		_, err = tx.ExecContext(s.ctx, "SELECT id FROM outbox_events WHERE published_at IS NULL FOR UPDATE")
+		require.NoError(t, err)
+
+		n, err := outbox.PublishPending(s.ctx, 10, func(context.Context, event.Event) error { return nil })
+		require.NoError(t, err)
+		assert.Equal(t, 0, n)
+	})
+
+	s.T().Run("when events are published they are not pending anymore", func(t *testing.T) {
+		n, err := outbox.PublishPending(s.ctx, 10, func(context.Context, event.Event) error { return nil })
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+
+		ee := s.pendingEvents(t)
+		assert.Empty(t, ee)
+	})
+
+	s.T().Run("when purging only the published events are deleted", func(t *testing.T) {
+		other := fixture.AnyFavorite().WithClientID(usr.ID).WithProductID(2).Build()
+		require.NoError(t, s.repo.CreateWithinQuota(s.ctx, usr.ID, []favorite.Favorite{other}, 0, other.Event(favorite.EventAdded)))
+
+		purged, err := outbox.PurgePublished(s.ctx, time.Now().Add(time.Minute))
+		require.NoError(t, err)
+		assert.Equal(t, 3, purged)
+
+		ee := s.pendingEvents(t)
+		assert.Len(t, ee, 1)
+	})
+}
+
+func (s *TestSuitePostgresRepository) TestActivities() {
+	usr := fixtureUser.AnyUser().WithEmail("history@email.com").Build()
+	require.NoError(s.T(), postgresUser.NewRepository(s.db).Create(s.ctx, usr), "failed to setup user")
+
+	adminID := uuid.NextID()
+	f := fixture.AnyFavorite().WithClientID(usr.ID).WithProductID(1).Build()
+
+	s.T().Run("when history is empty", func(t *testing.T) {
+		aa, total, err := s.repo.PaginateActivities(s.ctx, usr.ID, 0, 10)
+		require.NoError(t, err)
+		assert.Empty(t, aa)
+		assert.Equal(t, 0, total)
+	})
+
+	s.T().Run("when favorites change the activities are recorded with the actor", func(t *testing.T) {
+		require.NoError(t, s.repo.CreateWithinQuota(s.ctx, usr.ID, []favorite.Favorite{f}, 0, f.Event(favorite.EventAdded)))
+		require.NoError(t, s.repo.Remove(s.ctx, f, f.Event(favorite.EventRemoved).WithActor(adminID)))
+
+		aa, total, err := s.repo.PaginateActivities(s.ctx, usr.ID, 0, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 2, total)
+		require.Len(t, aa, 2)
+
+		assert.Equal(t, favorite.ActionRemoved, aa[0].Action)
+		assert.Equal(t, adminID, aa[0].ActorID)
+		assert.Equal(t, favorite.ActionAdded, aa[1].Action)
+		assert.Equal(t, usr.ID, aa[1].ActorID)
+		assert.Equal(t, 1, aa[1].ProductID)
+	})
+
+	s.T().Run("when paginating", func(t *testing.T) {
+		aa, total, err := s.repo.PaginateActivities(s.ctx, usr.ID, 1, 1)
+		require.NoError(t, err)
+		assert.Equal(t, 2, total)
+		require.Len(t, aa, 1)
+		assert.Equal(t, favorite.ActionAdded, aa[0].Action)
+	})
+}
+
+func (s *TestSuitePostgresRepository) TestRecommendations() {
+	usrRepo := postgresUser.NewRepository(s.db)
+
+	alice := fixtureUser.AnyUser().WithEmail("alice@email.com").Build()
+	bob := fixtureUser.AnyUser().WithEmail("bob@email.com").Build()
+	carol := fixtureUser.AnyUser().WithEmail("carol@email.com").Build()
+
+	for _, usr := range []user.User{alice, bob, carol} {
+		require.NoError(s.T(), usrRepo.Create(s.ctx, usr), "failed to setup user")
+	}
+
+	favorites := map[uuid.ID][]int{
+		alice.ID: {1, 2, 3},
+		bob.ID:   {1, 2},
+		carol.ID: {1},
+	}
+
+	for clientID, productIDs := range favorites {
+		for _, productID := range productIDs {
+			f := fixture.AnyFavorite().WithClientID(clientID).WithProductID(productID).Build()
+			require.NoError(s.T(), s.create(f), "failed to create favorite")
+		}
+	}
+
+	s.T().Run("when similarities were never refreshed", func(t *testing.T) {
+		rr, err := s.repo.RecommendByClientID(s.ctx, carol.ID, 10)
+		require.NoError(t, err)
+		assert.Empty(t, rr)
+	})
+
+	s.T().Run("when similarities are refreshed", func(t *testing.T) {
+		pairs, err := s.repo.RefreshSimilarities(s.ctx, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 6, pairs)
+
+		pairs, err = s.repo.RefreshSimilarities(s.ctx, 1)
+		require.NoError(t, err)
+		assert.Equal(t, 3, pairs)
+	})
+
+	s.T().Run("when recommending the products of the client are left out", func(t *testing.T) {
+		_, err := s.repo.RefreshSimilarities(s.ctx, 10)
+		require.NoError(t, err)
+
+		rr, err := s.repo.RecommendByClientID(s.ctx, carol.ID, 10)
+		require.NoError(t, err)
+		require.Len(t, rr, 2)
+		assert.Equal(t, 2, rr[0].ProductID)
+		assert.Equal(t, 3, rr[1].ProductID)
+		assert.Greater(t, rr[0].Score, rr[1].Score)
+
+		rr, err = s.repo.RecommendByClientID(s.ctx, alice.ID, 10)
+		require.NoError(t, err)
+		assert.Empty(t, rr)
+	})
+}
+
+func (s *TestSuitePostgresRepository) TestArchive() {
+	usr := fixtureUser.AnyUser().WithEmail("archive@email.com").Build()
+	require.NoError(s.T(), postgresUser.NewRepository(s.db).Create(s.ctx, usr), "failed to setup user")
+
+	kept := fixture.AnyFavorite().WithClientID(usr.ID).WithProductID(1).Build()
+	removed := fixture.AnyFavorite().WithClientID(usr.ID).WithProductID(2).Build()
+	require.NoError(s.T(), s.create(kept, removed), "failed to create favorites")
+
+	s.T().Run("when listing favorites by products", func(t *testing.T) {
+		ff, err := s.repo.AllByProductIDs(s.ctx, []int{2, 3})
+		require.NoError(t, err)
+		require.Len(t, ff, 1)
+		assert.Equal(t, usr.ID, ff[0].ClientID)
+		assert.Equal(t, 2, ff[0].ProductID)
+	})
+
+	s.T().Run("when archived the favorite isn't listed anymore and the activity is recorded", func(t *testing.T) {
+		err := s.repo.Archive(s.ctx, []favorite.Favorite{removed}, removed.Event(favorite.EventArchived).WithActor(uuid.Nil))
+		require.NoError(t, err)
+
+		ff, err := s.repo.AllByProductIDs(s.ctx, []int{2})
+		require.NoError(t, err)
+		assert.Empty(t, ff)
+
+		total, err := s.repo.CountByClientID(s.ctx, usr.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 1, total)
+
+		aa, _, err := s.repo.PaginateActivities(s.ctx, usr.ID, 0, 10)
+		require.NoError(t, err)
+		require.Len(t, aa, 1)
+		assert.Equal(t, favorite.ActionArchived, aa[0].Action)
+		assert.Equal(t, uuid.Nil, aa[0].ActorID)
+	})
+
+	s.T().Run("when the product is favorited and archived again", func(t *testing.T) {
+		require.NoError(t, s.create(removed))
+		require.NoError(t, s.repo.Archive(s.ctx, []favorite.Favorite{removed}))
+
+		var archived int
+		require.NoError(t, s.db.QueryRowContext(s.ctx, "SELECT count(*) FROM archived_favorites WHERE client_id = $1", usr.ID).Scan(&archived))
+		assert.Equal(t, 1, archived)
+	})
+}
+
+func (s *TestSuitePostgresRepository) TestChanges() {
+	usr := fixtureUser.AnyUser().WithEmail("sync@email.com").Build()
+	require.NoError(s.T(), postgresUser.NewRepository(s.db).Create(s.ctx, usr), "failed to setup user")
+
+	f1 := fixture.AnyFavorite().WithClientID(usr.ID).WithProductID(1).Build()
+	f2 := fixture.AnyFavorite().WithClientID(usr.ID).WithProductID(2).Build()
+	f3 := fixture.AnyFavorite().WithClientID(usr.ID).WithProductID(3).Build()
+
+	s.T().Run("when there is no change", func(t *testing.T) {
+		cc, err := s.repo.ChangesByClientID(s.ctx, usr.ID, favorite.SyncToken{}, 10)
+		require.NoError(t, err)
+		assert.Empty(t, cc)
+	})
+
+	var token favorite.SyncToken
+
+	s.T().Run("when favorites are added the changes follow the sequence", func(t *testing.T) {
+		require.NoError(t, s.create(f1, f2, f3))
+
+		cc, err := s.repo.ChangesByClientID(s.ctx, usr.ID, favorite.SyncToken{}, 10)
+		require.NoError(t, err)
+		require.Len(t, cc, 3)
+		assert.Equal(t, int64(1), cc[0].Seq)
+		assert.Equal(t, int64(3), cc[2].Seq)
+		assert.False(t, cc[2].Removed)
+
+		token = favorite.NewSyncToken(cc[2], time.Now())
+	})
+
+	s.T().Run("when the writes change nothing the sequence is kept", func(t *testing.T) {
+		missing := fixture.AnyFavorite().WithClientID(usr.ID).WithProductID(99).Build()
+
+		created, err := s.repo.UpsertWithinQuota(s.ctx, f1, 0)
+		require.NoError(t, err)
+		require.False(t, created)
+		require.NoError(t, s.repo.Update(s.ctx, missing))
+		require.NoError(t, s.repo.Remove(s.ctx, missing))
+		require.NoError(t, s.repo.RemoveMany(s.ctx, []favorite.Favorite{missing}))
+		require.NoError(t, s.repo.UpdatePositions(s.ctx, usr.ID, []favorite.Favorite{missing}))
+		require.NoError(t, s.repo.Archive(s.ctx, []favorite.Favorite{missing}))
+
+		var lastSeq int64
+		require.NoError(t, s.db.QueryRowContext(s.ctx, "SELECT last_seq FROM favorite_change_seqs WHERE client_id = $1", usr.ID).Scan(&lastSeq))
+		assert.Equal(t, int64(3), lastSeq)
+
+		cc, err := s.repo.ChangesByClientID(s.ctx, usr.ID, token, 10)
+		require.NoError(t, err)
+		assert.Empty(t, cc)
+	})
+
+	s.T().Run("when favorites are updated, removed and archived only the new changes are returned", func(t *testing.T) {
+		f1.Note = "presente"
+		require.NoError(t, s.repo.Update(s.ctx, f1))
+		require.NoError(t, s.repo.Remove(s.ctx, f2))
+		require.NoError(t, s.repo.Archive(s.ctx, []favorite.Favorite{f3}))
+
+		cc, err := s.repo.ChangesByClientID(s.ctx, usr.ID, token, 10)
+		require.NoError(t, err)
+		require.Len(t, cc, 3)
+
+		assert.Equal(t, 1, cc[0].Favorite.ProductID)
+		assert.False(t, cc[0].Removed)
+		assert.Equal(t, "presente", cc[0].Favorite.Note)
+		assert.Equal(t, 2, cc[1].Favorite.ProductID)
+		assert.True(t, cc[1].Removed)
+		assert.Equal(t, 3, cc[2].Favorite.ProductID)
+		assert.True(t, cc[2].Removed)
+	})
+
+	s.T().Run("when paginating", func(t *testing.T) {
+		cc, err := s.repo.ChangesByClientID(s.ctx, usr.ID, token, 1)
+		require.NoError(t, err)
+		require.Len(t, cc, 1)
+
+		cc, err = s.repo.ChangesByClientID(s.ctx, usr.ID, favorite.NewSyncToken(cc[0], time.Now()), 10)
+		require.NoError(t, err)
+		require.Len(t, cc, 2)
+		assert.Equal(t, 2, cc[0].Favorite.ProductID)
+	})
+
+	s.T().Run("when tombstones are older than the trash retention they are purged", func(t *testing.T) {
+		_, err := s.repo.PurgeTrash(s.ctx, time.Now().Add(time.Minute))
+		require.NoError(t, err)
+
+		cc, err := s.repo.ChangesByClientID(s.ctx, usr.ID, token, 10)
+		require.NoError(t, err)
+		require.Len(t, cc, 1)
+		assert.Equal(t, 1, cc[0].Favorite.ProductID)
+	})
+}
+
+func (s *TestSuitePostgresRepository) TestPositions() {
+	usr := fixtureUser.AnyUser().WithEmail("positions@email.com").Build()
+	require.NoError(s.T(), postgresUser.NewRepository(s.db).Create(s.ctx, usr), "failed to setup user")
+
+	filter := favorite.Filter{Order: favorite.OrderByPosition}
+	productIDs := func(ff []favorite.Favorite) []int {
+		ids := make([]int, 0, len(ff))
+		for _, f := range ff {
+			ids = append(ids, f.ProductID)
+		}
+
+		return ids
+	}
+
+	s.T().Run("when favorites are added they go to the top", func(t *testing.T) {
+		for _, pID := range []int{1, 2, 3} {
+			require.NoError(t, s.create(fixture.AnyFavorite().WithClientID(usr.ID).WithProductID(pID).Build()))
+		}
+
+		ff, err := s.repo.AllByClientID(s.ctx, usr.ID, filter)
+		require.NoError(t, err)
+		assert.Equal(t, []int{3, 2, 1}, productIDs(ff))
+	})
+
+	s.T().Run("when positions are updated the favorites follow the new order", func(t *testing.T) {
+		ff, err := s.repo.AllByClientID(s.ctx, usr.ID, filter)
+		require.NoError(t, err)
+
+		o := favorite.NewOrdering(usr.ID, ff)
+		require.NoError(t, o.Move(1, 3, false))
+		require.NoError(t, s.repo.UpdatePositions(s.ctx, usr.ID, o.Changed()))
+
+		ff, err = s.repo.AllByClientID(s.ctx, usr.ID, filter)
+		require.NoError(t, err)
+		assert.Equal(t, []int{1, 3, 2}, productIDs(ff))
+
+		page, total, err := s.repo.PaginateByClientID(s.ctx, usr.ID, filter, 0, 2)
+		require.NoError(t, err)
+		assert.Equal(t, 3, total)
+		assert.Equal(t, []int{1, 3}, productIDs(page))
+	})
+
+	s.T().Run("when favorites are restored from the trash they go to the top", func(t *testing.T) {
+		f2 := fixture.AnyFavorite().WithClientID(usr.ID).WithProductID(2).Build()
+		f3 := fixture.AnyFavorite().WithClientID(usr.ID).WithProductID(3).Build()
+		require.NoError(t, s.repo.Remove(s.ctx, f2))
+		require.NoError(t, s.repo.Remove(s.ctx, f3))
+
+		require.NoError(t, s.repo.RestoreWithinQuota(s.ctx, f2, 0))
+		require.NoError(t, s.create(f3))
+
+		ff, err := s.repo.AllByClientID(s.ctx, usr.ID, filter)
+		require.NoError(t, err)
+		assert.Equal(t, []int{3, 2, 1}, productIDs(ff))
+	})
+}
+
+func (s *TestSuitePostgresRepository) TestUpsert() {
+	usr := fixtureUser.AnyUser().WithEmail("upsert@email.com").Build()
+	require.NoError(s.T(), postgresUser.NewRepository(s.db).Create(s.ctx, usr), "failed to setup user")
+
+	f := fixture.AnyFavorite().WithClientID(usr.ID).WithProductID(1).Build()
+
+	s.T().Run("when the favorite doesn't exist it is created", func(t *testing.T) {
+		created, err := s.repo.UpsertWithinQuota(s.ctx, f, 0, f.Event(favorite.EventAdded))
+		require.NoError(t, err)
+		assert.True(t, created)
+	})
+
+	s.T().Run("when the favorite already exists it is kept", func(t *testing.T) {
+		created, err := s.repo.UpsertWithinQuota(s.ctx, f, 0, f.Event(favorite.EventAdded))
+		require.NoError(t, err)
+		assert.False(t, created)
+
+		aa, _, err := s.repo.PaginateActivities(s.ctx, usr.ID, 0, 10)
+		require.NoError(t, err)
+		assert.Len(t, aa, 1)
+	})
+
+	s.T().Run("when creating an existing favorite the unique violation is translated", func(t *testing.T) {
+		err := s.create(f)
+		assert.ErrorAs(t, err, new(*favorite.ErrAlreadyFavorite))
+
+		err = s.repo.CreateWithinQuota(s.ctx, usr.ID, []favorite.Favorite{f}, 0)
+		assert.ErrorAs(t, err, new(*favorite.ErrAlreadyFavorite))
+	})
+
+	s.T().Run("when the favorite is in the trash it is restored", func(t *testing.T) {
+		require.NoError(t, s.repo.Remove(s.ctx, f))
+
+		created, err := s.repo.UpsertWithinQuota(s.ctx, f, 0)
+		require.NoError(t, err)
+		assert.True(t, created)
+
+		_, err = s.repo.Find(s.ctx, usr.ID, f.ProductID)
+		assert.NoError(t, err)
+	})
+
+	s.T().Run("when the quota is exceeded nothing is created", func(t *testing.T) {
+		other := fixture.AnyFavorite().WithClientID(usr.ID).WithProductID(2).Build()
+
+		created, err := s.repo.UpsertWithinQuota(s.ctx, other, 1)
+		assert.ErrorAs(t, err, new(*favorite.ErrQuotaExceeded))
+		assert.False(t, created)
+
+		total, err := s.repo.CountByClientID(s.ctx, usr.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 1, total)
+	})
+}
@@ -0,0 +1,109 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+func (r *repository) FindShare(ctx context.Context, token string) (favorite.Share, error) {
+	query := `
+		SELECT
+			token, client_id, created_at, expires_at, revoked_at
+		FROM favorite_shares
+		WHERE
+			token = $1
+		`
+
+	var s favorite.Share
+	if err := r.db.QueryRowContext(ctx, query, token).Scan(
+		&s.Token,
+		&s.ClientID,
+		&s.CreatedAt,
+		&s.ExpiresAt,
+		&s.RevokedAt,
+	); err != nil {
+		if err == sql.ErrNoRows {
+			return favorite.Share{}, &favorite.ErrShareNotFound{
+				Token: token,
+			}
+		}
+		return favorite.Share{}, err
+	}
+
+	return s, nil
+}
+
+func (r *repository) SharesByClientID(ctx context.Context, clientID uuid.ID) ([]favorite.Share, error) {
+	query := `
+		SELECT
+			token, client_id, created_at, expires_at, revoked_at
+		FROM favorite_shares
+		WHERE
+			client_id = $1
+			AND revoked_at IS NULL
+		ORDER BY created_at DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, clientID)
+	if err != nil {
+		return []favorite.Share{}, err
+	}
+	defer rows.Close()
+
+	ss := make([]favorite.Share, 0)
+	for rows.Next() {
+		var s favorite.Share
+		if err := rows.Scan(
+			&s.Token,
+			&s.ClientID,
+			&s.CreatedAt,
+			&s.ExpiresAt,
+			&s.RevokedAt,
+		); err != nil {
+			return []favorite.Share{}, err
+		}
+
+		ss = append(ss, s)
+	}
+
+	if err := rows.Err(); err != nil {
+		return []favorite.Share{}, err
+	}
+
+	return ss, nil
+}
+
+func (r *repository) CreateShare(ctx context.Context, s favorite.Share) error {
+	query := `
+	INSERT INTO favorite_shares(
+		token, client_id, created_at, expires_at
+	) VALUES (
+		$1, $2, $3, $4
+	)
+	`
+
+	_, err := r.db.ExecContext(ctx, query, s.Token, s.ClientID, s.CreatedAt, s.ExpiresAt)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repository) RevokeShare(ctx context.Context, s favorite.Share) error {
+	query := `
+	UPDATE favorite_shares
+		SET revoked_at = $3
+	WHERE token = $1 AND client_id = $2
+	`
+
+	_, err := r.db.ExecContext(ctx, query, s.Token, s.ClientID, s.RevokedAt)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
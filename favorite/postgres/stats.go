@@ -0,0 +1,96 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+)
+
+func (r *repository) CountByProduct(ctx context.Context) ([]favorite.ProductCount, error) {
+	query := `
+		SELECT
+			product_id, count(*)
+		FROM favorites
+		WHERE
+			deleted_at IS NULL
+		GROUP BY product_id
+		ORDER BY count(*) DESC, product_id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return []favorite.ProductCount{}, err
+	}
+	defer rows.Close()
+
+	cc := make([]favorite.ProductCount, 0)
+	for rows.Next() {
+		var c favorite.ProductCount
+		if err := rows.Scan(&c.ProductID, &c.Count); err != nil {
+			return []favorite.ProductCount{}, err
+		}
+
+		cc = append(cc, c)
+	}
+
+	if err := rows.Err(); err != nil {
+		return []favorite.ProductCount{}, err
+	}
+
+	return cc, nil
+}
+
+func (r *repository) DailyActivity(ctx context.Context, since time.Time) ([]favorite.DailyActivity, error) {
+	query := `
+		SELECT
+			day, added, removed
+		FROM favorite_daily_activity
+		WHERE day >= date_trunc('day', $1::TIMESTAMPTZ)
+		ORDER BY day
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, since)
+	if err != nil {
+		return []favorite.DailyActivity{}, err
+	}
+	defer rows.Close()
+
+	aa := make([]favorite.DailyActivity, 0)
+	for rows.Next() {
+		var a favorite.DailyActivity
+		if err := rows.Scan(&a.Day, &a.Added, &a.Removed); err != nil {
+			return []favorite.DailyActivity{}, err
+		}
+
+		aa = append(aa, a)
+	}
+
+	if err := rows.Err(); err != nil {
+		return []favorite.DailyActivity{}, err
+	}
+
+	return aa, nil
+}
+
+// countActivity adds to the counters of the day in the transaction of the change,
+// so the totals are kept after the trash is purged or a favorite is restored
+func countActivity(ctx context.Context, tx *sql.Tx, added, removed int) error {
+	if added == 0 && removed == 0 {
+		return nil
+	}
+
+	query := `
+	INSERT INTO favorite_daily_activity(
+		day, added, removed
+	) VALUES (
+		date_trunc('day', NOW()), $1, $2
+	)
+	ON CONFLICT (day) DO UPDATE
+		SET added = favorite_daily_activity.added + EXCLUDED.added, removed = favorite_daily_activity.removed + EXCLUDED.removed
+	`
+
+	_, err := tx.ExecContext(ctx, query, added, removed)
+	return err
+}
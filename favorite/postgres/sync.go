@@ -0,0 +1,87 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgtype"
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+// changeSeqCTE takes the next change sequence of the client $1 as seq.last_seq.
+// The row stays locked until the transaction ends, so the changes of a client are committed in sequence order.
+// A client without changes has no row to lock yet, its first favorite is written under the quota lock
+const changeSeqCTE = `
+	current_seq AS (
+		SELECT last_seq FROM favorite_change_seqs WHERE client_id = $1 FOR UPDATE
+	),
+	seq AS (
+		SELECT COALESCE((SELECT last_seq FROM current_seq), 0) + 1 AS last_seq
+	)`
+
+// bumpChangeSeqCTE stores seq.last_seq as the last change sequence of the client $1 only when the CTE changed returns rows,
+// so a write that changes nothing doesn't move the sync token
+const bumpChangeSeqCTE = `
+	bumped_seq AS (
+		INSERT INTO favorite_change_seqs (client_id, last_seq)
+		SELECT $1, last_seq FROM seq
+		WHERE EXISTS (SELECT 1 FROM changed)
+		ON CONFLICT (client_id) DO UPDATE SET last_seq = EXCLUDED.last_seq
+	)`
+
+func (r *repository) ChangesByClientID(ctx context.Context, clientID uuid.ID, after favorite.SyncToken, limit int) ([]favorite.Change, error) {
+	query := `
+		SELECT
+			change_seq, deleted_at IS NOT NULL, product_id, note, tags, price_when_favorited, title_when_favorited, registred_at, position
+		FROM favorites
+		WHERE
+			client_id = $1
+			AND (change_seq, product_id) > ($2, $3)
+		UNION ALL
+		SELECT
+			change_seq, TRUE, product_id, '', '{}'::TEXT[], NULL::NUMERIC, '', removed_at, 0
+		FROM favorite_tombstones
+		WHERE
+			client_id = $1
+			AND (change_seq, product_id) > ($2, $3)
+		ORDER BY 1, 3
+		LIMIT $4
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, clientID, after.Seq, after.ProductID, limit)
+	if err != nil {
+		return []favorite.Change{}, err
+	}
+	defer rows.Close()
+
+	cc := make([]favorite.Change, 0, limit)
+	for rows.Next() {
+		c := favorite.Change{Favorite: favorite.Favorite{ClientID: clientID}}
+		var tags pgtype.TextArray
+		if err := rows.Scan(
+			&c.Seq,
+			&c.Removed,
+			&c.Favorite.ProductID,
+			&c.Favorite.Note,
+			&tags,
+			&c.Favorite.PriceWhenFavorited,
+			&c.Favorite.TitleWhenFavorited,
+			&c.Favorite.RegistredAt,
+			&c.Favorite.Position,
+		); err != nil {
+			return []favorite.Change{}, err
+		}
+
+		if err := tags.AssignTo(&c.Favorite.Tags); err != nil {
+			return []favorite.Change{}, err
+		}
+
+		cc = append(cc, c)
+	}
+
+	if err := rows.Err(); err != nil {
+		return []favorite.Change{}, err
+	}
+
+	return cc, nil
+}
@@ -0,0 +1,178 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/jackc/pgtype"
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+func (r *repository) FindTrashed(ctx context.Context, clientID uuid.ID, productID int, deletedAfter time.Time) (favorite.Favorite, error) {
+	query := `
+		SELECT
+			client_id, product_id, note, tags, price_when_favorited, title_when_favorited, registred_at, deleted_at
+		FROM favorites
+		WHERE
+			client_id = $1
+			AND product_id = $2
+			AND deleted_at > $3
+		`
+
+	var f favorite.Favorite
+	var tags pgtype.TextArray
+	if err := r.db.QueryRowContext(ctx, query, clientID, productID, deletedAfter).Scan(
+		&f.ClientID,
+		&f.ProductID,
+		&f.Note,
+		&tags,
+		&f.PriceWhenFavorited,
+		&f.TitleWhenFavorited,
+		&f.RegistredAt,
+		&f.DeletedAt,
+	); err != nil {
+		if err == sql.ErrNoRows {
+			return favorite.Favorite{}, &favorite.ErrFavoriteNotFound{
+				ClientID:  clientID,
+				ProductID: productID,
+			}
+		}
+		return favorite.Favorite{}, err
+	}
+
+	if err := tags.AssignTo(&f.Tags); err != nil {
+		return favorite.Favorite{}, err
+	}
+
+	return f, nil
+}
+
+func (r *repository) PaginateTrash(ctx context.Context, clientID uuid.ID, deletedAfter time.Time, page, pageSize int) ([]favorite.Favorite, int, error) {
+	query := `
+		SELECT
+			client_id, product_id, note, tags, price_when_favorited, title_when_favorited, registred_at, deleted_at
+		FROM favorites
+		WHERE
+			client_id = $1
+			AND deleted_at > $2
+		ORDER BY deleted_at DESC, product_id DESC
+		LIMIT $3 OFFSET $4
+	`
+
+	queryCount := `
+		SELECT count(*) FROM favorites
+		WHERE
+			client_id = $1
+			AND deleted_at > $2
+	`
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, queryCount, clientID, deletedAfter).Scan(&total); err != nil {
+		return []favorite.Favorite{}, 0, err
+	}
+
+	offset := page * pageSize
+
+	rows, err := r.db.QueryContext(ctx, query, clientID, deletedAfter, pageSize, offset)
+	if err != nil {
+		return []favorite.Favorite{}, 0, err
+	}
+	defer rows.Close()
+
+	ff := make([]favorite.Favorite, 0, pageSize)
+	for rows.Next() {
+		var f favorite.Favorite
+		var tags pgtype.TextArray
+		if err := rows.Scan(
+			&f.ClientID,
+			&f.ProductID,
+			&f.Note,
+			&tags,
+			&f.PriceWhenFavorited,
+			&f.TitleWhenFavorited,
+			&f.RegistredAt,
+			&f.DeletedAt,
+		); err != nil {
+			return []favorite.Favorite{}, 0, err
+		}
+
+		if err := tags.AssignTo(&f.Tags); err != nil {
+			return []favorite.Favorite{}, 0, err
+		}
+
+		ff = append(ff, f)
+	}
+
+	if err := rows.Err(); err != nil {
+		return []favorite.Favorite{}, 0, err
+	}
+
+	return ff, total, nil
+}
+
+// restoreFavoriteQuery puts the favorite back on the top of the manual ordering, like restoreFavoriteCTE does,
+// $3 places it the gap above the first favorite
+var restoreFavoriteQuery = `
+	WITH` + changeSeqCTE + `,
+	top AS (
+		SELECT COALESCE(MIN(position), 0) - $3::BIGINT AS position
+		FROM favorites
+		WHERE client_id = $1 AND deleted_at IS NULL
+	),
+	changed AS (
+		UPDATE favorites
+			SET deleted_at = NULL, change_seq = (SELECT last_seq FROM seq), position = (SELECT position FROM top)
+		WHERE client_id = $1 AND product_id = $2 AND deleted_at IS NOT NULL
+		RETURNING product_id
+	),` + bumpChangeSeqCTE + `
+	SELECT count(*) FROM changed
+	`
+
+// restore counts the restored favorite as added again, its removal is kept on the daily activity
+func restore(ctx context.Context, tx *sql.Tx, f favorite.Favorite) error {
+	var restored int
+	if err := tx.QueryRowContext(ctx, restoreFavoriteQuery, f.ClientID, f.ProductID, favorite.PositionGap).Scan(&restored); err != nil {
+		return err
+	}
+
+	return countActivity(ctx, tx, restored, 0)
+}
+
+func (r *repository) PurgeTrash(ctx context.Context, deletedBefore time.Time) (int, error) {
+	query := `
+	DELETE FROM favorites WHERE deleted_at IS NOT NULL AND deleted_at <= $1
+	`
+
+	// Tombstones are kept as long as the trash, after that sync tokens are expired anyway
+	queryTombstones := `
+	DELETE FROM favorite_tombstones WHERE removed_at <= $1
+	`
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback() //nolint: errcheck
+
+	res, err := tx.ExecContext(ctx, query, deletedBefore)
+	if err != nil {
+		return 0, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err := tx.ExecContext(ctx, queryTombstones, deletedBefore); err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return int(affected), nil
+}
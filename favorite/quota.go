@@ -0,0 +1,43 @@
+package favorite
+
+import (
+	"time"
+
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/validator"
+)
+
+// Quota is the max favorites of a client set by an admin, it overrides the limit of the client role and 0 means unlimited
+type Quota struct {
+	ClientID     uuid.ID   `json:"clientId"`
+	MaxFavorites int       `json:"maxFavorites"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+}
+
+func (q Quota) validate() error {
+	v := validator.New()
+
+	if q.ClientID.IsZero() {
+		v.AddError("clientId", "campo obrigatório")
+	}
+
+	if q.MaxFavorites < 0 {
+		v.AddError("maxFavorites", "não pode ser negativo")
+	}
+
+	return v.Validate()
+}
+
+func NewQuota(clientID uuid.ID, maxFavorites int) (Quota, error) {
+	q := Quota{
+		ClientID:     clientID,
+		MaxFavorites: maxFavorites,
+		UpdatedAt:    time.Now(),
+	}
+
+	if err := q.validate(); err != nil {
+		return Quota{}, err
+	}
+
+	return q, nil
+}
@@ -0,0 +1,70 @@
+package favorite_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+func TestNewQuota(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		about         string
+		clientID      uuid.ID
+		maxFavorites  int
+		expectedError string
+	}{
+		{
+			about:         "when clientID is invalid",
+			clientID:      uuid.Nil,
+			maxFavorites:  10,
+			expectedError: "[AQF002] clientId: campo obrigatório",
+		},
+		{
+			about:         "when maxFavorites is negative",
+			clientID:      uuid.NextID(),
+			maxFavorites:  -1,
+			expectedError: "[AQF002] maxFavorites: não pode ser negativo",
+		},
+		{
+			about:        "when it is unlimited",
+			clientID:     uuid.NextID(),
+			maxFavorites: 0,
+		},
+		{
+			about:        "when it has a limit",
+			clientID:     uuid.NextID(),
+			maxFavorites: 10,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			q, err := favorite.NewQuota(tc.clientID, tc.maxFavorites)
+
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				assert.Equal(t, favorite.Quota{}, q)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.clientID, q.ClientID)
+			assert.Equal(t, tc.maxFavorites, q.MaxFavorites)
+			assert.False(t, q.UpdatedAt.IsZero())
+		})
+	}
+}
+
+func TestErrQuotaExceeded_Available(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 3, (&favorite.ErrQuotaExceeded{Limit: 5, Count: 2}).Available())
+	assert.Equal(t, 0, (&favorite.ErrQuotaExceeded{Limit: 5, Count: 7}).Available())
+}
@@ -0,0 +1,7 @@
+package favorite
+
+// Recommendation is a product similar to the favorites of a client, the score is the sum of its similarity with each of them
+type Recommendation struct {
+	ProductID int     `json:"productId"`
+	Score     float64 `json:"score"`
+}
@@ -2,18 +2,86 @@ package favorite
 
 import (
 	"context"
+	"time"
 
+	"github.com/uesleicarvalhoo/aiqfome/event"
 	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
 )
 
 type Reader interface {
 	Find(ctx context.Context, clientID uuid.ID, productID int) (Favorite, error)
-	PaginateByClientID(ctx context.Context, clientID uuid.ID, page, pageSize int) ([]Favorite, int, error)
+	FindMultiple(ctx context.Context, clientID uuid.ID, productIDs []int) ([]Favorite, error)
+	PaginateByClientID(ctx context.Context, clientID uuid.ID, filter Filter, page, pageSize int) ([]Favorite, int, error)
+	AllByClientID(ctx context.Context, clientID uuid.ID, filter Filter) ([]Favorite, error)
+	// ScrollByClientID returns up to limit favorites next to the cursor, always ordered by (registred_at, product_id) desc
+	ScrollByClientID(ctx context.Context, clientID uuid.ID, filter Filter, cursor Cursor, limit int) ([]Favorite, error)
+	// FindTrashed returns a removed favorite when it was removed after deletedAfter
+	FindTrashed(ctx context.Context, clientID uuid.ID, productID int, deletedAfter time.Time) (Favorite, error)
+	// PaginateTrash returns the favorites removed after deletedAfter, most recently removed first
+	PaginateTrash(ctx context.Context, clientID uuid.ID, deletedAfter time.Time, page, pageSize int) ([]Favorite, int, error)
+	// AllByProductIDs returns the favorites of any client with one of the products, the trash isn't included
+	AllByProductIDs(ctx context.Context, productIDs []int) ([]Favorite, error)
+	// CountByProduct returns how many clients have each product as favorite, most favorited first
+	CountByProduct(ctx context.Context) ([]ProductCount, error)
+	// DailyActivity returns the favorites added and removed per day since the day of the given moment.
+	// Restores count as additions and archiving isn't counted, the days before the counters only know the removals still in the trash
+	DailyActivity(ctx context.Context, since time.Time) ([]DailyActivity, error)
+	FindList(ctx context.Context, clientID, listID uuid.ID) (List, error)
+	ListsByClientID(ctx context.Context, clientID uuid.ID) ([]List, error)
+	FindListItem(ctx context.Context, listID uuid.ID, productID int) (ListItem, error)
+	PaginateListItems(ctx context.Context, listID uuid.ID, page, pageSize int) ([]ListItem, int, error)
+	// CountByClientID returns how many favorites the client has, the trash isn't counted
+	CountByClientID(ctx context.Context, clientID uuid.ID) (int, error)
+	FindQuota(ctx context.Context, clientID uuid.ID) (Quota, error)
+	FindShare(ctx context.Context, token string) (Share, error)
+	// SharesByClientID returns the shares that weren't revoked, expired ones included, most recent first
+	SharesByClientID(ctx context.Context, clientID uuid.ID) ([]Share, error)
+	// PaginateActivities returns the history of the client favorites, most recent first
+	PaginateActivities(ctx context.Context, clientID uuid.ID, page, pageSize int) ([]Activity, int, error)
+	// ChangesByClientID returns up to limit changes of the client favorites after the token, oldest first.
+	// Removals are known while in the trash, or as tombstones for as long as the trash retention when archived
+	ChangesByClientID(ctx context.Context, clientID uuid.ID, after SyncToken, limit int) ([]Change, error)
+	// RecommendByClientID returns the products most similar to the client favorites that the client doesn't have, best score first
+	RecommendByClientID(ctx context.Context, clientID uuid.ID, limit int) ([]Recommendation, error)
 }
 
+// Writer methods that receive events write them to the outbox, and the activities they record to the history, in the same transaction of the change
 type Writer interface {
-	Create(ctx context.Context, f Favorite) error
-	Remove(ctx context.Context, f Favorite) error
+	// CreateWithinQuota creates or restores all favorites in a single transaction, restored ones keep their original registred_at.
+	// It fails with ErrAlreadyFavorite when a favorite already exists and with ErrQuotaExceeded when the client would have
+	// more than limit favorites. The check and the creation are serialized per client, a limit lower than 1 means unlimited
+	CreateWithinQuota(ctx context.Context, clientID uuid.ID, ff []Favorite, limit int, ee ...event.Event) error
+	// UpsertWithinQuota creates or restores the favorite in a single statement and does nothing when it already exists,
+	// it returns whether the favorite was created. The events are saved and the quota checked only when it was created
+	UpsertWithinQuota(ctx context.Context, f Favorite, limit int, ee ...event.Event) (bool, error)
+	Update(ctx context.Context, f Favorite) error
+	// UpdatePositions saves the position of each favorite of the client in a single transaction
+	UpdatePositions(ctx context.Context, clientID uuid.ID, ff []Favorite) error
+	// Remove moves the favorite to the trash
+	Remove(ctx context.Context, f Favorite, ee ...event.Event) error
+	// RemoveMany moves all favorites to the trash in a single transaction
+	RemoveMany(ctx context.Context, ff []Favorite, ee ...event.Event) error
+	// RestoreWithinQuota brings the favorite back from the trash, but fails with ErrQuotaExceeded when the client would have
+	// more than limit favorites. The check and the restore are serialized per client, a limit lower than 1 means unlimited
+	RestoreWithinQuota(ctx context.Context, f Favorite, limit int, ee ...event.Event) error
+	// Archive moves the favorites to the archive in a single transaction, they stop being listed and counted
+	Archive(ctx context.Context, ff []Favorite, ee ...event.Event) error
+	// PurgeTrash permanently deletes the favorites removed before deletedBefore, and the tombstones as old
+	PurgeTrash(ctx context.Context, deletedBefore time.Time) (int, error)
+	// RefreshSimilarities rebuilds the similarity between the products favorited by the same clients,
+	// keeping the maxPerProduct most similar of each product. It returns how many pairs were kept
+	RefreshSimilarities(ctx context.Context, maxPerProduct int) (int, error)
+	CreateList(ctx context.Context, l List) error
+	UpdateList(ctx context.Context, l List) error
+	DeleteList(ctx context.Context, l List) error
+	// AddListItem fails with ErrListItemAlreadyExists when the product is already in the list
+	AddListItem(ctx context.Context, i ListItem) error
+	RemoveListItem(ctx context.Context, i ListItem) error
+	// SaveQuota creates or replaces the quota of the client
+	SaveQuota(ctx context.Context, q Quota) error
+	DeleteQuota(ctx context.Context, clientID uuid.ID) error
+	CreateShare(ctx context.Context, s Share) error
+	RevokeShare(ctx context.Context, s Share) error
 }
 
 type Repository interface {
@@ -0,0 +1,64 @@
+package favorite
+
+import (
+	"crypto/rand"
+	"time"
+
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/validator"
+)
+
+// Share is a public link to the favorites of a client, anyone with the token can see them until it expires or is revoked
+type Share struct {
+	Token     string     `json:"token"`
+	ClientID  uuid.ID    `json:"clientId"`
+	CreatedAt time.Time  `json:"createdAt"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	RevokedAt *time.Time `json:"revokedAt,omitempty"`
+}
+
+func (s Share) validate() error {
+	v := validator.New()
+
+	if s.Token == "" {
+		v.AddError("token", "campo obrigatório")
+	}
+
+	if s.ClientID.IsZero() {
+		v.AddError("clientId", "campo obrigatório")
+	}
+
+	if s.ExpiresAt != nil && !s.ExpiresAt.After(s.CreatedAt) {
+		v.AddError("expiresAt", "deve ser uma data futura")
+	}
+
+	return v.Validate()
+}
+
+func (s Share) IsExpired(now time.Time) bool {
+	return s.ExpiresAt != nil && !now.Before(*s.ExpiresAt)
+}
+
+func (s Share) IsActive(now time.Time) bool {
+	return s.RevokedAt == nil && !s.IsExpired(now)
+}
+
+func (s *Share) Revoke() {
+	now := time.Now()
+	s.RevokedAt = &now
+}
+
+func NewShare(clientID uuid.ID, expiresAt *time.Time) (Share, error) {
+	s := Share{
+		Token:     rand.Text(),
+		ClientID:  clientID,
+		CreatedAt: time.Now(),
+		ExpiresAt: expiresAt,
+	}
+
+	if err := s.validate(); err != nil {
+		return Share{}, err
+	}
+
+	return s, nil
+}
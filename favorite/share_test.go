@@ -0,0 +1,133 @@
+package favorite_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/favorite/fixture"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/test"
+)
+
+func TestNewShare(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		about         string
+		clientID      uuid.ID
+		expiresAt     *time.Time
+		expectedError string
+	}{
+		{
+			about:         "when clientID is invalid",
+			clientID:      uuid.Nil,
+			expectedError: "[AQF002] clientId: campo obrigatório",
+		},
+		{
+			about:         "when expiresAt is in the past",
+			clientID:      uuid.NextID(),
+			expiresAt:     test.Ptr(time.Now().Add(-time.Hour)),
+			expectedError: "[AQF002] expiresAt: deve ser uma data futura",
+		},
+		{
+			about:    "when it never expires",
+			clientID: uuid.NextID(),
+		},
+		{
+			about:     "when it expires in the future",
+			clientID:  uuid.NextID(),
+			expiresAt: test.Ptr(time.Now().Add(time.Hour)),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			s, err := favorite.NewShare(tc.clientID, tc.expiresAt)
+
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				assert.Equal(t, favorite.Share{}, s)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotEmpty(t, s.Token)
+			assert.Equal(t, tc.clientID, s.ClientID)
+			assert.Equal(t, tc.expiresAt, s.ExpiresAt)
+			assert.Nil(t, s.RevokedAt)
+		})
+	}
+}
+
+func TestNewShare_UniqueTokens(t *testing.T) {
+	t.Parallel()
+
+	clientID := uuid.NextID()
+
+	a, err := favorite.NewShare(clientID, nil)
+	assert.NoError(t, err)
+
+	b, err := favorite.NewShare(clientID, nil)
+	assert.NoError(t, err)
+
+	assert.NotEqual(t, a.Token, b.Token)
+}
+
+func TestShare_IsActive(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	builder := fixture.AnyShare().WithCreatedAt(now.Add(-48 * time.Hour))
+
+	testCases := []struct {
+		about    string
+		share    favorite.Share
+		expected bool
+	}{
+		{
+			about:    "when it never expires",
+			share:    builder.Build(),
+			expected: true,
+		},
+		{
+			about:    "when it expires in the future",
+			share:    builder.WithExpiresAt(test.Ptr(now.Add(time.Hour))).Build(),
+			expected: true,
+		},
+		{
+			about:    "when it is expired",
+			share:    builder.WithExpiresAt(test.Ptr(now.Add(-time.Hour))).Build(),
+			expected: false,
+		},
+		{
+			about:    "when it is revoked",
+			share:    builder.WithRevokedAt(test.Ptr(now.Add(-time.Hour))).Build(),
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, tc.share.IsActive(now))
+		})
+	}
+}
+
+func TestShare_Revoke(t *testing.T) {
+	t.Parallel()
+
+	s := fixture.AnyShare().Build()
+
+	s.Revoke()
+
+	assert.NotNil(t, s.RevokedAt)
+	assert.False(t, s.IsActive(time.Now()))
+}
@@ -0,0 +1,14 @@
+package favorite
+
+import "time"
+
+type ProductCount struct {
+	ProductID int `json:"productId"`
+	Count     int `json:"count"`
+}
+
+type DailyActivity struct {
+	Day     time.Time `json:"day"`
+	Added   int       `json:"added"`
+	Removed int       `json:"removed"`
+}
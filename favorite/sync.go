@@ -0,0 +1,74 @@
+package favorite
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+)
+
+// Change is a favorite added, updated, restored or removed, ordered by (Seq, ProductID) within the client.
+// Removals only carry the product id on the favorite
+type Change struct {
+	Seq      int64
+	Removed  bool
+	Favorite Favorite
+}
+
+// SyncToken points to the last change a client has seen
+type SyncToken struct {
+	Seq       int64
+	ProductID int
+	IssuedAt  time.Time
+}
+
+type syncTokenPayload struct {
+	Seq       int64 `json:"s"`
+	ProductID int   `json:"p"`
+	IssuedAt  int64 `json:"i"`
+}
+
+// NewSyncToken returns a token after the given change, the zero change means before any change
+func NewSyncToken(c Change, issuedAt time.Time) SyncToken {
+	return SyncToken{
+		Seq:       c.Seq,
+		ProductID: c.Favorite.ProductID,
+		IssuedAt:  issuedAt,
+	}
+}
+
+// Expired reports if the token was issued before the cutoff, the removals older than it may have been forgotten
+func (t SyncToken) Expired(cutoff time.Time) bool {
+	return t.IssuedAt.Before(cutoff)
+}
+
+func (t SyncToken) Encode() string {
+	b, _ := json.Marshal(syncTokenPayload{
+		Seq:       t.Seq,
+		ProductID: t.ProductID,
+		IssuedAt:  t.IssuedAt.Unix(),
+	})
+
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func DecodeSyncToken(s string) (SyncToken, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return SyncToken{}, ErrInvalidSyncToken
+	}
+
+	var p syncTokenPayload
+	if err := json.Unmarshal(b, &p); err != nil {
+		return SyncToken{}, ErrInvalidSyncToken
+	}
+
+	if p.Seq < 0 || p.ProductID < 0 || p.IssuedAt <= 0 {
+		return SyncToken{}, ErrInvalidSyncToken
+	}
+
+	return SyncToken{
+		Seq:       p.Seq,
+		ProductID: p.ProductID,
+		IssuedAt:  time.Unix(p.IssuedAt, 0),
+	}, nil
+}
@@ -0,0 +1,81 @@
+package favorite_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/favorite/fixture"
+)
+
+func TestDecodeSyncToken(t *testing.T) {
+	t.Parallel()
+
+	issuedAt := time.Now().Truncate(time.Second)
+	change := favorite.Change{
+		Seq:      7,
+		Favorite: fixture.AnyFavorite().WithProductID(42).Build(),
+	}
+
+	testCases := []struct {
+		about         string
+		token         string
+		expectedToken favorite.SyncToken
+		expectedError error
+	}{
+		{
+			about:         "when token is not base64",
+			token:         "%%%",
+			expectedError: favorite.ErrInvalidSyncToken,
+		},
+		{
+			about:         "when token is not a valid payload",
+			token:         "aW52YWxpZA",
+			expectedError: favorite.ErrInvalidSyncToken,
+		},
+		{
+			about:         "when token has no issue date",
+			token:         "eyJzIjoxLCJwIjoxfQ",
+			expectedError: favorite.ErrInvalidSyncToken,
+		},
+		{
+			about:         "when token is valid",
+			token:         favorite.NewSyncToken(change, issuedAt).Encode(),
+			expectedToken: favorite.SyncToken{Seq: 7, ProductID: 42, IssuedAt: issuedAt},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Action
+			res, err := favorite.DecodeSyncToken(tc.token)
+
+			// Assert
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				assert.Equal(t, favorite.SyncToken{}, res)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedToken.Seq, res.Seq)
+			assert.Equal(t, tc.expectedToken.ProductID, res.ProductID)
+			assert.True(t, tc.expectedToken.IssuedAt.Equal(res.IssuedAt))
+		})
+	}
+}
+
+func TestSyncToken_Expired(t *testing.T) {
+	t.Parallel()
+
+	cutoff := time.Now().Add(-24 * time.Hour)
+
+	assert.False(t, favorite.SyncToken{IssuedAt: cutoff.Add(time.Hour)}.Expired(cutoff))
+	assert.True(t, favorite.SyncToken{IssuedAt: cutoff.Add(-time.Hour)}.Expired(cutoff))
+}
@@ -79,7 +79,7 @@ func (u *signUpUseCase) Execute(ctx context.Context, p dto.SignUpParams) (user.U
 		return user.User{}, err
 	}
 
-	if err := u.repo.Create(ctx, c); err != nil {
+	if err := u.repo.Create(ctx, c, c.Event(user.EventSignedUp)); err != nil {
 		logger.ErrorF(ctx, "error while sign up user", logger.Fields{
 			"user_email": p.Email,
 			"error":      err.Error(),
@@ -16,6 +16,7 @@ import (
 	passwordMocks "github.com/uesleicarvalhoo/aiqfome/pkg/password/mocks"
 	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
 	mocksUuid "github.com/uesleicarvalhoo/aiqfome/pkg/uuid/mocks"
+	"github.com/uesleicarvalhoo/aiqfome/test"
 	"github.com/uesleicarvalhoo/aiqfome/user"
 	fixtureUser "github.com/uesleicarvalhoo/aiqfome/user/fixture"
 	mocksUser "github.com/uesleicarvalhoo/aiqfome/user/mocks"
@@ -96,7 +97,7 @@ func TestSignUpUseCase_Execute(t *testing.T) {
 			setupRepo: func(r *mocksUser.Repository) {
 				r.On("FindByEmail", mock.Anything, paramsBuilder.Build().Email).
 					Return(user.User{}, user.ErrNotFound)
-				r.On("Create", mock.Anything, mock.AnythingOfType("user.User")).
+				r.On("Create", mock.Anything, mock.AnythingOfType("user.User"), test.MatchEvent(user.EventSignedUp)).
 					Return(errors.New("db error"))
 			},
 			setupHasher: func(h *passwordMocks.Hasher) {
@@ -114,7 +115,7 @@ func TestSignUpUseCase_Execute(t *testing.T) {
 			setupRepo: func(r *mocksUser.Repository) {
 				r.On("FindByEmail", mock.Anything, paramsBuilder.Build().Email).
 					Return(user.User{}, user.ErrNotFound)
-				r.On("Create", mock.Anything, mock.AnythingOfType("user.User")).
+				r.On("Create", mock.Anything, mock.AnythingOfType("user.User"), test.MatchEvent(user.EventSignedUp)).
 					Return(nil)
 			},
 			setupHasher: func(h *passwordMocks.Hasher) {
@@ -52,7 +52,7 @@ func (u *deleteClientUseCase) Execute(ctx context.Context, p dto.DeleteClientPar
 		})
 	}
 
-	if err := u.repo.Delete(ctx, usr); err != nil {
+	if err := u.repo.Delete(ctx, usr, usr.Event(user.EventDeleted)); err != nil {
 		logger.ErrorF(ctx, "error while trying to paginate clients", logger.Fields{
 			"error": err.Error(),
 		})
@@ -12,6 +12,7 @@ import (
 	"github.com/uesleicarvalhoo/aiqfome/internal/app/client/dto/fixture"
 	"github.com/uesleicarvalhoo/aiqfome/internal/app/client/usecase"
 	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/test"
 	"github.com/uesleicarvalhoo/aiqfome/user"
 	fixtureUser "github.com/uesleicarvalhoo/aiqfome/user/fixture"
 	userMocks "github.com/uesleicarvalhoo/aiqfome/user/mocks"
@@ -45,7 +46,7 @@ func TestDeleteClientUseCase_Execute(t *testing.T) {
 			setupRepo: func(r *userMocks.Repository) {
 				r.On("Find", mock.Anything, userID).
 					Return(userBuilder.Build(), nil)
-				r.On("Delete", mock.Anything, mock.AnythingOfType("user.User")).
+				r.On("Delete", mock.Anything, mock.AnythingOfType("user.User"), test.MatchEvent(user.EventDeleted)).
 					Return(errors.New("db error"))
 			},
 			expectedErr: "[AQF004] erro ao deletar cliente | cause: db error",
@@ -74,7 +75,7 @@ func TestDeleteClientUseCase_Execute(t *testing.T) {
 			setupRepo: func(r *userMocks.Repository) {
 				r.On("Find", mock.Anything, userID).
 					Return(userBuilder.Build(), nil)
-				r.On("Delete", mock.Anything, userBuilder.Build()).
+				r.On("Delete", mock.Anything, userBuilder.Build(), test.MatchEvent(user.EventDeleted)).
 					Return(errors.New("db error"))
 			},
 			expectedErr: "[AQF004] erro ao deletar cliente | cause: db error",
@@ -85,7 +86,7 @@ func TestDeleteClientUseCase_Execute(t *testing.T) {
 			setupRepo: func(r *userMocks.Repository) {
 				r.On("Find", mock.Anything, userID).
 					Return(userBuilder.Build(), nil)
-				r.On("Delete", mock.Anything, userBuilder.Build()).
+				r.On("Delete", mock.Anything, userBuilder.Build(), test.MatchEvent(user.EventDeleted)).
 					Return(nil)
 			},
 			expectedErr: "",
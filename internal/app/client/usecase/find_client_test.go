@@ -12,6 +12,7 @@ import (
 	fixtureDTO "github.com/uesleicarvalhoo/aiqfome/internal/app/client/dto/fixture"
 	"github.com/uesleicarvalhoo/aiqfome/internal/app/client/usecase"
 	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/test"
 	"github.com/uesleicarvalhoo/aiqfome/user"
 	fixtureUser "github.com/uesleicarvalhoo/aiqfome/user/fixture"
 	clientMocks "github.com/uesleicarvalhoo/aiqfome/user/mocks"
@@ -62,7 +63,7 @@ func TestUpdateClientUseCase_Execute(t *testing.T) {
 
 				r.On("Update", mock.Anything, mock.MatchedBy(func(c user.User) bool {
 					return c.ID == clientID && c.Name == newName && c.Active == newActive
-				})).
+				}), test.MatchEvent(user.EventUpdated)).
 					Return(errors.New("db error"))
 			},
 			expectedErr: "[AQF004] failed to update client | cause: db error",
@@ -76,7 +77,7 @@ func TestUpdateClientUseCase_Execute(t *testing.T) {
 
 				r.On("Update", mock.Anything, mock.MatchedBy(func(c user.User) bool {
 					return c.ID == clientID && c.Name == newName && c.Active == newActive
-				})).
+				}), test.MatchEvent(user.EventUpdated)).
 					Return(nil)
 			},
 			expectedResp: clientBuilder.WithName(newName).WithActive(newActive).Build(),
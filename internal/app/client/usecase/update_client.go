@@ -60,7 +60,7 @@ func (u *updateClientUseCase) Execute(ctx context.Context, p dto.UpdateClientPar
 		return dto.Client{}, err
 	}
 
-	if err := u.repo.Update(ctx, usr); err != nil {
+	if err := u.repo.Update(ctx, usr, usr.Event(user.EventUpdated)); err != nil {
 		return dto.Client{}, domainerror.Wrap(err, domainerror.DependecyError, "failed to update client", map[string]any{
 			"error": err.Error(),
 		})
@@ -0,0 +1,30 @@
+package dto
+
+import (
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/validator"
+)
+
+type AddProductToListParams struct {
+	ClientID  uuid.ID `json:"-"`
+	ListID    uuid.ID `json:"-"`
+	ProductID int     `json:"productId"`
+}
+
+func (p AddProductToListParams) Validate() error {
+	v := validator.New()
+
+	if p.ClientID.IsZero() {
+		v.AddError("clientId", "campo obrigatório")
+	}
+
+	if p.ListID.IsZero() {
+		v.AddError("listId", "campo obrigatório")
+	}
+
+	if p.ProductID == 0 {
+		v.AddError("productId", "campo obrigatório")
+	}
+
+	return v.Validate()
+}
@@ -0,0 +1,57 @@
+package dto_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto/fixture"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+func TestAddProductToListParams_Validate(t *testing.T) {
+	t.Parallel()
+
+	builder := fixture.AnyAddProductToListParams()
+
+	testCases := []struct {
+		about         string
+		params        dto.AddProductToListParams
+		expectedError string
+	}{
+		{
+			about:         "when listID is zero",
+			params:        builder.WithListID(uuid.Nil).Build(),
+			expectedError: "[AQF002] listId: campo obrigatório",
+		},
+		{
+			about:         "when productID is zero",
+			params:        builder.WithProductID(0).Build(),
+			expectedError: "[AQF002] productId: campo obrigatório",
+		},
+		{
+			about:         "when all fields are invalid",
+			params:        builder.WithClientID(uuid.Nil).WithListID(uuid.Nil).WithProductID(0).Build(),
+			expectedError: "[AQF002] clientId: campo obrigatório; listId: campo obrigatório; productId: campo obrigatório",
+		},
+		{
+			about:         "when all values are valid",
+			params:        builder.Build(),
+			expectedError: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.params.Validate()
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package dto
+
+import (
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+type AddProductsToFavoritesParams struct {
+	ClientID   uuid.ID `json:"-"`
+	ProductIDs []int   `json:"productIds"`
+}
+
+func (p AddProductsToFavoritesParams) Validate() error {
+	return validateBatch(p.ClientID, p.ProductIDs)
+}
@@ -1,15 +1,66 @@
 package dto
 
 import (
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
 	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
 	"github.com/uesleicarvalhoo/aiqfome/pkg/validator"
-	"github.com/uesleicarvalhoo/aiqfome/product"
+)
+
+type PaginationMode string
+
+const (
+	PaginationPage   PaginationMode = "page"
+	PaginationCursor PaginationMode = "cursor"
+)
+
+type SortField string
+
+const (
+	SortRegisteredAt SortField = "registeredAt"
+	SortPrice        SortField = "price"
+	SortTitle        SortField = "title"
+	SortRating       SortField = "rating"
+	SortManual       SortField = "manual"
+)
+
+type SortOrder string
+
+const (
+	OrderAsc  SortOrder = "asc"
+	OrderDesc SortOrder = "desc"
 )
 
 type GetClientFavoritesParams struct {
-	ClientID uuid.ID `json:"-"`
-	Page     int     `json:"page"`
-	PageSize int     `json:"pageSize"`
+	ClientID     uuid.ID        `json:"-"`
+	Page         int            `json:"page"`
+	PageSize     int            `json:"pageSize"`
+	Tag          string         `json:"tag"`
+	Mode         PaginationMode `json:"mode"`
+	Cursor       string         `json:"cursor"`
+	Sort         SortField      `json:"sort"`
+	Order        SortOrder      `json:"order"`
+	Category     string         `json:"category"`
+	MinPrice     *float32       `json:"minPrice"`
+	MaxPrice     *float32       `json:"maxPrice"`
+	MinRating    *float32       `json:"minRating"`
+	PriceDropped bool           `json:"priceDropped"`
+}
+
+// UsesCursor reports if the listing must be paginated by cursor, informing a cursor implies the cursor mode
+func (p GetClientFavoritesParams) UsesCursor() bool {
+	return p.Mode == PaginationCursor || p.Cursor != ""
+}
+
+// UsesProductAttributes reports if the listing is sorted or filtered by data of the products
+func (p GetClientFavoritesParams) UsesProductAttributes() bool {
+	sortByProduct := p.Sort == SortPrice || p.Sort == SortTitle || p.Sort == SortRating
+
+	return sortByProduct || p.FiltersByProduct()
+}
+
+// FiltersByProduct reports if the listing is filtered by data of the products
+func (p GetClientFavoritesParams) FiltersByProduct() bool {
+	return p.Category != "" || p.MinPrice != nil || p.MaxPrice != nil || p.MinRating != nil || p.PriceDropped
 }
 
 func (p GetClientFavoritesParams) Validate() error {
@@ -27,12 +78,57 @@ func (p GetClientFavoritesParams) Validate() error {
 		v.AddError("page", "não pode ser negativo")
 	}
 
+	if p.Mode != "" && p.Mode != PaginationPage && p.Mode != PaginationCursor {
+		v.AddError("mode", "deve ser page ou cursor")
+	}
+
+	switch p.Sort {
+	case "", SortRegisteredAt, SortPrice, SortTitle, SortRating, SortManual:
+	default:
+		v.AddError("sort", "deve ser registeredAt, price, title, rating ou manual")
+	}
+
+	if p.Order != "" && p.Order != OrderAsc && p.Order != OrderDesc {
+		v.AddError("order", "deve ser asc ou desc")
+	}
+
+	if p.MinPrice != nil && *p.MinPrice < 0 {
+		v.AddError("minPrice", "não pode ser negativo")
+	}
+
+	if p.MaxPrice != nil && *p.MaxPrice < 0 {
+		v.AddError("maxPrice", "não pode ser negativo")
+	}
+
+	if p.MinPrice != nil && p.MaxPrice != nil && *p.MinPrice > *p.MaxPrice {
+		v.AddError("maxPrice", "deve ser maior ou igual a minPrice")
+	}
+
+	if p.MinRating != nil && (*p.MinRating < 0 || *p.MinRating > 5) {
+		v.AddError("minRating", "deve estar entre 0 e 5")
+	}
+
+	if p.UsesCursor() && (p.UsesProductAttributes() || p.Sort != "" || p.Order != "") {
+		v.AddError("mode", "o modo cursor não suporta ordenação ou filtros por produto")
+	}
+
+	if p.Mode == PaginationPage && p.Cursor != "" {
+		v.AddError("cursor", "não pode ser usado com o modo page")
+	} else if p.Cursor != "" {
+		if _, err := favorite.DecodeCursor(p.Cursor); err != nil {
+			v.AddError("cursor", "cursor inválido")
+		}
+	}
+
 	return v.Validate()
 }
 
 type ClientFavorites struct {
-	ClientID uuid.ID           `json:"clientId"`
-	Products []product.Product `json:"products"`
-	Total    int               `json:"total"`
-	Pages    int               `json:"pages"`
+	ClientID   uuid.ID        `json:"clientId"`
+	Products   []FavoriteItem `json:"products"`
+	Total      int            `json:"total"`
+	Pages      int            `json:"pages"`
+	NextCursor string         `json:"nextCursor,omitempty"`
+	PrevCursor string         `json:"prevCursor,omitempty"`
+	Warnings   []Warning      `json:"warnings,omitempty"`
 }
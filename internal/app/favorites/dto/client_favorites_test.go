@@ -4,15 +4,19 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	fixtureFavorite "github.com/uesleicarvalhoo/aiqfome/favorite/fixture"
 	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
 	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto/fixture"
 	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/test"
 )
 
 func TestGetClientFavoritesParams_Validate(t *testing.T) {
 	t.Parallel()
 
 	builder := fixture.AnyGetClientFavoritesParams()
+	validCursor := favorite.NewCursor(fixtureFavorite.AnyFavorite().Build(), favorite.DirectionNext).Encode()
 
 	testCases := []struct {
 		about         string
@@ -39,6 +43,61 @@ func TestGetClientFavoritesParams_Validate(t *testing.T) {
 			params:        builder.WithClientID(uuid.Nil).WithPageSize(0).WithPage(-1).Build(),
 			expectedError: "[AQF002] clientId: campo obrigatório; pageSize: deve ser maior do que 1; page: não pode ser negativo",
 		},
+		{
+			about:         "when mode is invalid",
+			params:        builder.WithMode("offset").Build(),
+			expectedError: "[AQF002] mode: deve ser page ou cursor",
+		},
+		{
+			about:         "when cursor is used with page mode",
+			params:        builder.WithMode(dto.PaginationPage).WithCursor(validCursor).Build(),
+			expectedError: "[AQF002] cursor: não pode ser usado com o modo page",
+		},
+		{
+			about:         "when cursor is invalid",
+			params:        builder.WithCursor("invalid").Build(),
+			expectedError: "[AQF002] cursor: cursor inválido",
+		},
+		{
+			about:         "when cursor is valid",
+			params:        builder.WithCursor(validCursor).Build(),
+			expectedError: "",
+		},
+		{
+			about:         "when sort is invalid",
+			params:        builder.WithSort("name", "").Build(),
+			expectedError: "[AQF002] sort: deve ser registeredAt, price, title, rating ou manual",
+		},
+		{
+			about:         "when order is invalid",
+			params:        builder.WithSort(dto.SortPrice, "up").Build(),
+			expectedError: "[AQF002] order: deve ser asc ou desc",
+		},
+		{
+			about:         "when prices are negative",
+			params:        builder.WithPriceRange(test.Ptr[float32](-1), test.Ptr[float32](-1)).Build(),
+			expectedError: "[AQF002] minPrice: não pode ser negativo; maxPrice: não pode ser negativo",
+		},
+		{
+			about:         "when minPrice is greater than maxPrice",
+			params:        builder.WithPriceRange(test.Ptr[float32](10), test.Ptr[float32](5)).Build(),
+			expectedError: "[AQF002] maxPrice: deve ser maior ou igual a minPrice",
+		},
+		{
+			about:         "when minRating is out of range",
+			params:        builder.WithMinRating(test.Ptr[float32](6)).Build(),
+			expectedError: "[AQF002] minRating: deve estar entre 0 e 5",
+		},
+		{
+			about:         "when cursor mode is used with product sort",
+			params:        builder.WithMode(dto.PaginationCursor).WithSort(dto.SortPrice, dto.OrderAsc).Build(),
+			expectedError: "[AQF002] mode: o modo cursor não suporta ordenação ou filtros por produto",
+		},
+		{
+			about:         "when sorting and filtering by product",
+			params:        builder.WithSort(dto.SortRating, dto.OrderDesc).WithCategory("electronics").WithPriceRange(test.Ptr[float32](1), test.Ptr[float32](10)).Build(),
+			expectedError: "",
+		},
 		{
 			about:         "when all values are valid",
 			params:        builder.Build(),
@@ -0,0 +1,25 @@
+package dto
+
+import (
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/validator"
+)
+
+type CreateFavoriteListParams struct {
+	ClientID uuid.ID `json:"-"`
+	Name     string  `json:"name"`
+}
+
+func (p CreateFavoriteListParams) Validate() error {
+	v := validator.New()
+
+	if p.ClientID.IsZero() {
+		v.AddError("clientId", "campo obrigatório")
+	}
+
+	if p.Name == "" {
+		v.AddError("name", "campo obrigatório")
+	}
+
+	return v.Validate()
+}
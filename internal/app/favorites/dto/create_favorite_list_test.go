@@ -0,0 +1,52 @@
+package dto_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto/fixture"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+func TestCreateFavoriteListParams_Validate(t *testing.T) {
+	t.Parallel()
+
+	builder := fixture.AnyCreateFavoriteListParams()
+
+	testCases := []struct {
+		about         string
+		params        dto.CreateFavoriteListParams
+		expectedError string
+	}{
+		{
+			about:         "when clientID is zero",
+			params:        builder.WithClientID(uuid.Nil).Build(),
+			expectedError: "[AQF002] clientId: campo obrigatório",
+		},
+		{
+			about:         "when name is empty",
+			params:        builder.WithName("").Build(),
+			expectedError: "[AQF002] name: campo obrigatório",
+		},
+		{
+			about:         "when all values are valid",
+			params:        builder.Build(),
+			expectedError: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.params.Validate()
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package dto
+
+import (
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/validator"
+)
+
+type DeleteFavoriteListParams struct {
+	ClientID uuid.ID `json:"clientId"`
+	ListID   uuid.ID `json:"listId"`
+}
+
+func (p DeleteFavoriteListParams) Validate() error {
+	v := validator.New()
+
+	if p.ClientID.IsZero() {
+		v.AddError("clientId", "campo obrigatório")
+	}
+
+	if p.ListID.IsZero() {
+		v.AddError("listId", "campo obrigatório")
+	}
+
+	return v.Validate()
+}
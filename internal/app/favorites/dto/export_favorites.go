@@ -0,0 +1,109 @@
+package dto
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/validator"
+	"github.com/uesleicarvalhoo/aiqfome/product"
+)
+
+type ExportFormat string
+
+const (
+	ExportCSV  ExportFormat = "csv"
+	ExportJSON ExportFormat = "json"
+)
+
+func (f ExportFormat) ContentType() string {
+	if f == ExportCSV {
+		return "text/csv; charset=utf-8"
+	}
+
+	return "application/json"
+}
+
+// ExportTagsSeparator joins the tags of a favorite in a single csv column
+const ExportTagsSeparator = "|"
+
+// ExportCSVHeader is the first line of the csv export, in the same order of ExportedFavorite.CSVRecord
+var ExportCSVHeader = []string{"product_id", "title", "price", "category", "note", "tags", "price_when_favorited", "registred_at"}
+
+type ExportFavoritesParams struct {
+	ClientID uuid.ID      `json:"-"`
+	Format   ExportFormat `json:"format"`
+}
+
+func (p ExportFavoritesParams) Validate() error {
+	v := validator.New()
+
+	if p.ClientID.IsZero() {
+		v.AddError("clientId", "campo obrigatório")
+	}
+
+	if p.Format != ExportCSV && p.Format != ExportJSON {
+		v.AddError("format", "deve ser csv ou json")
+	}
+
+	return v.Validate()
+}
+
+type ExportedFavorite struct {
+	ProductID          int       `json:"productId"`
+	Title              string    `json:"title"`
+	Price              *float32  `json:"price"`
+	Category           string    `json:"category"`
+	Note               string    `json:"note"`
+	Tags               []string  `json:"tags"`
+	PriceWhenFavorited *float32  `json:"priceWhenFavorited"`
+	RegistredAt        time.Time `json:"registredAt"`
+}
+
+// NewExportedFavorite builds the exported favorite, when the product is nil it was removed upstream and only the stored data is exported
+func NewExportedFavorite(f favorite.Favorite, p *product.Product) ExportedFavorite {
+	e := ExportedFavorite{
+		ProductID:          f.ProductID,
+		Title:              f.TitleWhenFavorited,
+		Note:               f.Note,
+		Tags:               f.Tags,
+		PriceWhenFavorited: f.PriceWhenFavorited,
+		RegistredAt:        f.RegistredAt,
+	}
+
+	if e.Tags == nil {
+		e.Tags = []string{}
+	}
+
+	if p != nil {
+		price := p.Price
+		e.Title = p.Title
+		e.Price = &price
+		e.Category = p.Category
+	}
+
+	return e
+}
+
+func (e ExportedFavorite) CSVRecord() []string {
+	return []string{
+		strconv.Itoa(e.ProductID),
+		e.Title,
+		formatPrice(e.Price),
+		e.Category,
+		e.Note,
+		strings.Join(e.Tags, ExportTagsSeparator),
+		formatPrice(e.PriceWhenFavorited),
+		e.RegistredAt.Format(time.RFC3339),
+	}
+}
+
+func formatPrice(p *float32) string {
+	if p == nil {
+		return ""
+	}
+
+	return strconv.FormatFloat(float64(*p), 'f', 2, 32)
+}
@@ -0,0 +1,102 @@
+package dto_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	fixtureFavorite "github.com/uesleicarvalhoo/aiqfome/favorite/fixture"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto/fixture"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	fixtureProduct "github.com/uesleicarvalhoo/aiqfome/product/fixture"
+	"github.com/uesleicarvalhoo/aiqfome/test"
+)
+
+func TestExportFavoritesParams_Validate(t *testing.T) {
+	t.Parallel()
+
+	builder := fixture.AnyExportFavoritesParams()
+
+	testCases := []struct {
+		about         string
+		params        dto.ExportFavoritesParams
+		expectedError string
+	}{
+		{
+			about:         "when clientID is zero",
+			params:        builder.WithClientID(uuid.Nil).Build(),
+			expectedError: "[AQF002] clientId: campo obrigatório",
+		},
+		{
+			about:         "when format is invalid",
+			params:        builder.WithFormat("xml").Build(),
+			expectedError: "[AQF002] format: deve ser csv ou json",
+		},
+		{
+			about:         "when format is json",
+			params:        builder.WithFormat(dto.ExportJSON).Build(),
+			expectedError: "",
+		},
+		{
+			about:         "when all values are valid",
+			params:        builder.Build(),
+			expectedError: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.params.Validate()
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestExportedFavorite_CSVRecord(t *testing.T) {
+	t.Parallel()
+
+	registredAt := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	favoriteBuilder := fixtureFavorite.AnyFavorite().
+		WithProductID(1).
+		WithNote("presente").
+		WithTags([]string{"natal", "mae"}).
+		WithSnapshot("Notebook antigo", 120).
+		WithRegistredAt(registredAt)
+
+	testCases := []struct {
+		about          string
+		exported       dto.ExportedFavorite
+		expectedRecord []string
+	}{
+		{
+			about: "when product is available",
+			exported: dto.NewExportedFavorite(
+				favoriteBuilder.Build(),
+				test.Ptr(fixtureProduct.AnyProduct().WithID(1).WithTitle("Notebook").WithPrice(99.9).WithCategory("electronics").Build()),
+			),
+			expectedRecord: []string{"1", "Notebook", "99.90", "electronics", "presente", "natal|mae", "120.00", "2026-10-18T12:00:00Z"},
+		},
+		{
+			about:          "when product was removed upstream",
+			exported:       dto.NewExportedFavorite(favoriteBuilder.Build(), nil),
+			expectedRecord: []string{"1", "Notebook antigo", "", "", "presente", "natal|mae", "120.00", "2026-10-18T12:00:00Z"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expectedRecord, tc.exported.CSVRecord())
+		})
+	}
+}
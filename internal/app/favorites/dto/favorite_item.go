@@ -0,0 +1,63 @@
+package dto
+
+import (
+	"math"
+	"time"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/product"
+)
+
+type FavoriteItem struct {
+	product.Product
+	Note               string    `json:"note"`
+	Tags               []string  `json:"tags"`
+	PriceWhenFavorited *float32  `json:"priceWhenFavorited"`
+	CurrentPrice       float32   `json:"currentPrice"`
+	PriceDelta         *float32  `json:"priceDelta"`
+	RegistredAt        time.Time `json:"registredAt"`
+	Position           int64     `json:"position"`
+	// Unavailable is set when the product was removed upstream, only the id and the title when favorited are known
+	Unavailable bool `json:"unavailable,omitempty"`
+}
+
+// PriceDropped reports if the product is cheaper now than when it was favorited
+func (i FavoriteItem) PriceDropped() bool {
+	return i.PriceDelta != nil && *i.PriceDelta < 0
+}
+
+func NewFavoriteItem(f favorite.Favorite, p product.Product) FavoriteItem {
+	i := FavoriteItem{
+		Product:            p,
+		Note:               f.Note,
+		Tags:               f.Tags,
+		PriceWhenFavorited: f.PriceWhenFavorited,
+		CurrentPrice:       p.Price,
+		RegistredAt:        f.RegistredAt,
+		Position:           f.Position,
+	}
+
+	// Favorites created before the price snapshot have no delta
+	if f.PriceWhenFavorited != nil {
+		delta := float32(math.Round(float64(p.Price-*f.PriceWhenFavorited)*100) / 100)
+		i.PriceDelta = &delta
+	}
+
+	return i
+}
+
+// NewUnavailableFavoriteItem returns a placeholder for a favorite whose product was removed upstream
+func NewUnavailableFavoriteItem(f favorite.Favorite) FavoriteItem {
+	return FavoriteItem{
+		Product: product.Product{
+			ID:    f.ProductID,
+			Title: f.TitleWhenFavorited,
+		},
+		Note:               f.Note,
+		Tags:               f.Tags,
+		PriceWhenFavorited: f.PriceWhenFavorited,
+		RegistredAt:        f.RegistredAt,
+		Position:           f.Position,
+		Unavailable:        true,
+	}
+}
@@ -0,0 +1,35 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/product"
+)
+
+type FavoriteList struct {
+	ID        uuid.ID   `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+func ListFromDomain(l favorite.List) FavoriteList {
+	return FavoriteList{
+		ID:        l.ID,
+		Name:      l.Name,
+		CreatedAt: l.CreatedAt,
+		UpdatedAt: l.UpdatedAt,
+	}
+}
+
+type ClientFavoriteLists struct {
+	ClientID uuid.ID        `json:"clientId"`
+	Lists    []FavoriteList `json:"lists"`
+}
+
+type ListProduct struct {
+	ListID  uuid.ID         `json:"listId"`
+	Product product.Product `json:"product"`
+}
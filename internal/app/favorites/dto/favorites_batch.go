@@ -0,0 +1,49 @@
+package dto
+
+import (
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/validator"
+)
+
+type BatchItemStatus string
+
+const (
+	BatchItemAdded     BatchItemStatus = "added"
+	BatchItemRemoved   BatchItemStatus = "removed"
+	BatchItemDuplicate BatchItemStatus = "duplicate"
+	BatchItemNotFound  BatchItemStatus = "not_found"
+	BatchItemInvalid   BatchItemStatus = "invalid"
+	// BatchItemQuotaExceeded is used by imports, the favorite was skipped because the client reached the quota
+	BatchItemQuotaExceeded BatchItemStatus = "quota_exceeded"
+)
+
+type BatchItemResult struct {
+	ProductID int             `json:"productId"`
+	Status    BatchItemStatus `json:"status"`
+}
+
+type FavoritesBatchResult struct {
+	ClientID uuid.ID           `json:"clientId"`
+	Items    []BatchItemResult `json:"items"`
+}
+
+func validateBatch(clientID uuid.ID, productIDs []int) error {
+	v := validator.New()
+
+	if clientID.IsZero() {
+		v.AddError("clientId", "campo obrigatório")
+	}
+
+	if len(productIDs) == 0 {
+		v.AddError("productIds", "campo obrigatório")
+	}
+
+	for _, id := range productIDs {
+		if id <= 0 {
+			v.AddError("productIds", "deve conter apenas ids válidos")
+			break
+		}
+	}
+
+	return v.Validate()
+}
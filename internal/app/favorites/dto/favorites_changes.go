@@ -0,0 +1,50 @@
+package dto
+
+import (
+	"fmt"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/validator"
+)
+
+const FavoritesChangesMaxLimit = 500
+
+type GetFavoritesChangesParams struct {
+	ClientID uuid.ID `json:"-"`
+	// Since is the syncToken of the previous response, empty to get all favorites
+	Since string `json:"since"`
+	Limit int    `json:"limit"`
+}
+
+func (p GetFavoritesChangesParams) Validate() error {
+	v := validator.New()
+
+	if p.ClientID.IsZero() {
+		v.AddError("clientId", "campo obrigatório")
+	}
+
+	if p.Limit < 1 || p.Limit > FavoritesChangesMaxLimit {
+		v.AddError("limit", fmt.Sprintf("deve estar entre 1 e %d", FavoritesChangesMaxLimit))
+	}
+
+	if p.Since != "" {
+		if _, err := favorite.DecodeSyncToken(p.Since); err != nil {
+			v.AddError("since", "token de sincronização inválido")
+		}
+	}
+
+	return v.Validate()
+}
+
+// FavoritesChanges are the favorites upserted and removed since the token, a product is in only one of them.
+// When FullResync is set the token is too old, the client must drop its copy and sync again without token
+type FavoritesChanges struct {
+	ClientID   uuid.ID        `json:"clientId"`
+	Upserted   []FavoriteItem `json:"upserted"`
+	Removed    []int          `json:"removed"`
+	SyncToken  string         `json:"syncToken"`
+	HasMore    bool           `json:"hasMore"`
+	FullResync bool           `json:"fullResync"`
+	Warnings   []Warning      `json:"warnings,omitempty"`
+}
@@ -0,0 +1,61 @@
+package dto_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto/fixture"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+func TestGetFavoritesChangesParams_Validate(t *testing.T) {
+	t.Parallel()
+
+	builder := fixture.AnyGetFavoritesChangesParams()
+
+	testCases := []struct {
+		about         string
+		params        dto.GetFavoritesChangesParams
+		expectedError string
+	}{
+		{
+			about:         "when clientID is zero",
+			params:        builder.WithClientID(uuid.Nil).Build(),
+			expectedError: "[AQF002] clientId: campo obrigatório",
+		},
+		{
+			about:         "when limit is less than 1",
+			params:        builder.WithLimit(0).Build(),
+			expectedError: "[AQF002] limit: deve estar entre 1 e 500",
+		},
+		{
+			about:         "when limit is greater than the max",
+			params:        builder.WithLimit(dto.FavoritesChangesMaxLimit + 1).Build(),
+			expectedError: "[AQF002] limit: deve estar entre 1 e 500",
+		},
+		{
+			about:         "when since is not a sync token",
+			params:        builder.WithSince("invalid").Build(),
+			expectedError: "[AQF002] since: token de sincronização inválido",
+		},
+		{
+			about:  "when all values are valid",
+			params: builder.Build(),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.params.Validate()
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/validator"
+)
+
+type GetFavoritesHistoryParams struct {
+	ClientID uuid.ID `json:"-"`
+	Page     int     `json:"page"`
+	PageSize int     `json:"pageSize"`
+}
+
+func (p GetFavoritesHistoryParams) Validate() error {
+	v := validator.New()
+
+	if p.ClientID.IsZero() {
+		v.AddError("clientId", "campo obrigatório")
+	}
+
+	if p.PageSize < 1 {
+		v.AddError("pageSize", "deve ser maior do que 1")
+	}
+
+	if p.Page < 0 {
+		v.AddError("page", "não pode ser negativo")
+	}
+
+	return v.Validate()
+}
+
+// HistoryEntry is an action on a favorite, OnBehalf is set when it was made by someone other than the client, like an admin
+type HistoryEntry struct {
+	ProductID    int             `json:"productId"`
+	ProductTitle string          `json:"productTitle"`
+	Action       favorite.Action `json:"action" enums:"added,removed,restored,archived"`
+	ActorID      uuid.ID         `json:"actorId"`
+	OnBehalf     bool            `json:"onBehalf"`
+	OccurredAt   time.Time       `json:"occurredAt"`
+}
+
+func HistoryEntryFromDomain(a favorite.Activity) HistoryEntry {
+	return HistoryEntry{
+		ProductID:    a.ProductID,
+		ProductTitle: a.ProductTitle,
+		Action:       a.Action,
+		ActorID:      a.ActorID,
+		OnBehalf:     a.ActorID != a.ClientID,
+		OccurredAt:   a.OccurredAt,
+	}
+}
+
+type FavoritesHistory struct {
+	ClientID uuid.ID        `json:"clientId"`
+	Entries  []HistoryEntry `json:"entries"`
+	Total    int            `json:"total"`
+	Pages    int            `json:"pages"`
+}
@@ -0,0 +1,50 @@
+package dto
+
+import (
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/validator"
+)
+
+// FavoritesQuota is the usage of the client against its quota, Limit and Remaining are null when it is unlimited
+type FavoritesQuota struct {
+	ClientID   uuid.ID `json:"clientId"`
+	Limit      *int    `json:"limit"`
+	Used       int     `json:"used"`
+	Remaining  *int    `json:"remaining"`
+	Overridden bool    `json:"overridden"`
+}
+
+func NewFavoritesQuota(clientID uuid.ID, limit, used int, overridden bool) FavoritesQuota {
+	q := FavoritesQuota{
+		ClientID:   clientID,
+		Used:       used,
+		Overridden: overridden,
+	}
+
+	if limit > 0 {
+		remaining := max(limit-used, 0)
+		q.Limit = &limit
+		q.Remaining = &remaining
+	}
+
+	return q
+}
+
+type SetFavoritesQuotaParams struct {
+	ClientID     uuid.ID `json:"-"`
+	MaxFavorites *int    `json:"maxFavorites"`
+}
+
+func (p SetFavoritesQuotaParams) Validate() error {
+	v := validator.New()
+
+	if p.ClientID.IsZero() {
+		v.AddError("clientId", "campo obrigatório")
+	}
+
+	if p.MaxFavorites == nil {
+		v.AddError("maxFavorites", "campo obrigatório")
+	}
+
+	return v.Validate()
+}
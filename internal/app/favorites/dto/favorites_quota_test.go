@@ -0,0 +1,97 @@
+package dto_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto/fixture"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/test"
+)
+
+func TestNewFavoritesQuota(t *testing.T) {
+	t.Parallel()
+
+	clientID := uuid.NextID()
+
+	testCases := []struct {
+		about      string
+		limit      int
+		used       int
+		overridden bool
+		expected   dto.FavoritesQuota
+	}{
+		{
+			about:    "when it is unlimited",
+			limit:    0,
+			used:     10,
+			expected: dto.FavoritesQuota{ClientID: clientID, Used: 10},
+		},
+		{
+			about:      "when client is under the limit",
+			limit:      10,
+			used:       4,
+			overridden: true,
+			expected:   dto.FavoritesQuota{ClientID: clientID, Limit: test.Ptr(10), Used: 4, Remaining: test.Ptr(6), Overridden: true},
+		},
+		{
+			about:    "when client is over the limit",
+			limit:    10,
+			used:     12,
+			expected: dto.FavoritesQuota{ClientID: clientID, Limit: test.Ptr(10), Used: 12, Remaining: test.Ptr(0)},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, dto.NewFavoritesQuota(clientID, tc.limit, tc.used, tc.overridden))
+		})
+	}
+}
+
+func TestSetFavoritesQuotaParams_Validate(t *testing.T) {
+	t.Parallel()
+
+	builder := fixture.AnySetFavoritesQuotaParams()
+
+	testCases := []struct {
+		about         string
+		params        dto.SetFavoritesQuotaParams
+		expectedError string
+	}{
+		{
+			about:         "when clientID is zero",
+			params:        builder.WithClientID(uuid.Nil).Build(),
+			expectedError: "[AQF002] clientId: campo obrigatório",
+		},
+		{
+			about:         "when maxFavorites is missing",
+			params:        builder.WithMaxFavorites(nil).Build(),
+			expectedError: "[AQF002] maxFavorites: campo obrigatório",
+		},
+		{
+			about:         "when all values are valid",
+			params:        builder.Build(),
+			expectedError: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.params.Validate()
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
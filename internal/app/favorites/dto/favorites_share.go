@@ -0,0 +1,118 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/validator"
+	"github.com/uesleicarvalhoo/aiqfome/product"
+)
+
+type CreateFavoritesShareParams struct {
+	ClientID  uuid.ID    `json:"-"`
+	ExpiresAt *time.Time `json:"expiresAt"`
+}
+
+func (p CreateFavoritesShareParams) Validate() error {
+	v := validator.New()
+
+	if p.ClientID.IsZero() {
+		v.AddError("clientId", "campo obrigatório")
+	}
+
+	return v.Validate()
+}
+
+type RevokeFavoritesShareParams struct {
+	ClientID uuid.ID `json:"-"`
+	Token    string  `json:"token"`
+}
+
+func (p RevokeFavoritesShareParams) Validate() error {
+	v := validator.New()
+
+	if p.ClientID.IsZero() {
+		v.AddError("clientId", "campo obrigatório")
+	}
+
+	if p.Token == "" {
+		v.AddError("token", "campo obrigatório")
+	}
+
+	return v.Validate()
+}
+
+type FavoritesShare struct {
+	Token     string     `json:"token"`
+	Path      string     `json:"path"`
+	CreatedAt time.Time  `json:"createdAt"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	Expired   bool       `json:"expired"`
+}
+
+func ShareFromDomain(s favorite.Share) FavoritesShare {
+	return FavoritesShare{
+		Token:     s.Token,
+		Path:      "/shared/" + s.Token,
+		CreatedAt: s.CreatedAt,
+		ExpiresAt: s.ExpiresAt,
+		Expired:   s.IsExpired(time.Now()),
+	}
+}
+
+type ClientFavoritesShares struct {
+	ClientID uuid.ID          `json:"clientId"`
+	Shares   []FavoritesShare `json:"shares"`
+}
+
+type GetSharedFavoritesParams struct {
+	Token    string `json:"-"`
+	Page     int    `json:"page"`
+	PageSize int    `json:"pageSize"`
+}
+
+func (p GetSharedFavoritesParams) Validate() error {
+	v := validator.New()
+
+	if p.Token == "" {
+		v.AddError("token", "campo obrigatório")
+	}
+
+	if p.PageSize < 1 {
+		v.AddError("pageSize", "deve ser maior do que 1")
+	}
+
+	if p.Page < 0 {
+		v.AddError("page", "não pode ser negativo")
+	}
+
+	return v.Validate()
+}
+
+// SharedFavorites is what a visitor sees through a share link, only the products without the client data, notes and tags
+type SharedFavorites struct {
+	Products  []SharedFavorite `json:"products"`
+	Total     int              `json:"total"`
+	Pages     int              `json:"pages"`
+	ExpiresAt *time.Time       `json:"expiresAt,omitempty"`
+	Warnings  []Warning        `json:"warnings,omitempty"`
+}
+
+type SharedFavorite struct {
+	product.Product
+	// Unavailable is set when the product was removed upstream, only the id and the title when favorited are known
+	Unavailable bool `json:"unavailable,omitempty"`
+}
+
+func NewSharedFavorites(items []FavoriteItem) []SharedFavorite {
+	ss := make([]SharedFavorite, 0, len(items))
+	for _, i := range items {
+		ss = append(ss, SharedFavorite{
+			Product:     i.Product,
+			Unavailable: i.Unavailable,
+		})
+	}
+
+	return ss
+}
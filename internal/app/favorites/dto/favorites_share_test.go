@@ -0,0 +1,142 @@
+package dto_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	fixtureFavorite "github.com/uesleicarvalhoo/aiqfome/favorite/fixture"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto/fixture"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/test"
+)
+
+func TestCreateFavoritesShareParams_Validate(t *testing.T) {
+	t.Parallel()
+
+	builder := fixture.AnyCreateFavoritesShareParams()
+
+	testCases := []struct {
+		about         string
+		params        dto.CreateFavoritesShareParams
+		expectedError string
+	}{
+		{
+			about:         "when clientID is zero",
+			params:        builder.WithClientID(uuid.Nil).Build(),
+			expectedError: "[AQF002] clientId: campo obrigatório",
+		},
+		{
+			about:         "when all values are valid",
+			params:        builder.WithExpiresAt(test.Ptr(time.Now().Add(time.Hour))).Build(),
+			expectedError: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.params.Validate()
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestRevokeFavoritesShareParams_Validate(t *testing.T) {
+	t.Parallel()
+
+	builder := fixture.AnyRevokeFavoritesShareParams()
+
+	testCases := []struct {
+		about         string
+		params        dto.RevokeFavoritesShareParams
+		expectedError string
+	}{
+		{
+			about:         "when values are empty",
+			params:        builder.WithClientID(uuid.Nil).WithToken("").Build(),
+			expectedError: "[AQF002] clientId: campo obrigatório; token: campo obrigatório",
+		},
+		{
+			about:         "when all values are valid",
+			params:        builder.Build(),
+			expectedError: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.params.Validate()
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestGetSharedFavoritesParams_Validate(t *testing.T) {
+	t.Parallel()
+
+	builder := fixture.AnyGetSharedFavoritesParams()
+
+	testCases := []struct {
+		about         string
+		params        dto.GetSharedFavoritesParams
+		expectedError string
+	}{
+		{
+			about:         "when token is empty",
+			params:        builder.WithToken("").Build(),
+			expectedError: "[AQF002] token: campo obrigatório",
+		},
+		{
+			about:         "when page values are invalid",
+			params:        builder.WithPage(-1).WithPageSize(0).Build(),
+			expectedError: "[AQF002] pageSize: deve ser maior do que 1; page: não pode ser negativo",
+		},
+		{
+			about:         "when all values are valid",
+			params:        builder.Build(),
+			expectedError: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.params.Validate()
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestShareFromDomain(t *testing.T) {
+	t.Parallel()
+
+	expired := fixtureFavorite.AnyShare().WithToken("abc").WithExpiresAt(test.Ptr(time.Now().Add(-time.Minute))).Build()
+
+	s := dto.ShareFromDomain(expired)
+
+	assert.Equal(t, "abc", s.Token)
+	assert.Equal(t, "/shared/abc", s.Path)
+	assert.True(t, s.Expired)
+}
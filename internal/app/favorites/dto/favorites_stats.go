@@ -0,0 +1,51 @@
+package dto
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/validator"
+	"github.com/uesleicarvalhoo/aiqfome/product"
+)
+
+const (
+	StatsMaxDays = 365
+	StatsMaxTop  = 100
+)
+
+type GetFavoritesStatsParams struct {
+	Days int `json:"days"`
+	Top  int `json:"top"`
+}
+
+func (p GetFavoritesStatsParams) Validate() error {
+	v := validator.New()
+
+	if p.Days < 1 || p.Days > StatsMaxDays {
+		v.AddError("days", fmt.Sprintf("deve estar entre 1 e %d", StatsMaxDays))
+	}
+
+	if p.Top < 1 || p.Top > StatsMaxTop {
+		v.AddError("top", fmt.Sprintf("deve estar entre 1 e %d", StatsMaxTop))
+	}
+
+	return v.Validate()
+}
+
+type ProductStats struct {
+	product.Product
+	Favorites int `json:"favorites"`
+}
+
+type CategoryStats struct {
+	Category  string `json:"category"`
+	Favorites int    `json:"favorites"`
+}
+
+type FavoritesStats struct {
+	MostFavorited []ProductStats           `json:"mostFavorited"`
+	Daily         []favorite.DailyActivity `json:"daily"`
+	Categories    []CategoryStats          `json:"categories"`
+	GeneratedAt   time.Time                `json:"generatedAt"`
+}
@@ -0,0 +1,56 @@
+package dto_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto/fixture"
+)
+
+func TestGetFavoritesStatsParams_Validate(t *testing.T) {
+	t.Parallel()
+
+	builder := fixture.AnyGetFavoritesStatsParams()
+
+	testCases := []struct {
+		about         string
+		params        dto.GetFavoritesStatsParams
+		expectedError string
+	}{
+		{
+			about:         "when days is zero",
+			params:        builder.WithDays(0).Build(),
+			expectedError: "[AQF002] days: deve estar entre 1 e 365",
+		},
+		{
+			about:         "when days is greater than the max",
+			params:        builder.WithDays(dto.StatsMaxDays + 1).Build(),
+			expectedError: "[AQF002] days: deve estar entre 1 e 365",
+		},
+		{
+			about:         "when top is greater than the max",
+			params:        builder.WithTop(dto.StatsMaxTop + 1).Build(),
+			expectedError: "[AQF002] top: deve estar entre 1 e 100",
+		},
+		{
+			about:         "when all values are valid",
+			params:        builder.Build(),
+			expectedError: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.params.Validate()
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/validator"
+)
+
+type GetFavoritesTrashParams struct {
+	ClientID uuid.ID `json:"-"`
+	Page     int     `json:"page"`
+	PageSize int     `json:"pageSize"`
+}
+
+func (p GetFavoritesTrashParams) Validate() error {
+	v := validator.New()
+
+	if p.ClientID.IsZero() {
+		v.AddError("clientId", "campo obrigatório")
+	}
+
+	if p.PageSize < 1 {
+		v.AddError("pageSize", "deve ser maior do que 1")
+	}
+
+	if p.Page < 0 {
+		v.AddError("page", "não pode ser negativo")
+	}
+
+	return v.Validate()
+}
+
+type TrashItem struct {
+	FavoriteItem
+	DeletedAt time.Time `json:"deletedAt"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+type FavoritesTrash struct {
+	ClientID uuid.ID     `json:"clientId"`
+	Products []TrashItem `json:"products"`
+	Total    int         `json:"total"`
+	Pages    int         `json:"pages"`
+}
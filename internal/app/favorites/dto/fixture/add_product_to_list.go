@@ -0,0 +1,43 @@
+package fixture
+
+import (
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+type AddProductToListParamsBuilder struct {
+	clientID  uuid.ID
+	listID    uuid.ID
+	productID int
+}
+
+func AnyAddProductToListParams() AddProductToListParamsBuilder {
+	return AddProductToListParamsBuilder{
+		clientID:  uuid.NextID(),
+		listID:    uuid.NextID(),
+		productID: 1,
+	}
+}
+
+func (b AddProductToListParamsBuilder) WithClientID(id uuid.ID) AddProductToListParamsBuilder {
+	b.clientID = id
+	return b
+}
+
+func (b AddProductToListParamsBuilder) WithListID(id uuid.ID) AddProductToListParamsBuilder {
+	b.listID = id
+	return b
+}
+
+func (b AddProductToListParamsBuilder) WithProductID(pid int) AddProductToListParamsBuilder {
+	b.productID = pid
+	return b
+}
+
+func (b AddProductToListParamsBuilder) Build() dto.AddProductToListParams {
+	return dto.AddProductToListParams{
+		ClientID:  b.clientID,
+		ListID:    b.listID,
+		ProductID: b.productID,
+	}
+}
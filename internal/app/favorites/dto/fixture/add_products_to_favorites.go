@@ -0,0 +1,35 @@
+package fixture
+
+import (
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+type AddProductsToFavoritesParamsBuilder struct {
+	clientID   uuid.ID
+	productIDs []int
+}
+
+func AnyAddProductsToFavoritesParams() AddProductsToFavoritesParamsBuilder {
+	return AddProductsToFavoritesParamsBuilder{
+		clientID:   uuid.NextID(),
+		productIDs: []int{1, 2},
+	}
+}
+
+func (b AddProductsToFavoritesParamsBuilder) WithClientID(id uuid.ID) AddProductsToFavoritesParamsBuilder {
+	b.clientID = id
+	return b
+}
+
+func (b AddProductsToFavoritesParamsBuilder) WithProductIDs(ids []int) AddProductsToFavoritesParamsBuilder {
+	b.productIDs = ids
+	return b
+}
+
+func (b AddProductsToFavoritesParamsBuilder) Build() dto.AddProductsToFavoritesParams {
+	return dto.AddProductsToFavoritesParams{
+		ClientID:   b.clientID,
+		ProductIDs: b.productIDs,
+	}
+}
@@ -3,13 +3,12 @@ package fixture
 import (
 	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
 	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
-	"github.com/uesleicarvalhoo/aiqfome/product"
 	fixtureProduct "github.com/uesleicarvalhoo/aiqfome/product/fixture"
 )
 
 type ClientFavoritesBuilder struct {
 	clientID uuid.ID
-	products []product.Product
+	products []dto.FavoriteItem
 	total    int
 	pages    int
 }
@@ -17,7 +16,7 @@ type ClientFavoritesBuilder struct {
 func AnyClientFavorites() ClientFavoritesBuilder {
 	return ClientFavoritesBuilder{
 		clientID: uuid.NextID(),
-		products: []product.Product{fixtureProduct.AnyProduct().Build()},
+		products: []dto.FavoriteItem{{Product: fixtureProduct.AnyProduct().Build(), Tags: []string{}}},
 		total:    1,
 		pages:    1,
 	}
@@ -28,7 +27,7 @@ func (b ClientFavoritesBuilder) WithClientID(id uuid.ID) ClientFavoritesBuilder
 	return b
 }
 
-func (b ClientFavoritesBuilder) WithProducts(prods []product.Product) ClientFavoritesBuilder {
+func (b ClientFavoritesBuilder) WithProducts(prods []dto.FavoriteItem) ClientFavoritesBuilder {
 	b.products = prods
 	return b
 }
@@ -6,9 +6,19 @@ import (
 )
 
 type GetClientFavoritesParamsBuilder struct {
-	clientID uuid.ID
-	page     int
-	pageSize int
+	clientID     uuid.ID
+	page         int
+	pageSize     int
+	tag          string
+	mode         dto.PaginationMode
+	cursor       string
+	sort         dto.SortField
+	order        dto.SortOrder
+	category     string
+	minPrice     *float32
+	maxPrice     *float32
+	minRating    *float32
+	priceDropped bool
 }
 
 func AnyGetClientFavoritesParams() GetClientFavoritesParamsBuilder {
@@ -34,10 +44,62 @@ func (b GetClientFavoritesParamsBuilder) WithPageSize(size int) GetClientFavorit
 	return b
 }
 
+func (b GetClientFavoritesParamsBuilder) WithTag(tag string) GetClientFavoritesParamsBuilder {
+	b.tag = tag
+	return b
+}
+
+func (b GetClientFavoritesParamsBuilder) WithMode(mode dto.PaginationMode) GetClientFavoritesParamsBuilder {
+	b.mode = mode
+	return b
+}
+
+func (b GetClientFavoritesParamsBuilder) WithCursor(cursor string) GetClientFavoritesParamsBuilder {
+	b.cursor = cursor
+	return b
+}
+
+func (b GetClientFavoritesParamsBuilder) WithSort(sort dto.SortField, order dto.SortOrder) GetClientFavoritesParamsBuilder {
+	b.sort = sort
+	b.order = order
+	return b
+}
+
+func (b GetClientFavoritesParamsBuilder) WithCategory(category string) GetClientFavoritesParamsBuilder {
+	b.category = category
+	return b
+}
+
+func (b GetClientFavoritesParamsBuilder) WithPriceRange(min, max *float32) GetClientFavoritesParamsBuilder {
+	b.minPrice = min
+	b.maxPrice = max
+	return b
+}
+
+func (b GetClientFavoritesParamsBuilder) WithMinRating(rating *float32) GetClientFavoritesParamsBuilder {
+	b.minRating = rating
+	return b
+}
+
+func (b GetClientFavoritesParamsBuilder) WithPriceDropped(dropped bool) GetClientFavoritesParamsBuilder {
+	b.priceDropped = dropped
+	return b
+}
+
 func (b GetClientFavoritesParamsBuilder) Build() dto.GetClientFavoritesParams {
 	return dto.GetClientFavoritesParams{
-		ClientID: b.clientID,
-		Page:     b.page,
-		PageSize: b.pageSize,
+		ClientID:     b.clientID,
+		Page:         b.page,
+		PageSize:     b.pageSize,
+		Tag:          b.tag,
+		Mode:         b.mode,
+		Cursor:       b.cursor,
+		Sort:         b.sort,
+		Order:        b.order,
+		Category:     b.category,
+		MinPrice:     b.minPrice,
+		MaxPrice:     b.maxPrice,
+		MinRating:    b.minRating,
+		PriceDropped: b.priceDropped,
 	}
 }
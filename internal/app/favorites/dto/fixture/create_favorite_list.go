@@ -0,0 +1,35 @@
+package fixture
+
+import (
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+type CreateFavoriteListParamsBuilder struct {
+	clientID uuid.ID
+	name     string
+}
+
+func AnyCreateFavoriteListParams() CreateFavoriteListParamsBuilder {
+	return CreateFavoriteListParamsBuilder{
+		clientID: uuid.NextID(),
+		name:     "Almoço",
+	}
+}
+
+func (b CreateFavoriteListParamsBuilder) WithClientID(id uuid.ID) CreateFavoriteListParamsBuilder {
+	b.clientID = id
+	return b
+}
+
+func (b CreateFavoriteListParamsBuilder) WithName(name string) CreateFavoriteListParamsBuilder {
+	b.name = name
+	return b
+}
+
+func (b CreateFavoriteListParamsBuilder) Build() dto.CreateFavoriteListParams {
+	return dto.CreateFavoriteListParams{
+		ClientID: b.clientID,
+		Name:     b.name,
+	}
+}
@@ -0,0 +1,35 @@
+package fixture
+
+import (
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+type DeleteFavoriteListParamsBuilder struct {
+	clientID uuid.ID
+	listID   uuid.ID
+}
+
+func AnyDeleteFavoriteListParams() DeleteFavoriteListParamsBuilder {
+	return DeleteFavoriteListParamsBuilder{
+		clientID: uuid.NextID(),
+		listID:   uuid.NextID(),
+	}
+}
+
+func (b DeleteFavoriteListParamsBuilder) WithClientID(id uuid.ID) DeleteFavoriteListParamsBuilder {
+	b.clientID = id
+	return b
+}
+
+func (b DeleteFavoriteListParamsBuilder) WithListID(id uuid.ID) DeleteFavoriteListParamsBuilder {
+	b.listID = id
+	return b
+}
+
+func (b DeleteFavoriteListParamsBuilder) Build() dto.DeleteFavoriteListParams {
+	return dto.DeleteFavoriteListParams{
+		ClientID: b.clientID,
+		ListID:   b.listID,
+	}
+}
@@ -0,0 +1,35 @@
+package fixture
+
+import (
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+type ExportFavoritesParamsBuilder struct {
+	clientID uuid.ID
+	format   dto.ExportFormat
+}
+
+func AnyExportFavoritesParams() ExportFavoritesParamsBuilder {
+	return ExportFavoritesParamsBuilder{
+		clientID: uuid.NextID(),
+		format:   dto.ExportCSV,
+	}
+}
+
+func (b ExportFavoritesParamsBuilder) WithClientID(id uuid.ID) ExportFavoritesParamsBuilder {
+	b.clientID = id
+	return b
+}
+
+func (b ExportFavoritesParamsBuilder) WithFormat(format dto.ExportFormat) ExportFavoritesParamsBuilder {
+	b.format = format
+	return b
+}
+
+func (b ExportFavoritesParamsBuilder) Build() dto.ExportFavoritesParams {
+	return dto.ExportFavoritesParams{
+		ClientID: b.clientID,
+		Format:   b.format,
+	}
+}
@@ -0,0 +1,53 @@
+package fixture
+
+import (
+	"time"
+
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+type FavoriteListBuilder struct {
+	id        uuid.ID
+	name      string
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+func AnyFavoriteList() FavoriteListBuilder {
+	return FavoriteListBuilder{
+		id:        uuid.NextID(),
+		name:      "Almoço",
+		createdAt: time.Now(),
+		updatedAt: time.Now(),
+	}
+}
+
+func (b FavoriteListBuilder) WithID(id uuid.ID) FavoriteListBuilder {
+	b.id = id
+	return b
+}
+
+func (b FavoriteListBuilder) WithName(name string) FavoriteListBuilder {
+	b.name = name
+	return b
+}
+
+func (b FavoriteListBuilder) WithCreatedAt(t time.Time) FavoriteListBuilder {
+	b.createdAt = t
+	return b
+}
+
+func (b FavoriteListBuilder) WithUpdatedAt(t time.Time) FavoriteListBuilder {
+	b.updatedAt = t
+	return b
+}
+
+func (b FavoriteListBuilder) Build() dto.FavoriteList {
+	return dto.FavoriteList{
+		ID:        b.id,
+		Name:      b.name,
+		CreatedAt: b.createdAt,
+		UpdatedAt: b.updatedAt,
+	}
+}
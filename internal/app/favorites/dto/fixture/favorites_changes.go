@@ -0,0 +1,42 @@
+package fixture
+
+import (
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+type GetFavoritesChangesParamsBuilder struct {
+	clientID uuid.ID
+	since    string
+	limit    int
+}
+
+func AnyGetFavoritesChangesParams() GetFavoritesChangesParamsBuilder {
+	return GetFavoritesChangesParamsBuilder{
+		clientID: uuid.NextID(),
+		limit:    100,
+	}
+}
+
+func (b GetFavoritesChangesParamsBuilder) WithClientID(id uuid.ID) GetFavoritesChangesParamsBuilder {
+	b.clientID = id
+	return b
+}
+
+func (b GetFavoritesChangesParamsBuilder) WithSince(since string) GetFavoritesChangesParamsBuilder {
+	b.since = since
+	return b
+}
+
+func (b GetFavoritesChangesParamsBuilder) WithLimit(limit int) GetFavoritesChangesParamsBuilder {
+	b.limit = limit
+	return b
+}
+
+func (b GetFavoritesChangesParamsBuilder) Build() dto.GetFavoritesChangesParams {
+	return dto.GetFavoritesChangesParams{
+		ClientID: b.clientID,
+		Since:    b.since,
+		Limit:    b.limit,
+	}
+}
@@ -0,0 +1,43 @@
+package fixture
+
+import (
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+type GetFavoritesHistoryParamsBuilder struct {
+	clientID uuid.ID
+	page     int
+	pageSize int
+}
+
+func AnyGetFavoritesHistoryParams() GetFavoritesHistoryParamsBuilder {
+	return GetFavoritesHistoryParamsBuilder{
+		clientID: uuid.NextID(),
+		page:     1,
+		pageSize: 20,
+	}
+}
+
+func (b GetFavoritesHistoryParamsBuilder) WithClientID(id uuid.ID) GetFavoritesHistoryParamsBuilder {
+	b.clientID = id
+	return b
+}
+
+func (b GetFavoritesHistoryParamsBuilder) WithPage(p int) GetFavoritesHistoryParamsBuilder {
+	b.page = p
+	return b
+}
+
+func (b GetFavoritesHistoryParamsBuilder) WithPageSize(size int) GetFavoritesHistoryParamsBuilder {
+	b.pageSize = size
+	return b
+}
+
+func (b GetFavoritesHistoryParamsBuilder) Build() dto.GetFavoritesHistoryParams {
+	return dto.GetFavoritesHistoryParams{
+		ClientID: b.clientID,
+		Page:     b.page,
+		PageSize: b.pageSize,
+	}
+}
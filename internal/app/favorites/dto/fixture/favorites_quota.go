@@ -0,0 +1,37 @@
+package fixture
+
+import (
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+type SetFavoritesQuotaParamsBuilder struct {
+	clientID     uuid.ID
+	maxFavorites *int
+}
+
+func AnySetFavoritesQuotaParams() SetFavoritesQuotaParamsBuilder {
+	maxFavorites := 100
+
+	return SetFavoritesQuotaParamsBuilder{
+		clientID:     uuid.NextID(),
+		maxFavorites: &maxFavorites,
+	}
+}
+
+func (b SetFavoritesQuotaParamsBuilder) WithClientID(id uuid.ID) SetFavoritesQuotaParamsBuilder {
+	b.clientID = id
+	return b
+}
+
+func (b SetFavoritesQuotaParamsBuilder) WithMaxFavorites(maxFavorites *int) SetFavoritesQuotaParamsBuilder {
+	b.maxFavorites = maxFavorites
+	return b
+}
+
+func (b SetFavoritesQuotaParamsBuilder) Build() dto.SetFavoritesQuotaParams {
+	return dto.SetFavoritesQuotaParams{
+		ClientID:     b.clientID,
+		MaxFavorites: b.maxFavorites,
+	}
+}
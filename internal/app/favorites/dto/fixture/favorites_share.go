@@ -0,0 +1,102 @@
+package fixture
+
+import (
+	"time"
+
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+type CreateFavoritesShareParamsBuilder struct {
+	clientID  uuid.ID
+	expiresAt *time.Time
+}
+
+func AnyCreateFavoritesShareParams() CreateFavoritesShareParamsBuilder {
+	return CreateFavoritesShareParamsBuilder{
+		clientID: uuid.NextID(),
+	}
+}
+
+func (b CreateFavoritesShareParamsBuilder) WithClientID(id uuid.ID) CreateFavoritesShareParamsBuilder {
+	b.clientID = id
+	return b
+}
+
+func (b CreateFavoritesShareParamsBuilder) WithExpiresAt(t *time.Time) CreateFavoritesShareParamsBuilder {
+	b.expiresAt = t
+	return b
+}
+
+func (b CreateFavoritesShareParamsBuilder) Build() dto.CreateFavoritesShareParams {
+	return dto.CreateFavoritesShareParams{
+		ClientID:  b.clientID,
+		ExpiresAt: b.expiresAt,
+	}
+}
+
+type RevokeFavoritesShareParamsBuilder struct {
+	clientID uuid.ID
+	token    string
+}
+
+func AnyRevokeFavoritesShareParams() RevokeFavoritesShareParamsBuilder {
+	return RevokeFavoritesShareParamsBuilder{
+		clientID: uuid.NextID(),
+		token:    "share-token",
+	}
+}
+
+func (b RevokeFavoritesShareParamsBuilder) WithClientID(id uuid.ID) RevokeFavoritesShareParamsBuilder {
+	b.clientID = id
+	return b
+}
+
+func (b RevokeFavoritesShareParamsBuilder) WithToken(token string) RevokeFavoritesShareParamsBuilder {
+	b.token = token
+	return b
+}
+
+func (b RevokeFavoritesShareParamsBuilder) Build() dto.RevokeFavoritesShareParams {
+	return dto.RevokeFavoritesShareParams{
+		ClientID: b.clientID,
+		Token:    b.token,
+	}
+}
+
+type GetSharedFavoritesParamsBuilder struct {
+	token    string
+	page     int
+	pageSize int
+}
+
+func AnyGetSharedFavoritesParams() GetSharedFavoritesParamsBuilder {
+	return GetSharedFavoritesParamsBuilder{
+		token:    "share-token",
+		page:     0,
+		pageSize: 10,
+	}
+}
+
+func (b GetSharedFavoritesParamsBuilder) WithToken(token string) GetSharedFavoritesParamsBuilder {
+	b.token = token
+	return b
+}
+
+func (b GetSharedFavoritesParamsBuilder) WithPage(page int) GetSharedFavoritesParamsBuilder {
+	b.page = page
+	return b
+}
+
+func (b GetSharedFavoritesParamsBuilder) WithPageSize(size int) GetSharedFavoritesParamsBuilder {
+	b.pageSize = size
+	return b
+}
+
+func (b GetSharedFavoritesParamsBuilder) Build() dto.GetSharedFavoritesParams {
+	return dto.GetSharedFavoritesParams{
+		Token:    b.token,
+		Page:     b.page,
+		PageSize: b.pageSize,
+	}
+}
@@ -0,0 +1,34 @@
+package fixture
+
+import (
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+)
+
+type GetFavoritesStatsParamsBuilder struct {
+	days int
+	top  int
+}
+
+func AnyGetFavoritesStatsParams() GetFavoritesStatsParamsBuilder {
+	return GetFavoritesStatsParamsBuilder{
+		days: 30,
+		top:  10,
+	}
+}
+
+func (b GetFavoritesStatsParamsBuilder) WithDays(days int) GetFavoritesStatsParamsBuilder {
+	b.days = days
+	return b
+}
+
+func (b GetFavoritesStatsParamsBuilder) WithTop(top int) GetFavoritesStatsParamsBuilder {
+	b.top = top
+	return b
+}
+
+func (b GetFavoritesStatsParamsBuilder) Build() dto.GetFavoritesStatsParams {
+	return dto.GetFavoritesStatsParams{
+		Days: b.days,
+		Top:  b.top,
+	}
+}
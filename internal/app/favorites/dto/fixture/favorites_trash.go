@@ -0,0 +1,43 @@
+package fixture
+
+import (
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+type GetFavoritesTrashParamsBuilder struct {
+	clientID uuid.ID
+	page     int
+	pageSize int
+}
+
+func AnyGetFavoritesTrashParams() GetFavoritesTrashParamsBuilder {
+	return GetFavoritesTrashParamsBuilder{
+		clientID: uuid.NextID(),
+		page:     1,
+		pageSize: 20,
+	}
+}
+
+func (b GetFavoritesTrashParamsBuilder) WithClientID(id uuid.ID) GetFavoritesTrashParamsBuilder {
+	b.clientID = id
+	return b
+}
+
+func (b GetFavoritesTrashParamsBuilder) WithPage(p int) GetFavoritesTrashParamsBuilder {
+	b.page = p
+	return b
+}
+
+func (b GetFavoritesTrashParamsBuilder) WithPageSize(size int) GetFavoritesTrashParamsBuilder {
+	b.pageSize = size
+	return b
+}
+
+func (b GetFavoritesTrashParamsBuilder) Build() dto.GetFavoritesTrashParams {
+	return dto.GetFavoritesTrashParams{
+		ClientID: b.clientID,
+		Page:     b.page,
+		PageSize: b.pageSize,
+	}
+}
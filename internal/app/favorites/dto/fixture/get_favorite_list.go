@@ -0,0 +1,51 @@
+package fixture
+
+import (
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+type GetFavoriteListParamsBuilder struct {
+	clientID uuid.ID
+	listID   uuid.ID
+	page     int
+	pageSize int
+}
+
+func AnyGetFavoriteListParams() GetFavoriteListParamsBuilder {
+	return GetFavoriteListParamsBuilder{
+		clientID: uuid.NextID(),
+		listID:   uuid.NextID(),
+		page:     1,
+		pageSize: 20,
+	}
+}
+
+func (b GetFavoriteListParamsBuilder) WithClientID(id uuid.ID) GetFavoriteListParamsBuilder {
+	b.clientID = id
+	return b
+}
+
+func (b GetFavoriteListParamsBuilder) WithListID(id uuid.ID) GetFavoriteListParamsBuilder {
+	b.listID = id
+	return b
+}
+
+func (b GetFavoriteListParamsBuilder) WithPage(p int) GetFavoriteListParamsBuilder {
+	b.page = p
+	return b
+}
+
+func (b GetFavoriteListParamsBuilder) WithPageSize(size int) GetFavoriteListParamsBuilder {
+	b.pageSize = size
+	return b
+}
+
+func (b GetFavoriteListParamsBuilder) Build() dto.GetFavoriteListParams {
+	return dto.GetFavoriteListParams{
+		ClientID: b.clientID,
+		ListID:   b.listID,
+		Page:     b.page,
+		PageSize: b.pageSize,
+	}
+}
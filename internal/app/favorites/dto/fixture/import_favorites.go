@@ -0,0 +1,64 @@
+package fixture
+
+import (
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+type ImportFavoritesParamsBuilder struct {
+	clientID uuid.ID
+	rows     []dto.ImportRow
+}
+
+func AnyImportFavoritesParams() ImportFavoritesParamsBuilder {
+	return ImportFavoritesParamsBuilder{
+		clientID: uuid.NextID(),
+		rows:     []dto.ImportRow{{Row: 1, ProductID: 1, Tags: []string{}}},
+	}
+}
+
+func (b ImportFavoritesParamsBuilder) WithClientID(id uuid.ID) ImportFavoritesParamsBuilder {
+	b.clientID = id
+	return b
+}
+
+func (b ImportFavoritesParamsBuilder) WithRows(rows []dto.ImportRow) ImportFavoritesParamsBuilder {
+	b.rows = rows
+	return b
+}
+
+func (b ImportFavoritesParamsBuilder) Build() dto.ImportFavoritesParams {
+	return dto.ImportFavoritesParams{
+		ClientID: b.clientID,
+		Rows:     b.rows,
+	}
+}
+
+type GetImportJobParamsBuilder struct {
+	clientID uuid.ID
+	jobID    uuid.ID
+}
+
+func AnyGetImportJobParams() GetImportJobParamsBuilder {
+	return GetImportJobParamsBuilder{
+		clientID: uuid.NextID(),
+		jobID:    uuid.NextID(),
+	}
+}
+
+func (b GetImportJobParamsBuilder) WithClientID(id uuid.ID) GetImportJobParamsBuilder {
+	b.clientID = id
+	return b
+}
+
+func (b GetImportJobParamsBuilder) WithJobID(id uuid.ID) GetImportJobParamsBuilder {
+	b.jobID = id
+	return b
+}
+
+func (b GetImportJobParamsBuilder) Build() dto.GetImportJobParams {
+	return dto.GetImportJobParams{
+		ClientID: b.clientID,
+		JobID:    b.jobID,
+	}
+}
@@ -0,0 +1,35 @@
+package fixture
+
+import (
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+type GetRecommendationsParamsBuilder struct {
+	clientID uuid.ID
+	limit    int
+}
+
+func AnyGetRecommendationsParams() GetRecommendationsParamsBuilder {
+	return GetRecommendationsParamsBuilder{
+		clientID: uuid.NextID(),
+		limit:    10,
+	}
+}
+
+func (b GetRecommendationsParamsBuilder) WithClientID(id uuid.ID) GetRecommendationsParamsBuilder {
+	b.clientID = id
+	return b
+}
+
+func (b GetRecommendationsParamsBuilder) WithLimit(limit int) GetRecommendationsParamsBuilder {
+	b.limit = limit
+	return b
+}
+
+func (b GetRecommendationsParamsBuilder) Build() dto.GetRecommendationsParams {
+	return dto.GetRecommendationsParams{
+		ClientID: b.clientID,
+		Limit:    b.limit,
+	}
+}
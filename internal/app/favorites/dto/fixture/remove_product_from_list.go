@@ -0,0 +1,43 @@
+package fixture
+
+import (
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+type RemoveProductFromListParamsBuilder struct {
+	clientID  uuid.ID
+	listID    uuid.ID
+	productID int
+}
+
+func AnyRemoveProductFromListParams() RemoveProductFromListParamsBuilder {
+	return RemoveProductFromListParamsBuilder{
+		clientID:  uuid.NextID(),
+		listID:    uuid.NextID(),
+		productID: 1,
+	}
+}
+
+func (b RemoveProductFromListParamsBuilder) WithClientID(id uuid.ID) RemoveProductFromListParamsBuilder {
+	b.clientID = id
+	return b
+}
+
+func (b RemoveProductFromListParamsBuilder) WithListID(id uuid.ID) RemoveProductFromListParamsBuilder {
+	b.listID = id
+	return b
+}
+
+func (b RemoveProductFromListParamsBuilder) WithProductID(pid int) RemoveProductFromListParamsBuilder {
+	b.productID = pid
+	return b
+}
+
+func (b RemoveProductFromListParamsBuilder) Build() dto.RemoveProductFromListParams {
+	return dto.RemoveProductFromListParams{
+		ClientID:  b.clientID,
+		ListID:    b.listID,
+		ProductID: b.productID,
+	}
+}
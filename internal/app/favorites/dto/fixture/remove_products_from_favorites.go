@@ -0,0 +1,35 @@
+package fixture
+
+import (
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+type RemoveProductsFromFavoritesParamsBuilder struct {
+	clientID   uuid.ID
+	productIDs []int
+}
+
+func AnyRemoveProductsFromFavoritesParams() RemoveProductsFromFavoritesParamsBuilder {
+	return RemoveProductsFromFavoritesParamsBuilder{
+		clientID:   uuid.NextID(),
+		productIDs: []int{1, 2},
+	}
+}
+
+func (b RemoveProductsFromFavoritesParamsBuilder) WithClientID(id uuid.ID) RemoveProductsFromFavoritesParamsBuilder {
+	b.clientID = id
+	return b
+}
+
+func (b RemoveProductsFromFavoritesParamsBuilder) WithProductIDs(ids []int) RemoveProductsFromFavoritesParamsBuilder {
+	b.productIDs = ids
+	return b
+}
+
+func (b RemoveProductsFromFavoritesParamsBuilder) Build() dto.RemoveProductsFromFavoritesParams {
+	return dto.RemoveProductsFromFavoritesParams{
+		ClientID:   b.clientID,
+		ProductIDs: b.productIDs,
+	}
+}
@@ -0,0 +1,43 @@
+package fixture
+
+import (
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+type RenameFavoriteListParamsBuilder struct {
+	clientID uuid.ID
+	listID   uuid.ID
+	name     string
+}
+
+func AnyRenameFavoriteListParams() RenameFavoriteListParamsBuilder {
+	return RenameFavoriteListParamsBuilder{
+		clientID: uuid.NextID(),
+		listID:   uuid.NextID(),
+		name:     "Presentes",
+	}
+}
+
+func (b RenameFavoriteListParamsBuilder) WithClientID(id uuid.ID) RenameFavoriteListParamsBuilder {
+	b.clientID = id
+	return b
+}
+
+func (b RenameFavoriteListParamsBuilder) WithListID(id uuid.ID) RenameFavoriteListParamsBuilder {
+	b.listID = id
+	return b
+}
+
+func (b RenameFavoriteListParamsBuilder) WithName(name string) RenameFavoriteListParamsBuilder {
+	b.name = name
+	return b
+}
+
+func (b RenameFavoriteListParamsBuilder) Build() dto.RenameFavoriteListParams {
+	return dto.RenameFavoriteListParams{
+		ClientID: b.clientID,
+		ListID:   b.listID,
+		Name:     b.name,
+	}
+}
@@ -0,0 +1,42 @@
+package fixture
+
+import (
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+type ReorderFavoritesParamsBuilder struct {
+	clientID   uuid.ID
+	productIDs []int
+	moves      []dto.FavoriteMove
+}
+
+func AnyReorderFavoritesParams() ReorderFavoritesParamsBuilder {
+	return ReorderFavoritesParamsBuilder{
+		clientID:   uuid.NextID(),
+		productIDs: []int{2, 1, 3},
+	}
+}
+
+func (b ReorderFavoritesParamsBuilder) WithClientID(id uuid.ID) ReorderFavoritesParamsBuilder {
+	b.clientID = id
+	return b
+}
+
+func (b ReorderFavoritesParamsBuilder) WithProductIDs(ids ...int) ReorderFavoritesParamsBuilder {
+	b.productIDs = ids
+	return b
+}
+
+func (b ReorderFavoritesParamsBuilder) WithMoves(mm ...dto.FavoriteMove) ReorderFavoritesParamsBuilder {
+	b.moves = mm
+	return b
+}
+
+func (b ReorderFavoritesParamsBuilder) Build() dto.ReorderFavoritesParams {
+	return dto.ReorderFavoritesParams{
+		ClientID:   b.clientID,
+		ProductIDs: b.productIDs,
+		Moves:      b.moves,
+	}
+}
@@ -0,0 +1,35 @@
+package fixture
+
+import (
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+type RestoreFavoriteParamsBuilder struct {
+	clientID  uuid.ID
+	productID int
+}
+
+func AnyRestoreFavoriteParams() RestoreFavoriteParamsBuilder {
+	return RestoreFavoriteParamsBuilder{
+		clientID:  uuid.NextID(),
+		productID: 1,
+	}
+}
+
+func (b RestoreFavoriteParamsBuilder) WithClientID(id uuid.ID) RestoreFavoriteParamsBuilder {
+	b.clientID = id
+	return b
+}
+
+func (b RestoreFavoriteParamsBuilder) WithProductID(pid int) RestoreFavoriteParamsBuilder {
+	b.productID = pid
+	return b
+}
+
+func (b RestoreFavoriteParamsBuilder) Build() dto.RestoreFavoriteParams {
+	return dto.RestoreFavoriteParams{
+		ClientID:  b.clientID,
+		ProductID: b.productID,
+	}
+}
@@ -0,0 +1,54 @@
+package fixture
+
+import (
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+type UpdateFavoriteParamsBuilder struct {
+	clientID  uuid.ID
+	productID int
+	note      *string
+	tags      *[]string
+}
+
+func AnyUpdateFavoriteParams() UpdateFavoriteParamsBuilder {
+	note := "presente de aniversário"
+	tags := []string{"presentes"}
+
+	return UpdateFavoriteParamsBuilder{
+		clientID:  uuid.NextID(),
+		productID: 1,
+		note:      &note,
+		tags:      &tags,
+	}
+}
+
+func (b UpdateFavoriteParamsBuilder) WithClientID(id uuid.ID) UpdateFavoriteParamsBuilder {
+	b.clientID = id
+	return b
+}
+
+func (b UpdateFavoriteParamsBuilder) WithProductID(pid int) UpdateFavoriteParamsBuilder {
+	b.productID = pid
+	return b
+}
+
+func (b UpdateFavoriteParamsBuilder) WithNote(note *string) UpdateFavoriteParamsBuilder {
+	b.note = note
+	return b
+}
+
+func (b UpdateFavoriteParamsBuilder) WithTags(tags *[]string) UpdateFavoriteParamsBuilder {
+	b.tags = tags
+	return b
+}
+
+func (b UpdateFavoriteParamsBuilder) Build() dto.UpdateFavoriteParams {
+	return dto.UpdateFavoriteParams{
+		ClientID:  b.clientID,
+		ProductID: b.productID,
+		Note:      b.note,
+		Tags:      b.tags,
+	}
+}
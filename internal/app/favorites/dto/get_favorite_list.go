@@ -0,0 +1,43 @@
+package dto
+
+import (
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/validator"
+	"github.com/uesleicarvalhoo/aiqfome/product"
+)
+
+type GetFavoriteListParams struct {
+	ClientID uuid.ID `json:"-"`
+	ListID   uuid.ID `json:"-"`
+	Page     int     `json:"page"`
+	PageSize int     `json:"pageSize"`
+}
+
+func (p GetFavoriteListParams) Validate() error {
+	v := validator.New()
+
+	if p.ClientID.IsZero() {
+		v.AddError("clientId", "campo obrigatório")
+	}
+
+	if p.ListID.IsZero() {
+		v.AddError("listId", "campo obrigatório")
+	}
+
+	if p.PageSize < 1 {
+		v.AddError("pageSize", "deve ser maior do que 1")
+	}
+
+	if p.Page < 0 {
+		v.AddError("page", "não pode ser negativo")
+	}
+
+	return v.Validate()
+}
+
+type FavoriteListProducts struct {
+	List     FavoriteList      `json:"list"`
+	Products []product.Product `json:"products"`
+	Total    int               `json:"total"`
+	Pages    int               `json:"pages"`
+}
@@ -0,0 +1,246 @@
+package dto
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/uesleicarvalhoo/aiqfome/pkg/domainerror"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/validator"
+)
+
+// ImportRow is a favorite read from an imported file, Row is its position on the file starting at 1
+type ImportRow struct {
+	Row       int      `json:"row"`
+	ProductID int      `json:"productId"`
+	Note      string   `json:"note"`
+	Tags      []string `json:"tags"`
+	Error     string   `json:"error,omitempty"`
+}
+
+// ParseImportRows reads the favorites of a file with the same layout of the export,
+// rows that can't be read are kept with the error so they are reported back
+func ParseImportRows(format ExportFormat, r io.Reader) ([]ImportRow, error) {
+	switch format {
+	case ExportCSV:
+		return parseImportCSV(r)
+	case ExportJSON:
+		return parseImportJSON(r)
+	default:
+		return nil, domainerror.New(domainerror.InvalidParams, "format: deve ser csv ou json", map[string]any{
+			"format": format,
+		})
+	}
+}
+
+func parseImportCSV(r io.Reader) ([]ImportRow, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+
+	header, err := cr.Read()
+	if err != nil {
+		return nil, invalidImportFile(err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.TrimSpace(name)] = i
+	}
+
+	if _, ok := columns["product_id"]; !ok {
+		return nil, domainerror.New(domainerror.InvalidParams, "arquivo inválido: coluna product_id obrigatória", map[string]any{
+			"header": header,
+		})
+	}
+
+	column := func(record []string, name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+
+		return strings.TrimSpace(record[i])
+	}
+
+	rows := make([]ImportRow, 0)
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, invalidImportFile(err)
+		}
+
+		row := ImportRow{
+			Row:  len(rows) + 1,
+			Note: column(record, "note"),
+			Tags: []string{},
+		}
+
+		if tags := column(record, "tags"); tags != "" {
+			row.Tags = strings.Split(tags, ExportTagsSeparator)
+		}
+
+		id, err := strconv.Atoi(column(record, "product_id"))
+		if err != nil || id <= 0 {
+			row.Error = "id do produto inválido"
+		}
+
+		row.ProductID = id
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+func parseImportJSON(r io.Reader) ([]ImportRow, error) {
+	var ee []ExportedFavorite
+	if err := json.NewDecoder(r).Decode(&ee); err != nil {
+		return nil, invalidImportFile(err)
+	}
+
+	rows := make([]ImportRow, 0, len(ee))
+	for i, e := range ee {
+		row := ImportRow{
+			Row:       i + 1,
+			ProductID: e.ProductID,
+			Note:      e.Note,
+			Tags:      e.Tags,
+		}
+
+		if row.Tags == nil {
+			row.Tags = []string{}
+		}
+
+		if e.ProductID <= 0 {
+			row.Error = "id do produto inválido"
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+func invalidImportFile(err error) error {
+	return domainerror.Wrap(err, domainerror.InvalidParams, "arquivo inválido", map[string]any{
+		"error": err.Error(),
+	})
+}
+
+type ImportFavoritesParams struct {
+	ClientID uuid.ID     `json:"-"`
+	Rows     []ImportRow `json:"rows"`
+}
+
+func (p ImportFavoritesParams) Validate() error {
+	v := validator.New()
+
+	if p.ClientID.IsZero() {
+		v.AddError("clientId", "campo obrigatório")
+	}
+
+	if len(p.Rows) == 0 {
+		v.AddError("rows", "o arquivo não possui favoritos")
+	}
+
+	return v.Validate()
+}
+
+type ImportRowResult struct {
+	Row       int             `json:"row"`
+	ProductID int             `json:"productId"`
+	Status    BatchItemStatus `json:"status"`
+	Error     string          `json:"error,omitempty"`
+}
+
+type ImportFavoritesReport struct {
+	Total      int `json:"total"`
+	Added      int `json:"added"`
+	Duplicates int `json:"duplicates"`
+	NotFound   int `json:"notFound"`
+	Invalid    int `json:"invalid"`
+	// QuotaExceeded counts the rows skipped because the client reached the favorites quota
+	QuotaExceeded int               `json:"quotaExceeded"`
+	Rows          []ImportRowResult `json:"rows"`
+}
+
+// NewImportFavoritesReport sorts the results by row and counts them by status
+func NewImportFavoritesReport(rows []ImportRowResult) ImportFavoritesReport {
+	slices.SortFunc(rows, func(a, b ImportRowResult) int {
+		return a.Row - b.Row
+	})
+
+	r := ImportFavoritesReport{
+		Total: len(rows),
+		Rows:  rows,
+	}
+
+	for _, row := range rows {
+		switch row.Status {
+		case BatchItemAdded:
+			r.Added++
+		case BatchItemDuplicate:
+			r.Duplicates++
+		case BatchItemNotFound:
+			r.NotFound++
+		case BatchItemInvalid:
+			r.Invalid++
+		case BatchItemQuotaExceeded:
+			r.QuotaExceeded++
+		}
+	}
+
+	return r
+}
+
+type ImportJobStatus string
+
+const (
+	ImportJobPending ImportJobStatus = "pending"
+	ImportJobDone    ImportJobStatus = "done"
+	ImportJobFailed  ImportJobStatus = "failed"
+)
+
+// ImportJob tracks an import, small files are imported right away and the job is returned already done
+type ImportJob struct {
+	ID         uuid.ID                `json:"id"`
+	ClientID   uuid.ID                `json:"clientId"`
+	Status     ImportJobStatus        `json:"status"`
+	Rows       int                    `json:"rows"`
+	Report     *ImportFavoritesReport `json:"report,omitempty"`
+	Error      string                 `json:"error,omitempty"`
+	CreatedAt  time.Time              `json:"createdAt"`
+	FinishedAt *time.Time             `json:"finishedAt,omitempty"`
+}
+
+func (j ImportJob) IsDone() bool {
+	return j.Status == ImportJobDone || j.Status == ImportJobFailed
+}
+
+type GetImportJobParams struct {
+	ClientID uuid.ID `json:"-"`
+	JobID    uuid.ID `json:"jobId"`
+}
+
+func (p GetImportJobParams) Validate() error {
+	v := validator.New()
+
+	if p.ClientID.IsZero() {
+		v.AddError("clientId", "campo obrigatório")
+	}
+
+	if p.JobID.IsZero() {
+		v.AddError("jobId", "campo obrigatório")
+	}
+
+	return v.Validate()
+}
@@ -0,0 +1,192 @@
+package dto_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto/fixture"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+func TestImportFavoritesParams_Validate(t *testing.T) {
+	t.Parallel()
+
+	builder := fixture.AnyImportFavoritesParams()
+
+	testCases := []struct {
+		about         string
+		params        dto.ImportFavoritesParams
+		expectedError string
+	}{
+		{
+			about:         "when clientID is zero",
+			params:        builder.WithClientID(uuid.Nil).Build(),
+			expectedError: "[AQF002] clientId: campo obrigatório",
+		},
+		{
+			about:         "when file has no rows",
+			params:        builder.WithRows([]dto.ImportRow{}).Build(),
+			expectedError: "[AQF002] rows: o arquivo não possui favoritos",
+		},
+		{
+			about:         "when all values are valid",
+			params:        builder.Build(),
+			expectedError: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.params.Validate()
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestGetImportJobParams_Validate(t *testing.T) {
+	t.Parallel()
+
+	builder := fixture.AnyGetImportJobParams()
+
+	testCases := []struct {
+		about         string
+		params        dto.GetImportJobParams
+		expectedError string
+	}{
+		{
+			about:         "when ids are zero",
+			params:        builder.WithClientID(uuid.Nil).WithJobID(uuid.Nil).Build(),
+			expectedError: "[AQF002] clientId: campo obrigatório; jobId: campo obrigatório",
+		},
+		{
+			about:         "when all values are valid",
+			params:        builder.Build(),
+			expectedError: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.params.Validate()
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestParseImportRows(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		about         string
+		format        dto.ExportFormat
+		file          string
+		expectedRows  []dto.ImportRow
+		expectedError string
+	}{
+		{
+			about:         "when format is invalid",
+			format:        "xml",
+			file:          "<favorites/>",
+			expectedError: "[AQF002] format: deve ser csv ou json",
+		},
+		{
+			about:         "when csv has no product_id column",
+			format:        dto.ExportCSV,
+			file:          "title,note\nPhone,presente\n",
+			expectedError: "[AQF002] arquivo inválido: coluna product_id obrigatória",
+		},
+		{
+			about:         "when json is malformed",
+			format:        dto.ExportJSON,
+			file:          `{"productId": 1`,
+			expectedError: "[AQF002] arquivo inválido",
+		},
+		{
+			about:  "when csv is an export",
+			format: dto.ExportCSV,
+			file: "product_id,title,price,category,note,tags,price_when_favorited,registred_at\n" +
+				"1,Phone,8.00,electronics,presente,natal|casa,10.00,2026-10-01T12:00:00Z\n" +
+				"abc,Tablet,20.00,electronics,,,,2026-10-01T12:00:00Z\n",
+			expectedRows: []dto.ImportRow{
+				{Row: 1, ProductID: 1, Note: "presente", Tags: []string{"natal", "casa"}},
+				{Row: 2, Tags: []string{}, Error: "id do produto inválido"},
+			},
+		},
+		{
+			about:  "when csv has only the product ids",
+			format: dto.ExportCSV,
+			file:   "product_id\n3\n4\n",
+			expectedRows: []dto.ImportRow{
+				{Row: 1, ProductID: 3, Tags: []string{}},
+				{Row: 2, ProductID: 4, Tags: []string{}},
+			},
+		},
+		{
+			about:  "when json is an export",
+			format: dto.ExportJSON,
+			file:   `[{"productId":1,"title":"Phone","note":"presente","tags":["natal"]},{"productId":0},{"productId":2}]`,
+			expectedRows: []dto.ImportRow{
+				{Row: 1, ProductID: 1, Note: "presente", Tags: []string{"natal"}},
+				{Row: 2, Tags: []string{}, Error: "id do produto inválido"},
+				{Row: 3, ProductID: 2, Tags: []string{}},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			rows, err := dto.ParseImportRows(tc.format, strings.NewReader(tc.file))
+			if tc.expectedError != "" {
+				assert.ErrorContains(t, err, tc.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedRows, rows)
+		})
+	}
+}
+
+func TestNewImportFavoritesReport(t *testing.T) {
+	t.Parallel()
+
+	report := dto.NewImportFavoritesReport([]dto.ImportRowResult{
+		{Row: 3, ProductID: 3, Status: dto.BatchItemNotFound},
+		{Row: 1, ProductID: 1, Status: dto.BatchItemAdded},
+		{Row: 4, ProductID: 1, Status: dto.BatchItemDuplicate},
+		{Row: 2, Status: dto.BatchItemInvalid, Error: "id do produto inválido"},
+	})
+
+	assert.Equal(t, dto.ImportFavoritesReport{
+		Total:      4,
+		Added:      1,
+		Duplicates: 1,
+		NotFound:   1,
+		Invalid:    1,
+		Rows: []dto.ImportRowResult{
+			{Row: 1, ProductID: 1, Status: dto.BatchItemAdded},
+			{Row: 2, Status: dto.BatchItemInvalid, Error: "id do produto inválido"},
+			{Row: 3, ProductID: 3, Status: dto.BatchItemNotFound},
+			{Row: 4, ProductID: 1, Status: dto.BatchItemDuplicate},
+		},
+	}, report)
+}
@@ -0,0 +1,42 @@
+package dto
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/validator"
+	"github.com/uesleicarvalhoo/aiqfome/product"
+)
+
+const RecommendationsMaxLimit = 50
+
+type GetRecommendationsParams struct {
+	ClientID uuid.ID `json:"-"`
+	Limit    int     `json:"limit"`
+}
+
+func (p GetRecommendationsParams) Validate() error {
+	v := validator.New()
+
+	if p.ClientID.IsZero() {
+		v.AddError("clientId", "campo obrigatório")
+	}
+
+	if p.Limit < 1 || p.Limit > RecommendationsMaxLimit {
+		v.AddError("limit", fmt.Sprintf("deve estar entre 1 e %d", RecommendationsMaxLimit))
+	}
+
+	return v.Validate()
+}
+
+type RecommendedProduct struct {
+	product.Product
+	Score float64 `json:"score"`
+}
+
+type Recommendations struct {
+	ClientID    uuid.ID              `json:"clientId"`
+	Products    []RecommendedProduct `json:"products"`
+	GeneratedAt time.Time            `json:"generatedAt"`
+}
@@ -0,0 +1,30 @@
+package dto
+
+import (
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/validator"
+)
+
+type RemoveProductFromListParams struct {
+	ClientID  uuid.ID `json:"clientId"`
+	ListID    uuid.ID `json:"listId"`
+	ProductID int     `json:"productId"`
+}
+
+func (p RemoveProductFromListParams) Validate() error {
+	v := validator.New()
+
+	if p.ClientID.IsZero() {
+		v.AddError("clientId", "campo obrigatório")
+	}
+
+	if p.ListID.IsZero() {
+		v.AddError("listId", "campo obrigatório")
+	}
+
+	if p.ProductID == 0 {
+		v.AddError("productId", "campo obrigatório")
+	}
+
+	return v.Validate()
+}
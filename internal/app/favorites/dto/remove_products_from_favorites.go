@@ -0,0 +1,14 @@
+package dto
+
+import (
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+type RemoveProductsFromFavoritesParams struct {
+	ClientID   uuid.ID `json:"-"`
+	ProductIDs []int   `json:"productIds"`
+}
+
+func (p RemoveProductsFromFavoritesParams) Validate() error {
+	return validateBatch(p.ClientID, p.ProductIDs)
+}
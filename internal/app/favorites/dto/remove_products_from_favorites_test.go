@@ -0,0 +1,57 @@
+package dto_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto/fixture"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+func TestRemoveProductsFromFavoritesParams_Validate(t *testing.T) {
+	t.Parallel()
+
+	builder := fixture.AnyRemoveProductsFromFavoritesParams()
+
+	testCases := []struct {
+		about         string
+		params        dto.RemoveProductsFromFavoritesParams
+		expectedError string
+	}{
+		{
+			about:         "when clientID is zero",
+			params:        builder.WithClientID(uuid.Nil).Build(),
+			expectedError: "[AQF002] clientId: campo obrigatório",
+		},
+		{
+			about:         "when productIDs is empty",
+			params:        builder.WithProductIDs([]int{}).Build(),
+			expectedError: "[AQF002] productIds: campo obrigatório",
+		},
+		{
+			about:         "when productIDs has an invalid id",
+			params:        builder.WithProductIDs([]int{1, 0, -1}).Build(),
+			expectedError: "[AQF002] productIds: deve conter apenas ids válidos",
+		},
+		{
+			about:         "when all values are valid",
+			params:        builder.Build(),
+			expectedError: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.params.Validate()
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
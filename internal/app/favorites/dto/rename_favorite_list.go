@@ -0,0 +1,30 @@
+package dto
+
+import (
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/validator"
+)
+
+type RenameFavoriteListParams struct {
+	ClientID uuid.ID `json:"-"`
+	ListID   uuid.ID `json:"-"`
+	Name     string  `json:"name"`
+}
+
+func (p RenameFavoriteListParams) Validate() error {
+	v := validator.New()
+
+	if p.ClientID.IsZero() {
+		v.AddError("clientId", "campo obrigatório")
+	}
+
+	if p.ListID.IsZero() {
+		v.AddError("listId", "campo obrigatório")
+	}
+
+	if p.Name == "" {
+		v.AddError("name", "campo obrigatório")
+	}
+
+	return v.Validate()
+}
@@ -0,0 +1,75 @@
+package dto
+
+import (
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/validator"
+)
+
+// FavoriteMove places the product right before or right after another favorite, only one of them must be set
+type FavoriteMove struct {
+	ProductID int  `json:"productId"`
+	Before    *int `json:"before,omitempty"`
+	After     *int `json:"after,omitempty"`
+}
+
+// ReorderFavoritesParams takes either the product ids in the wanted order or a list of moves
+type ReorderFavoritesParams struct {
+	ClientID   uuid.ID        `json:"-"`
+	ProductIDs []int          `json:"productIds"`
+	Moves      []FavoriteMove `json:"moves"`
+}
+
+func (p ReorderFavoritesParams) Validate() error {
+	v := validator.New()
+
+	if p.ClientID.IsZero() {
+		v.AddError("clientId", "campo obrigatório")
+	}
+
+	if len(p.ProductIDs) == 0 && len(p.Moves) == 0 {
+		v.AddError("productIds", "informe productIds ou moves")
+	}
+
+	if len(p.ProductIDs) > 0 && len(p.Moves) > 0 {
+		v.AddError("productIds", "não pode ser informado junto com moves")
+	}
+
+	seen := make(map[int]bool, len(p.ProductIDs))
+	for _, id := range p.ProductIDs {
+		if id <= 0 {
+			v.AddError("productIds", "deve conter apenas ids válidos")
+			break
+		}
+
+		if seen[id] {
+			v.AddError("productIds", "não pode conter ids repetidos")
+			break
+		}
+
+		seen[id] = true
+	}
+
+	for _, m := range p.Moves {
+		if m.ProductID <= 0 {
+			v.AddError("moves", "productId deve ser um id válido")
+			break
+		}
+
+		if (m.Before == nil) == (m.After == nil) {
+			v.AddError("moves", "informe before ou after")
+			break
+		}
+
+		target := m.After
+		if m.Before != nil {
+			target = m.Before
+		}
+
+		if *target <= 0 {
+			v.AddError("moves", "before e after devem ser ids válidos")
+			break
+		}
+	}
+
+	return v.Validate()
+}
@@ -0,0 +1,93 @@
+package dto_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto/fixture"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+func TestReorderFavoritesParams_Validate(t *testing.T) {
+	t.Parallel()
+
+	builder := fixture.AnyReorderFavoritesParams()
+	zero, one, two := 0, 1, 2
+
+	testCases := []struct {
+		about         string
+		params        dto.ReorderFavoritesParams
+		expectedError string
+	}{
+		{
+			about:         "when clientID is zero",
+			params:        builder.WithClientID(uuid.Nil).Build(),
+			expectedError: "[AQF002] clientId: campo obrigatório",
+		},
+		{
+			about:         "when neither productIds nor moves are informed",
+			params:        builder.WithProductIDs().Build(),
+			expectedError: "[AQF002] productIds: informe productIds ou moves",
+		},
+		{
+			about:         "when both productIds and moves are informed",
+			params:        builder.WithMoves(dto.FavoriteMove{ProductID: 3, Before: &one}).Build(),
+			expectedError: "[AQF002] productIds: não pode ser informado junto com moves",
+		},
+		{
+			about:         "when productIds has an invalid id",
+			params:        builder.WithProductIDs(1, 0).Build(),
+			expectedError: "[AQF002] productIds: deve conter apenas ids válidos",
+		},
+		{
+			about:         "when productIds has repeated ids",
+			params:        builder.WithProductIDs(1, 2, 1).Build(),
+			expectedError: "[AQF002] productIds: não pode conter ids repetidos",
+		},
+		{
+			about:         "when a move has no productId",
+			params:        builder.WithProductIDs().WithMoves(dto.FavoriteMove{Before: &one}).Build(),
+			expectedError: "[AQF002] moves: productId deve ser um id válido",
+		},
+		{
+			about:         "when a move has both before and after",
+			params:        builder.WithProductIDs().WithMoves(dto.FavoriteMove{ProductID: 3, Before: &one, After: &two}).Build(),
+			expectedError: "[AQF002] moves: informe before ou after",
+		},
+		{
+			about:         "when a move has neither before nor after",
+			params:        builder.WithProductIDs().WithMoves(dto.FavoriteMove{ProductID: 3}).Build(),
+			expectedError: "[AQF002] moves: informe before ou after",
+		},
+		{
+			about:         "when a move targets an invalid id",
+			params:        builder.WithProductIDs().WithMoves(dto.FavoriteMove{ProductID: 3, After: &zero}).Build(),
+			expectedError: "[AQF002] moves: before e after devem ser ids válidos",
+		},
+		{
+			about:         "when productIds are valid",
+			params:        builder.Build(),
+			expectedError: "",
+		},
+		{
+			about:         "when moves are valid",
+			params:        builder.WithProductIDs().WithMoves(dto.FavoriteMove{ProductID: 3, Before: &one}, dto.FavoriteMove{ProductID: 1, After: &two}).Build(),
+			expectedError: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.params.Validate()
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/validator"
+)
+
+type UpdateFavoriteParams struct {
+	ClientID  uuid.ID   `json:"-"`
+	ProductID int       `json:"-"`
+	Note      *string   `json:"note,omitempty"`
+	Tags      *[]string `json:"tags,omitempty"`
+}
+
+func (p UpdateFavoriteParams) Validate() error {
+	v := validator.New()
+
+	if p.ClientID.IsZero() {
+		v.AddError("clientId", "campo obrigatório")
+	}
+
+	if p.ProductID == 0 {
+		v.AddError("productId", "campo obrigatório")
+	}
+
+	if p.Note == nil && p.Tags == nil {
+		v.AddError("note", "informe note ou tags")
+	}
+
+	return v.Validate()
+}
+
+type Favorite struct {
+	ClientID    uuid.ID   `json:"clientId"`
+	ProductID   int       `json:"productId"`
+	Note        string    `json:"note"`
+	Tags        []string  `json:"tags"`
+	RegistredAt time.Time `json:"registredAt"`
+}
+
+func FavoriteFromDomain(f favorite.Favorite) Favorite {
+	return Favorite{
+		ClientID:    f.ClientID,
+		ProductID:   f.ProductID,
+		Note:        f.Note,
+		Tags:        f.Tags,
+		RegistredAt: f.RegistredAt,
+	}
+}
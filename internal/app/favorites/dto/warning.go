@@ -0,0 +1,34 @@
+package dto
+
+type WarningCode string
+
+const WarningProductsUnavailable WarningCode = "products_unavailable"
+
+// Warning tells that the response is partial, ProductIDs are the products it refers to
+type Warning struct {
+	Code       WarningCode `json:"code" enums:"products_unavailable"`
+	Message    string      `json:"message"`
+	ProductIDs []int       `json:"productIds"`
+}
+
+// FavoriteItemsWarnings returns the warnings about the items, nil when all their products are available
+func FavoriteItemsWarnings(items []FavoriteItem) []Warning {
+	ids := make([]int, 0)
+	for _, i := range items {
+		if i.Unavailable {
+			ids = append(ids, i.ID)
+		}
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	return []Warning{
+		{
+			Code:       WarningProductsUnavailable,
+			Message:    "alguns produtos favoritados não estão mais disponíveis",
+			ProductIDs: ids,
+		},
+	}
+}
@@ -0,0 +1,58 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AddProductToListUseCase is an autogenerated mock type for the AddProductToListUseCase type
+type AddProductToListUseCase struct {
+	mock.Mock
+}
+
+// Execute provides a mock function with given fields: ctx, p
+func (_m *AddProductToListUseCase) Execute(ctx context.Context, p dto.AddProductToListParams) (dto.ListProduct, error) {
+	ret := _m.Called(ctx, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 dto.ListProduct
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.AddProductToListParams) (dto.ListProduct, error)); ok {
+		return rf(ctx, p)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.AddProductToListParams) dto.ListProduct); ok {
+		r0 = rf(ctx, p)
+	} else {
+		r0 = ret.Get(0).(dto.ListProduct)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.AddProductToListParams) error); ok {
+		r1 = rf(ctx, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewAddProductToListUseCase creates a new instance of AddProductToListUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAddProductToListUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AddProductToListUseCase {
+	mock := &AddProductToListUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
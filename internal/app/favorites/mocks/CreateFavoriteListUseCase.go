@@ -0,0 +1,58 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CreateFavoriteListUseCase is an autogenerated mock type for the CreateFavoriteListUseCase type
+type CreateFavoriteListUseCase struct {
+	mock.Mock
+}
+
+// Execute provides a mock function with given fields: ctx, p
+func (_m *CreateFavoriteListUseCase) Execute(ctx context.Context, p dto.CreateFavoriteListParams) (dto.FavoriteList, error) {
+	ret := _m.Called(ctx, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 dto.FavoriteList
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.CreateFavoriteListParams) (dto.FavoriteList, error)); ok {
+		return rf(ctx, p)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.CreateFavoriteListParams) dto.FavoriteList); ok {
+		r0 = rf(ctx, p)
+	} else {
+		r0 = ret.Get(0).(dto.FavoriteList)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.CreateFavoriteListParams) error); ok {
+		r1 = rf(ctx, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewCreateFavoriteListUseCase creates a new instance of CreateFavoriteListUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCreateFavoriteListUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CreateFavoriteListUseCase {
+	mock := &CreateFavoriteListUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,50 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+
+	io "io"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ExportClientFavoritesUseCase is an autogenerated mock type for the ExportClientFavoritesUseCase type
+type ExportClientFavoritesUseCase struct {
+	mock.Mock
+}
+
+// Execute provides a mock function with given fields: ctx, p, w
+func (_m *ExportClientFavoritesUseCase) Execute(ctx context.Context, p dto.ExportFavoritesParams, w io.Writer) error {
+	ret := _m.Called(ctx, p, w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.ExportFavoritesParams, io.Writer) error); ok {
+		r0 = rf(ctx, p, w)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewExportClientFavoritesUseCase creates a new instance of ExportClientFavoritesUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewExportClientFavoritesUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ExportClientFavoritesUseCase {
+	mock := &ExportClientFavoritesUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,60 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+// GetClientFavoriteListsUseCase is an autogenerated mock type for the GetClientFavoriteListsUseCase type
+type GetClientFavoriteListsUseCase struct {
+	mock.Mock
+}
+
+// Execute provides a mock function with given fields: ctx, clientID
+func (_m *GetClientFavoriteListsUseCase) Execute(ctx context.Context, clientID uuid.ID) (dto.ClientFavoriteLists, error) {
+	ret := _m.Called(ctx, clientID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 dto.ClientFavoriteLists
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID) (dto.ClientFavoriteLists, error)); ok {
+		return rf(ctx, clientID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID) dto.ClientFavoriteLists); ok {
+		r0 = rf(ctx, clientID)
+	} else {
+		r0 = ret.Get(0).(dto.ClientFavoriteLists)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.ID) error); ok {
+		r1 = rf(ctx, clientID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewGetClientFavoriteListsUseCase creates a new instance of GetClientFavoriteListsUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewGetClientFavoriteListsUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *GetClientFavoriteListsUseCase {
+	mock := &GetClientFavoriteListsUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
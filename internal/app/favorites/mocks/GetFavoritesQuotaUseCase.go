@@ -0,0 +1,60 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+// GetFavoritesQuotaUseCase is an autogenerated mock type for the GetFavoritesQuotaUseCase type
+type GetFavoritesQuotaUseCase struct {
+	mock.Mock
+}
+
+// Execute provides a mock function with given fields: ctx, clientID
+func (_m *GetFavoritesQuotaUseCase) Execute(ctx context.Context, clientID uuid.ID) (dto.FavoritesQuota, error) {
+	ret := _m.Called(ctx, clientID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 dto.FavoritesQuota
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID) (dto.FavoritesQuota, error)); ok {
+		return rf(ctx, clientID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID) dto.FavoritesQuota); ok {
+		r0 = rf(ctx, clientID)
+	} else {
+		r0 = ret.Get(0).(dto.FavoritesQuota)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.ID) error); ok {
+		r1 = rf(ctx, clientID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewGetFavoritesQuotaUseCase creates a new instance of GetFavoritesQuotaUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewGetFavoritesQuotaUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *GetFavoritesQuotaUseCase {
+	mock := &GetFavoritesQuotaUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,58 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// GetFavoritesStatsUseCase is an autogenerated mock type for the GetFavoritesStatsUseCase type
+type GetFavoritesStatsUseCase struct {
+	mock.Mock
+}
+
+// Execute provides a mock function with given fields: ctx, p
+func (_m *GetFavoritesStatsUseCase) Execute(ctx context.Context, p dto.GetFavoritesStatsParams) (dto.FavoritesStats, error) {
+	ret := _m.Called(ctx, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 dto.FavoritesStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.GetFavoritesStatsParams) (dto.FavoritesStats, error)); ok {
+		return rf(ctx, p)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.GetFavoritesStatsParams) dto.FavoritesStats); ok {
+		r0 = rf(ctx, p)
+	} else {
+		r0 = ret.Get(0).(dto.FavoritesStats)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.GetFavoritesStatsParams) error); ok {
+		r1 = rf(ctx, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewGetFavoritesStatsUseCase creates a new instance of GetFavoritesStatsUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewGetFavoritesStatsUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *GetFavoritesStatsUseCase {
+	mock := &GetFavoritesStatsUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,58 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	dto "github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ImportFavoritesUseCase is an autogenerated mock type for the ImportFavoritesUseCase type
+type ImportFavoritesUseCase struct {
+	mock.Mock
+}
+
+// Execute provides a mock function with given fields: ctx, p
+func (_m *ImportFavoritesUseCase) Execute(ctx context.Context, p dto.ImportFavoritesParams) (dto.ImportJob, error) {
+	ret := _m.Called(ctx, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 dto.ImportJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, dto.ImportFavoritesParams) (dto.ImportJob, error)); ok {
+		return rf(ctx, p)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, dto.ImportFavoritesParams) dto.ImportJob); ok {
+		r0 = rf(ctx, p)
+	} else {
+		r0 = ret.Get(0).(dto.ImportJob)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, dto.ImportFavoritesParams) error); ok {
+		r1 = rf(ctx, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewImportFavoritesUseCase creates a new instance of ImportFavoritesUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewImportFavoritesUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ImportFavoritesUseCase {
+	mock := &ImportFavoritesUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
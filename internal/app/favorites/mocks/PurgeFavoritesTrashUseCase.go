@@ -0,0 +1,56 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PurgeFavoritesTrashUseCase is an autogenerated mock type for the PurgeFavoritesTrashUseCase type
+type PurgeFavoritesTrashUseCase struct {
+	mock.Mock
+}
+
+// Execute provides a mock function with given fields: ctx
+func (_m *PurgeFavoritesTrashUseCase) Execute(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewPurgeFavoritesTrashUseCase creates a new instance of PurgeFavoritesTrashUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPurgeFavoritesTrashUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PurgeFavoritesTrashUseCase {
+	mock := &PurgeFavoritesTrashUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,48 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+// RemoveFavoritesQuotaUseCase is an autogenerated mock type for the RemoveFavoritesQuotaUseCase type
+type RemoveFavoritesQuotaUseCase struct {
+	mock.Mock
+}
+
+// Execute provides a mock function with given fields: ctx, clientID
+func (_m *RemoveFavoritesQuotaUseCase) Execute(ctx context.Context, clientID uuid.ID) error {
+	ret := _m.Called(ctx, clientID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.ID) error); ok {
+		r0 = rf(ctx, clientID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewRemoveFavoritesQuotaUseCase creates a new instance of RemoveFavoritesQuotaUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRemoveFavoritesQuotaUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RemoveFavoritesQuotaUseCase {
+	mock := &RemoveFavoritesQuotaUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -10,17 +10,22 @@ import (
 	"github.com/uesleicarvalhoo/aiqfome/pkg/logger"
 	"github.com/uesleicarvalhoo/aiqfome/pkg/trace"
 	"github.com/uesleicarvalhoo/aiqfome/product"
+	"github.com/uesleicarvalhoo/aiqfome/user"
 )
 
 type addProductToFavoritesUseCase struct {
 	products  product.Reader
 	favorites favorite.Repository
+	users     user.Reader
+	quota     QuotaOptions
 }
 
-func NewAddProductToFavoritesUseCase(productReader product.Reader, favoriteRepo favorite.Repository) usecase.AddProductToFavoritesUseCase {
+func NewAddProductToFavoritesUseCase(productReader product.Reader, favoriteRepo favorite.Repository, userReader user.Reader, quota QuotaOptions) usecase.AddProductToFavoritesUseCase {
 	return &addProductToFavoritesUseCase{
 		products:  productReader,
 		favorites: favoriteRepo,
+		users:     userReader,
+		quota:     quota,
 	}
 }
 
@@ -74,7 +79,30 @@ func (u *addProductToFavoritesUseCase) Execute(ctx context.Context, p dto.AddPro
 		return dto.ProductFavorite{}, err
 	}
 
-	if err := u.favorites.Create(ctx, f); err != nil {
+	f.Snapshot(pd.Title, pd.Price)
+
+	limit, _, err := favoritesLimit(ctx, u.favorites, u.users, p.ClientID, u.quota)
+	if err != nil {
+		return dto.ProductFavorite{}, err
+	}
+
+	if err := u.favorites.CreateWithinQuota(ctx, p.ClientID, []favorite.Favorite{f}, limit, favoriteEvents(ctx, favorite.EventAdded, f)...); err != nil {
+		if qErr, ok := err.(*favorite.ErrQuotaExceeded); ok {
+			logger.WarnF(ctx, "favorites quota exceeded", logger.Fields{
+				"client_id": p.ClientID,
+				"limit":     qErr.Limit,
+			})
+
+			return dto.ProductFavorite{}, quotaExceeded(qErr)
+		}
+
+		if _, ok := err.(*favorite.ErrAlreadyFavorite); ok {
+			return dto.ProductFavorite{}, domainerror.New(domainerror.ProductAlreadyIsFavorite, "o produto já está nos favoritos", map[string]any{
+				"client_id":  p.ClientID,
+				"product_id": p.ProductID,
+			})
+		}
+
 		return dto.ProductFavorite{}, domainerror.Wrap(err, domainerror.DependecyError, "erro ao adicionar o produto aos favoritos", map[string]any{
 			"client_id":  p.ClientID,
 			"product_id": p.ProductID,
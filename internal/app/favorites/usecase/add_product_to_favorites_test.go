@@ -17,6 +17,11 @@ import (
 	"github.com/uesleicarvalhoo/aiqfome/product"
 	fixtureProd "github.com/uesleicarvalhoo/aiqfome/product/fixture"
 	mocksProduct "github.com/uesleicarvalhoo/aiqfome/product/mocks"
+	"github.com/uesleicarvalhoo/aiqfome/role"
+	"github.com/uesleicarvalhoo/aiqfome/test"
+	"github.com/uesleicarvalhoo/aiqfome/user"
+	fixtureUser "github.com/uesleicarvalhoo/aiqfome/user/fixture"
+	mocksUser "github.com/uesleicarvalhoo/aiqfome/user/mocks"
 )
 
 func TestAddProductToFavoritesUseCase_Execute(t *testing.T) {
@@ -28,12 +33,14 @@ func TestAddProductToFavoritesUseCase_Execute(t *testing.T) {
 		WithClientID(clientID)
 
 	productBuilder := fixtureProd.AnyProduct().WithID(productID)
+	quota := usecase.QuotaOptions{ByRole: map[role.Role]int{role.RoleClient: 5}}
 
 	testCases := []struct {
 		about          string
 		params         dto.AddProductToFavoritesParams
 		setupProducts  func(m *mocksProduct.Reader)
 		setupFavorites func(m *mocksFavorite.Repository)
+		setupUsers     func(m *mocksUser.Reader)
 		expectedErr    string
 		expectedResult dto.ProductFavorite
 	}{
@@ -83,11 +90,70 @@ func TestAddProductToFavoritesUseCase_Execute(t *testing.T) {
 			setupFavorites: func(m *mocksFavorite.Repository) {
 				m.On("Find", mock.Anything, clientID, productID).
 					Return(favorite.Favorite{}, errors.New("db error"))
-				m.On("Create", mock.Anything, mock.AnythingOfType("favorite.Favorite")).
+				m.On("FindQuota", mock.Anything, clientID).
+					Return(favorite.Quota{ClientID: clientID, MaxFavorites: 10}, nil)
+				m.On("CreateWithinQuota", mock.Anything, clientID, mock.AnythingOfType("[]favorite.Favorite"), 10, test.MatchEvent(favorite.EventAdded)).
 					Return(errors.New("db error"))
 			},
 			expectedErr: "[AQF004] erro ao adicionar o produto aos favoritos",
 		},
+		{
+			about:  "when the product is favorited concurrently",
+			params: paramsBuilder.Build(),
+			setupProducts: func(m *mocksProduct.Reader) {
+				m.On("Find", mock.Anything, productID).
+					Return(productBuilder.Build(), nil)
+			},
+			setupFavorites: func(m *mocksFavorite.Repository) {
+				m.On("Find", mock.Anything, clientID, productID).
+					Return(favorite.Favorite{}, &favorite.ErrFavoriteNotFound{ClientID: clientID, ProductID: productID})
+				m.On("FindQuota", mock.Anything, clientID).
+					Return(favorite.Quota{ClientID: clientID, MaxFavorites: 10}, nil)
+				m.On("CreateWithinQuota", mock.Anything, clientID, mock.AnythingOfType("[]favorite.Favorite"), 10, test.MatchEvent(favorite.EventAdded)).
+					Return(&favorite.ErrAlreadyFavorite{ClientID: clientID, ProductID: productID})
+			},
+			expectedErr: "[FAV001] o produto já está nos favoritos",
+		},
+		{
+			about:  "when client is not found",
+			params: paramsBuilder.Build(),
+			setupProducts: func(m *mocksProduct.Reader) {
+				m.On("Find", mock.Anything, productID).
+					Return(productBuilder.Build(), nil)
+			},
+			setupFavorites: func(m *mocksFavorite.Repository) {
+				m.On("Find", mock.Anything, clientID, productID).
+					Return(favorite.Favorite{}, errors.New("not found"))
+				m.On("FindQuota", mock.Anything, clientID).
+					Return(favorite.Quota{}, &favorite.ErrQuotaNotFound{ClientID: clientID})
+			},
+			setupUsers: func(m *mocksUser.Reader) {
+				m.On("Find", mock.Anything, clientID).
+					Return(user.User{}, user.ErrNotFound)
+			},
+			expectedErr: "[AQF003] cliente não encontrado",
+		},
+		{
+			about:  "when quota is exceeded",
+			params: paramsBuilder.Build(),
+			setupProducts: func(m *mocksProduct.Reader) {
+				m.On("Find", mock.Anything, productID).
+					Return(productBuilder.Build(), nil)
+			},
+			setupFavorites: func(m *mocksFavorite.Repository) {
+				m.On("Find", mock.Anything, clientID, productID).
+					Return(favorite.Favorite{}, errors.New("not found"))
+				m.On("FindQuota", mock.Anything, clientID).
+					Return(favorite.Quota{}, &favorite.ErrQuotaNotFound{ClientID: clientID})
+				m.On("CreateWithinQuota", mock.Anything, clientID, mock.AnythingOfType("[]favorite.Favorite"), 5, test.MatchEvent(favorite.EventAdded)).
+					Return(&favorite.ErrQuotaExceeded{ClientID: clientID, Limit: 5, Count: 5})
+			},
+			setupUsers: func(m *mocksUser.Reader) {
+				m.On("Find", mock.Anything, clientID).
+					Return(fixtureUser.AnyUser().WithID(clientID).WithRole(role.RoleClient).Build(), nil)
+			},
+			expectedErr: "[FAV003] limite de 5 favoritos atingido",
+		},
 		{
 			about:  "when all is valid",
 			params: paramsBuilder.Build(),
@@ -98,9 +164,18 @@ func TestAddProductToFavoritesUseCase_Execute(t *testing.T) {
 			setupFavorites: func(m *mocksFavorite.Repository) {
 				m.On("Find", mock.Anything, clientID, productID).
 					Return(favorite.Favorite{}, errors.New("not found"))
-				m.On("Create", mock.Anything, mock.MatchedBy(func(f favorite.Favorite) bool {
-					return f.ClientID == clientID && f.ProductID == productID
-				})).Return(nil)
+				m.On("FindQuota", mock.Anything, clientID).
+					Return(favorite.Quota{ClientID: clientID, MaxFavorites: 10}, nil)
+				m.On("CreateWithinQuota", mock.Anything, clientID, mock.MatchedBy(func(ff []favorite.Favorite) bool {
+					pd := productBuilder.Build()
+					if len(ff) != 1 {
+						return false
+					}
+
+					f := ff[0]
+					return f.ClientID == clientID && f.ProductID == productID &&
+						f.TitleWhenFavorited == pd.Title && f.PriceWhenFavorited != nil && *f.PriceWhenFavorited == pd.Price
+				}), 10, test.MatchEvent(favorite.EventAdded)).Return(nil)
 			},
 			expectedResult: dto.ProductFavorite{ClientID: clientID, Product: productBuilder.Build()},
 		},
@@ -123,7 +198,12 @@ func TestAddProductToFavoritesUseCase_Execute(t *testing.T) {
 				tc.setupFavorites(favRepo)
 			}
 
-			uc := usecase.NewAddProductToFavoritesUseCase(prodReader, favRepo)
+			userReader := mocksUser.NewReader(t)
+			if tc.setupUsers != nil {
+				tc.setupUsers(userReader)
+			}
+
+			uc := usecase.NewAddProductToFavoritesUseCase(prodReader, favRepo, userReader, quota)
 
 			// Action
 			res, err := uc.Execute(context.Background(), tc.params)
@@ -140,6 +220,7 @@ func TestAddProductToFavoritesUseCase_Execute(t *testing.T) {
 
 			prodReader.AssertExpectations(t)
 			favRepo.AssertExpectations(t)
+			userReader.AssertExpectations(t)
 		})
 	}
 }
@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/domainerror"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/logger"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/trace"
+	"github.com/uesleicarvalhoo/aiqfome/product"
+)
+
+type addProductToListUseCase struct {
+	products  product.Reader
+	favorites favorite.Repository
+}
+
+func NewAddProductToListUseCase(productReader product.Reader, favoriteRepo favorite.Repository) favorites.AddProductToListUseCase {
+	return &addProductToListUseCase{
+		products:  productReader,
+		favorites: favoriteRepo,
+	}
+}
+
+func (u *addProductToListUseCase) Execute(ctx context.Context, p dto.AddProductToListParams) (dto.ListProduct, error) {
+	ctx, span := trace.NewSpan(ctx, "favorites.addProductToList")
+	defer span.End()
+
+	if err := p.Validate(); err != nil {
+		logger.ErrorF(ctx, "invalid params", logger.Fields{
+			"error":  err.Error(),
+			"params": p,
+		})
+
+		return dto.ListProduct{}, err
+	}
+
+	l, err := findClientList(ctx, u.favorites, p.ClientID, p.ListID)
+	if err != nil {
+		return dto.ListProduct{}, err
+	}
+
+	pd, err := u.products.Find(ctx, p.ProductID)
+	if err != nil {
+		logger.ErrorF(ctx, "error while trying to find product", logger.Fields{
+			"product_id": p.ProductID,
+			"error":      err.Error(),
+		})
+
+		if _, ok := err.(*product.ErrNotFound); ok {
+			return dto.ListProduct{}, domainerror.Wrap(err, domainerror.ResourceNotFound, "produto não encontrado", map[string]any{
+				"product_id": p.ProductID,
+			})
+		}
+
+		return dto.ListProduct{}, domainerror.Wrap(err, domainerror.DependecyError, "erro ao obter dados do produto", map[string]any{
+			"product_id": p.ProductID,
+			"error":      err.Error(),
+		})
+	}
+
+	if _, err := u.favorites.FindListItem(ctx, l.ID, p.ProductID); err == nil {
+		return dto.ListProduct{}, domainerror.New(domainerror.ProductAlreadyInList, "o produto já está na lista", map[string]any{
+			"list_id":    l.ID,
+			"product_id": p.ProductID,
+		})
+	}
+
+	i, err := favorite.NewListItem(l.ID, p.ProductID)
+	if err != nil {
+		logger.ErrorF(ctx, "invalid list item params", logger.Fields{
+			"list_id":    l.ID,
+			"product_id": p.ProductID,
+			"error":      err.Error(),
+		})
+
+		return dto.ListProduct{}, err
+	}
+
+	if err := u.favorites.AddListItem(ctx, i); err != nil {
+		if _, ok := err.(*favorite.ErrListItemAlreadyExists); ok {
+			return dto.ListProduct{}, domainerror.New(domainerror.ProductAlreadyInList, "o produto já está na lista", map[string]any{
+				"list_id":    l.ID,
+				"product_id": p.ProductID,
+			})
+		}
+
+		return dto.ListProduct{}, domainerror.Wrap(err, domainerror.DependecyError, "erro ao adicionar o produto na lista", map[string]any{
+			"list_id":    l.ID,
+			"product_id": p.ProductID,
+			"error":      err.Error(),
+		})
+	}
+
+	return dto.ListProduct{
+		ListID:  l.ID,
+		Product: pd,
+	}, nil
+}
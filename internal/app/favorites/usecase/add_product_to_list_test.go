@@ -0,0 +1,195 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	fixtureFavorite "github.com/uesleicarvalhoo/aiqfome/favorite/fixture"
+	mocksFavorite "github.com/uesleicarvalhoo/aiqfome/favorite/mocks"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	fixtureDto "github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto/fixture"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/usecase"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/product"
+	fixtureProd "github.com/uesleicarvalhoo/aiqfome/product/fixture"
+	mocksProduct "github.com/uesleicarvalhoo/aiqfome/product/mocks"
+)
+
+func TestAddProductToListUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	clientID := uuid.NextID()
+	listID := uuid.NextID()
+	productID := 1
+
+	paramsBuilder := fixtureDto.AnyAddProductToListParams().
+		WithClientID(clientID).
+		WithListID(listID).
+		WithProductID(productID)
+
+	list := fixtureFavorite.AnyList().
+		WithID(listID).
+		WithClientID(clientID).
+		Build()
+
+	productBuilder := fixtureProd.AnyProduct().WithID(productID)
+
+	testCases := []struct {
+		about          string
+		params         dto.AddProductToListParams
+		setupProducts  func(m *mocksProduct.Reader)
+		setupFavorites func(m *mocksFavorite.Repository)
+		expectedErr    string
+		expectedResult dto.ListProduct
+	}{
+		{
+			about:       "when params are invalid",
+			params:      dto.AddProductToListParams{},
+			expectedErr: "[AQF002] clientId: campo obrigatório; listId: campo obrigatório; productId: campo obrigatório",
+		},
+		{
+			about:  "when list not found",
+			params: paramsBuilder.Build(),
+			setupFavorites: func(m *mocksFavorite.Repository) {
+				m.On("FindList", mock.Anything, clientID, listID).
+					Return(favorite.List{}, &favorite.ErrListNotFound{ClientID: clientID, ListID: listID})
+			},
+			expectedErr: "[AQF003] lista não encontrada",
+		},
+		{
+			about:  "when product not found",
+			params: paramsBuilder.Build(),
+			setupFavorites: func(m *mocksFavorite.Repository) {
+				m.On("FindList", mock.Anything, clientID, listID).
+					Return(list, nil)
+			},
+			setupProducts: func(m *mocksProduct.Reader) {
+				m.On("Find", mock.Anything, productID).
+					Return(product.Product{}, &product.ErrNotFound{ID: productID})
+			},
+			expectedErr: "[AQF003] produto não encontrado",
+		},
+		{
+			about:  "when product reader returns other error",
+			params: paramsBuilder.Build(),
+			setupFavorites: func(m *mocksFavorite.Repository) {
+				m.On("FindList", mock.Anything, clientID, listID).
+					Return(list, nil)
+			},
+			setupProducts: func(m *mocksProduct.Reader) {
+				m.On("Find", mock.Anything, productID).
+					Return(product.Product{}, errors.New("service down"))
+			},
+			expectedErr: "[AQF004] erro ao obter dados do produto",
+		},
+		{
+			about:  "when product already is in the list",
+			params: paramsBuilder.Build(),
+			setupFavorites: func(m *mocksFavorite.Repository) {
+				m.On("FindList", mock.Anything, clientID, listID).
+					Return(list, nil)
+				m.On("FindListItem", mock.Anything, listID, productID).
+					Return(favorite.ListItem{}, nil)
+			},
+			setupProducts: func(m *mocksProduct.Reader) {
+				m.On("Find", mock.Anything, productID).
+					Return(productBuilder.Build(), nil)
+			},
+			expectedErr: "[FAV002] o produto já está na lista",
+		},
+		{
+			about:  "when the product is added to the list concurrently",
+			params: paramsBuilder.Build(),
+			setupFavorites: func(m *mocksFavorite.Repository) {
+				m.On("FindList", mock.Anything, clientID, listID).
+					Return(list, nil)
+				m.On("FindListItem", mock.Anything, listID, productID).
+					Return(favorite.ListItem{}, &favorite.ErrListItemNotFound{ListID: listID, ProductID: productID})
+				m.On("AddListItem", mock.Anything, mock.AnythingOfType("favorite.ListItem")).
+					Return(&favorite.ErrListItemAlreadyExists{ListID: listID, ProductID: productID})
+			},
+			setupProducts: func(m *mocksProduct.Reader) {
+				m.On("Find", mock.Anything, productID).
+					Return(productBuilder.Build(), nil)
+			},
+			expectedErr: "[FAV002] o produto já está na lista",
+		},
+		{
+			about:  "when add list item fails",
+			params: paramsBuilder.Build(),
+			setupFavorites: func(m *mocksFavorite.Repository) {
+				m.On("FindList", mock.Anything, clientID, listID).
+					Return(list, nil)
+				m.On("FindListItem", mock.Anything, listID, productID).
+					Return(favorite.ListItem{}, &favorite.ErrListItemNotFound{ListID: listID, ProductID: productID})
+				m.On("AddListItem", mock.Anything, mock.AnythingOfType("favorite.ListItem")).
+					Return(errors.New("db error"))
+			},
+			setupProducts: func(m *mocksProduct.Reader) {
+				m.On("Find", mock.Anything, productID).
+					Return(productBuilder.Build(), nil)
+			},
+			expectedErr: "[AQF004] erro ao adicionar o produto na lista",
+		},
+		{
+			about:  "when all is valid",
+			params: paramsBuilder.Build(),
+			setupFavorites: func(m *mocksFavorite.Repository) {
+				m.On("FindList", mock.Anything, clientID, listID).
+					Return(list, nil)
+				m.On("FindListItem", mock.Anything, listID, productID).
+					Return(favorite.ListItem{}, &favorite.ErrListItemNotFound{ListID: listID, ProductID: productID})
+				m.On("AddListItem", mock.Anything, mock.MatchedBy(func(i favorite.ListItem) bool {
+					return i.ListID == listID && i.ProductID == productID
+				})).Return(nil)
+			},
+			setupProducts: func(m *mocksProduct.Reader) {
+				m.On("Find", mock.Anything, productID).
+					Return(productBuilder.Build(), nil)
+			},
+			expectedResult: dto.ListProduct{ListID: listID, Product: productBuilder.Build()},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			prodReader := mocksProduct.NewReader(t)
+			if tc.setupProducts != nil {
+				tc.setupProducts(prodReader)
+			}
+
+			favRepo := mocksFavorite.NewRepository(t)
+			if tc.setupFavorites != nil {
+				tc.setupFavorites(favRepo)
+			}
+
+			uc := usecase.NewAddProductToListUseCase(prodReader, favRepo)
+
+			// Action
+			res, err := uc.Execute(context.Background(), tc.params)
+
+			// Assert
+			if tc.expectedErr != "" {
+				assert.Equal(t, dto.ListProduct{}, res)
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedResult, res)
+			}
+
+			prodReader.AssertExpectations(t)
+			favRepo.AssertExpectations(t)
+		})
+	}
+}
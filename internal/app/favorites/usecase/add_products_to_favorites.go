@@ -0,0 +1,172 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/domainerror"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/logger"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/trace"
+	"github.com/uesleicarvalhoo/aiqfome/product"
+	"github.com/uesleicarvalhoo/aiqfome/user"
+)
+
+type addProductsToFavoritesUseCase struct {
+	products  product.Reader
+	favorites favorite.Repository
+	users     user.Reader
+	opts      BatchOptions
+	quota     QuotaOptions
+}
+
+func NewAddProductsToFavoritesUseCase(productReader product.Reader, favoriteRepo favorite.Repository, userReader user.Reader, opts BatchOptions, quota QuotaOptions) favorites.AddProductsToFavoritesUseCase {
+	return &addProductsToFavoritesUseCase{
+		products:  productReader,
+		favorites: favoriteRepo,
+		users:     userReader,
+		opts:      opts,
+		quota:     quota,
+	}
+}
+
+func (u *addProductsToFavoritesUseCase) Execute(ctx context.Context, p dto.AddProductsToFavoritesParams) (dto.FavoritesBatchResult, error) {
+	ctx, span := trace.NewSpan(ctx, "favorites.addProductsToFavorites")
+	defer span.End()
+
+	if err := p.Validate(); err != nil {
+		logger.ErrorF(ctx, "invalid params", logger.Fields{
+			"error":  err.Error(),
+			"params": p,
+		})
+
+		return dto.FavoritesBatchResult{}, err
+	}
+
+	if err := checkBatchSize(p.ProductIDs, u.opts); err != nil {
+		return dto.FavoritesBatchResult{}, err
+	}
+
+	ids, repeated := uniqueIDs(p.ProductIDs)
+
+	pp, err := u.products.FindMultiple(ctx, ids)
+	if err != nil {
+		// Products only unknown because the source failed are an outage, not products to reject
+		if nfErr, ok := err.(*product.ErrProductsNotFound); !ok || nfErr.Cause != nil {
+			logger.ErrorF(ctx, "error while trying to find products", logger.Fields{
+				"product_ids": ids,
+				"error":       err.Error(),
+			})
+
+			return dto.FavoritesBatchResult{}, domainerror.Wrap(err, domainerror.DependecyError, "erro ao obter dados dos produtos", map[string]any{
+				"product_ids": ids,
+				"error":       err.Error(),
+			})
+		}
+	}
+
+	foundIDs := make([]int, 0, len(pp))
+	found := make(map[int]product.Product, len(pp))
+	for _, pd := range pp {
+		foundIDs = append(foundIDs, pd.ID)
+		found[pd.ID] = pd
+	}
+
+	existing := []favorite.Favorite{}
+	if len(foundIDs) > 0 {
+		existing, err = u.favorites.FindMultiple(ctx, p.ClientID, foundIDs)
+		if err != nil {
+			logger.ErrorF(ctx, "error while trying to find favorites", logger.Fields{
+				"client_id":   p.ClientID,
+				"product_ids": foundIDs,
+				"error":       err.Error(),
+			})
+
+			return dto.FavoritesBatchResult{}, domainerror.Wrap(err, domainerror.DependecyError, "erro ao buscar favoritos", map[string]any{
+				"client_id":   p.ClientID,
+				"product_ids": foundIDs,
+				"error":       err.Error(),
+			})
+		}
+	}
+
+	items := make([]dto.BatchItemResult, 0, len(p.ProductIDs))
+	ff := make([]favorite.Favorite, 0, len(foundIDs))
+
+	for _, id := range ids {
+		if !slices.Contains(foundIDs, id) {
+			items = append(items, dto.BatchItemResult{ProductID: id, Status: dto.BatchItemNotFound})
+			continue
+		}
+
+		isFavorite := slices.ContainsFunc(existing, func(f favorite.Favorite) bool {
+			return f.ProductID == id
+		})
+		if isFavorite {
+			items = append(items, dto.BatchItemResult{ProductID: id, Status: dto.BatchItemDuplicate})
+			continue
+		}
+
+		f, err := favorite.New(p.ClientID, id)
+		if err != nil {
+			logger.ErrorF(ctx, "invalid favorite params", logger.Fields{
+				"client_id":  p.ClientID,
+				"product_id": id,
+				"error":      err.Error(),
+			})
+
+			return dto.FavoritesBatchResult{}, err
+		}
+
+		f.Snapshot(found[id].Title, found[id].Price)
+
+		ff = append(ff, f)
+		items = append(items, dto.BatchItemResult{ProductID: id, Status: dto.BatchItemAdded})
+	}
+
+	for _, id := range repeated {
+		items = append(items, dto.BatchItemResult{ProductID: id, Status: dto.BatchItemDuplicate})
+	}
+
+	if len(ff) > 0 {
+		limit, _, err := favoritesLimit(ctx, u.favorites, u.users, p.ClientID, u.quota)
+		if err != nil {
+			return dto.FavoritesBatchResult{}, err
+		}
+
+		if err := u.favorites.CreateWithinQuota(ctx, p.ClientID, ff, limit, favoriteEvents(ctx, favorite.EventAdded, ff...)...); err != nil {
+			if qErr, ok := err.(*favorite.ErrQuotaExceeded); ok {
+				logger.WarnF(ctx, "favorites quota exceeded", logger.Fields{
+					"client_id": p.ClientID,
+					"limit":     qErr.Limit,
+				})
+
+				return dto.FavoritesBatchResult{}, quotaExceeded(qErr)
+			}
+
+			if fErr, ok := err.(*favorite.ErrAlreadyFavorite); ok {
+				return dto.FavoritesBatchResult{}, domainerror.New(domainerror.ProductAlreadyIsFavorite, "o produto já está nos favoritos", map[string]any{
+					"client_id":  fErr.ClientID,
+					"product_id": fErr.ProductID,
+				})
+			}
+
+			logger.ErrorF(ctx, "error while trying to create favorites", logger.Fields{
+				"client_id": p.ClientID,
+				"error":     err.Error(),
+			})
+
+			return dto.FavoritesBatchResult{}, domainerror.Wrap(err, domainerror.DependecyError, "erro ao adicionar os produtos aos favoritos", map[string]any{
+				"client_id": p.ClientID,
+				"error":     err.Error(),
+			})
+		}
+	}
+
+	return dto.FavoritesBatchResult{
+		ClientID: p.ClientID,
+		Items:    items,
+	}, nil
+}
@@ -0,0 +1,219 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	fixtureFavorite "github.com/uesleicarvalhoo/aiqfome/favorite/fixture"
+	mocksFavorite "github.com/uesleicarvalhoo/aiqfome/favorite/mocks"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	fixtureDto "github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto/fixture"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/usecase"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/product"
+	fixtureProd "github.com/uesleicarvalhoo/aiqfome/product/fixture"
+	mocksProduct "github.com/uesleicarvalhoo/aiqfome/product/mocks"
+	"github.com/uesleicarvalhoo/aiqfome/role"
+	"github.com/uesleicarvalhoo/aiqfome/test"
+	fixtureUser "github.com/uesleicarvalhoo/aiqfome/user/fixture"
+	mocksUser "github.com/uesleicarvalhoo/aiqfome/user/mocks"
+)
+
+func TestAddProductsToFavoritesUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	clientID := uuid.NextID()
+
+	paramsBuilder := fixtureDto.AnyAddProductsToFavoritesParams().
+		WithClientID(clientID).
+		WithProductIDs([]int{1, 2, 3, 1})
+
+	productBuilder := fixtureProd.AnyProduct()
+	favoriteBuilder := fixtureFavorite.AnyFavorite().WithClientID(clientID)
+	quota := usecase.QuotaOptions{ByRole: map[role.Role]int{role.RoleClient: 5}}
+
+	testCases := []struct {
+		about          string
+		params         dto.AddProductsToFavoritesParams
+		setupProducts  func(m *mocksProduct.Reader)
+		setupFavorites func(m *mocksFavorite.Repository)
+		setupUsers     func(m *mocksUser.Reader)
+		expectedErr    string
+		expectedResult dto.FavoritesBatchResult
+	}{
+		{
+			about:       "when params are invalid",
+			params:      dto.AddProductsToFavoritesParams{},
+			expectedErr: "[AQF002] clientId: campo obrigatório; productIds: campo obrigatório",
+		},
+		{
+			about:       "when batch is too large",
+			params:      paramsBuilder.WithProductIDs([]int{1, 2, 3, 4, 5, 6}).Build(),
+			expectedErr: "[AQF002] é permitido no máximo 5 produtos por requisição",
+		},
+		{
+			about:  "when product reader fails",
+			params: paramsBuilder.Build(),
+			setupProducts: func(m *mocksProduct.Reader) {
+				m.On("FindMultiple", mock.Anything, []int{1, 2, 3}).
+					Return([]product.Product{}, errors.New("service down"))
+			},
+			expectedErr: "[AQF004] erro ao obter dados dos produtos",
+		},
+		{
+			about:  "when some products are only unknown because the product reader failed",
+			params: paramsBuilder.Build(),
+			setupProducts: func(m *mocksProduct.Reader) {
+				m.On("FindMultiple", mock.Anything, []int{1, 2, 3}).
+					Return([]product.Product{fixtureProd.AnyProduct().WithID(1).Build()}, &product.ErrProductsNotFound{IDs: []int{2, 3}, Cause: errors.New("service down")})
+			},
+			expectedErr: "[AQF004] erro ao obter dados dos produtos",
+		},
+		{
+			about:  "when find favorites fails",
+			params: paramsBuilder.Build(),
+			setupProducts: func(m *mocksProduct.Reader) {
+				m.On("FindMultiple", mock.Anything, []int{1, 2, 3}).
+					Return([]product.Product{productBuilder.WithID(1).Build()}, &product.ErrProductsNotFound{IDs: []int{2, 3}})
+			},
+			setupFavorites: func(m *mocksFavorite.Repository) {
+				m.On("FindMultiple", mock.Anything, clientID, []int{1}).
+					Return([]favorite.Favorite{}, errors.New("db error"))
+			},
+			expectedErr: "[AQF004] erro ao buscar favoritos",
+		},
+		{
+			about:  "when create many fails",
+			params: paramsBuilder.Build(),
+			setupProducts: func(m *mocksProduct.Reader) {
+				m.On("FindMultiple", mock.Anything, []int{1, 2, 3}).
+					Return([]product.Product{productBuilder.WithID(1).Build()}, &product.ErrProductsNotFound{IDs: []int{2, 3}})
+			},
+			setupFavorites: func(m *mocksFavorite.Repository) {
+				m.On("FindMultiple", mock.Anything, clientID, []int{1}).
+					Return([]favorite.Favorite{}, nil)
+				m.On("FindQuota", mock.Anything, clientID).
+					Return(favorite.Quota{ClientID: clientID, MaxFavorites: 10}, nil)
+				m.On("CreateWithinQuota", mock.Anything, clientID, mock.Anything, 10, test.MatchEvent(favorite.EventAdded)).
+					Return(errors.New("db error"))
+			},
+			expectedErr: "[AQF004] erro ao adicionar os produtos aos favoritos",
+		},
+		{
+			about:  "when quota is exceeded",
+			params: paramsBuilder.Build(),
+			setupProducts: func(m *mocksProduct.Reader) {
+				m.On("FindMultiple", mock.Anything, []int{1, 2, 3}).
+					Return([]product.Product{productBuilder.WithID(1).Build()}, &product.ErrProductsNotFound{IDs: []int{2, 3}})
+			},
+			setupFavorites: func(m *mocksFavorite.Repository) {
+				m.On("FindMultiple", mock.Anything, clientID, []int{1}).
+					Return([]favorite.Favorite{}, nil)
+				m.On("FindQuota", mock.Anything, clientID).
+					Return(favorite.Quota{}, &favorite.ErrQuotaNotFound{ClientID: clientID})
+				m.On("CreateWithinQuota", mock.Anything, clientID, mock.Anything, 5, test.MatchEvent(favorite.EventAdded)).
+					Return(&favorite.ErrQuotaExceeded{ClientID: clientID, Limit: 5, Count: 5})
+			},
+			setupUsers: func(m *mocksUser.Reader) {
+				m.On("Find", mock.Anything, clientID).
+					Return(fixtureUser.AnyUser().WithID(clientID).WithRole(role.RoleClient).Build(), nil)
+			},
+			expectedErr: "[FAV003] limite de 5 favoritos atingido",
+		},
+		{
+			about:  "when no product is found",
+			params: paramsBuilder.WithProductIDs([]int{2, 3}).Build(),
+			setupProducts: func(m *mocksProduct.Reader) {
+				m.On("FindMultiple", mock.Anything, []int{2, 3}).
+					Return([]product.Product{}, &product.ErrProductsNotFound{IDs: []int{2, 3}})
+			},
+			expectedResult: dto.FavoritesBatchResult{
+				ClientID: clientID,
+				Items: []dto.BatchItemResult{
+					{ProductID: 2, Status: dto.BatchItemNotFound},
+					{ProductID: 3, Status: dto.BatchItemNotFound},
+				},
+			},
+		},
+		{
+			about:  "when all is valid",
+			params: paramsBuilder.Build(),
+			setupProducts: func(m *mocksProduct.Reader) {
+				m.On("FindMultiple", mock.Anything, []int{1, 2, 3}).
+					Return([]product.Product{
+						productBuilder.WithID(1).Build(),
+						productBuilder.WithID(2).Build(),
+					}, &product.ErrProductsNotFound{IDs: []int{3}})
+			},
+			setupFavorites: func(m *mocksFavorite.Repository) {
+				m.On("FindMultiple", mock.Anything, clientID, []int{1, 2}).
+					Return([]favorite.Favorite{favoriteBuilder.WithProductID(2).Build()}, nil)
+				m.On("FindQuota", mock.Anything, clientID).
+					Return(favorite.Quota{ClientID: clientID, MaxFavorites: 0}, nil)
+				m.On("CreateWithinQuota", mock.Anything, clientID, mock.MatchedBy(func(ff []favorite.Favorite) bool {
+					pd := productBuilder.WithID(1).Build()
+
+					return len(ff) == 1 && ff[0].ClientID == clientID && ff[0].ProductID == 1 &&
+						ff[0].TitleWhenFavorited == pd.Title && ff[0].PriceWhenFavorited != nil && *ff[0].PriceWhenFavorited == pd.Price
+				}), 0, test.MatchEvent(favorite.EventAdded)).Return(nil)
+			},
+			expectedResult: dto.FavoritesBatchResult{
+				ClientID: clientID,
+				Items: []dto.BatchItemResult{
+					{ProductID: 1, Status: dto.BatchItemAdded},
+					{ProductID: 2, Status: dto.BatchItemDuplicate},
+					{ProductID: 3, Status: dto.BatchItemNotFound},
+					{ProductID: 1, Status: dto.BatchItemDuplicate},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			prodReader := mocksProduct.NewReader(t)
+			if tc.setupProducts != nil {
+				tc.setupProducts(prodReader)
+			}
+
+			favRepo := mocksFavorite.NewRepository(t)
+			if tc.setupFavorites != nil {
+				tc.setupFavorites(favRepo)
+			}
+
+			userReader := mocksUser.NewReader(t)
+			if tc.setupUsers != nil {
+				tc.setupUsers(userReader)
+			}
+
+			uc := usecase.NewAddProductsToFavoritesUseCase(prodReader, favRepo, userReader, usecase.BatchOptions{MaxBatchSize: 5}, quota)
+
+			// Action
+			res, err := uc.Execute(context.Background(), tc.params)
+
+			// Assert
+			if tc.expectedErr != "" {
+				assert.Equal(t, dto.FavoritesBatchResult{}, res)
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedResult, res)
+			}
+
+			prodReader.AssertExpectations(t)
+			favRepo.AssertExpectations(t)
+			userReader.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/domainerror"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/logger"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/trace"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/product"
+)
+
+type archiveUnavailableFavoritesUseCase struct {
+	repo     favorite.Repository
+	products product.Reader
+}
+
+func NewArchiveUnavailableFavoritesUseCase(repo favorite.Repository, products product.Reader) favorites.ArchiveUnavailableFavoritesUseCase {
+	return &archiveUnavailableFavoritesUseCase{
+		repo:     repo,
+		products: products,
+	}
+}
+
+func (u *archiveUnavailableFavoritesUseCase) Execute(ctx context.Context) (int, error) {
+	ctx, span := trace.NewSpan(ctx, "favorites.archiveUnavailableFavorites")
+	defer span.End()
+
+	counts, err := u.repo.CountByProduct(ctx)
+	if err != nil {
+		logger.ErrorF(ctx, "error while trying to count favorites by product", logger.Fields{
+			"error": err.Error(),
+		})
+
+		return 0, domainerror.Wrap(err, domainerror.DependecyError, "erro ao buscar produtos favoritados", map[string]any{
+			"error": err.Error(),
+		})
+	}
+
+	if len(counts) == 0 {
+		return 0, nil
+	}
+
+	ids := make([]int, 0, len(counts))
+	for _, c := range counts {
+		ids = append(ids, c.ProductID)
+	}
+
+	unavailable, err := u.findUnavailable(ctx, ids)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(unavailable) == 0 {
+		return 0, nil
+	}
+
+	// A catalog answering that nothing exists is more likely broken than empty
+	if len(unavailable) == len(ids) {
+		logger.WarnF(ctx, "all favorited products are unavailable, skipping archive", logger.Fields{
+			"products_not_found": unavailable,
+		})
+
+		return 0, nil
+	}
+
+	ff, err := u.repo.AllByProductIDs(ctx, unavailable)
+	if err != nil {
+		logger.ErrorF(ctx, "error while trying to list favorites by products", logger.Fields{
+			"product_ids": unavailable,
+			"error":       err.Error(),
+		})
+
+		return 0, domainerror.Wrap(err, domainerror.DependecyError, "erro ao buscar favoritos", map[string]any{
+			"product_ids": unavailable,
+			"error":       err.Error(),
+		})
+	}
+
+	ee := favorite.Events(favorite.EventArchived, ff)
+	for i := range ee {
+		ee[i] = ee[i].WithActor(uuid.Nil)
+	}
+
+	if err := u.repo.Archive(ctx, ff, ee...); err != nil {
+		logger.ErrorF(ctx, "error while trying to archive favorites", logger.Fields{
+			"product_ids": unavailable,
+			"error":       err.Error(),
+		})
+
+		return 0, domainerror.Wrap(err, domainerror.DependecyError, "erro ao arquivar favoritos", map[string]any{
+			"product_ids": unavailable,
+			"error":       err.Error(),
+		})
+	}
+
+	logger.InfoF(ctx, "unavailable favorites archived", logger.Fields{
+		"product_ids": unavailable,
+		"archived":    len(ff),
+	})
+
+	return len(ff), nil
+}
+
+// findUnavailable returns the ids of the products removed upstream
+func (u *archiveUnavailableFavoritesUseCase) findUnavailable(ctx context.Context, ids []int) ([]int, error) {
+	_, err := u.products.FindMultiple(ctx, ids)
+	if err == nil {
+		return []int{}, nil
+	}
+
+	// Products only unknown because the source failed aren't removed upstream
+	if nfErr, ok := err.(*product.ErrProductsNotFound); ok && nfErr.Cause == nil {
+		return nfErr.IDs, nil
+	}
+
+	logger.ErrorF(ctx, "error while trying to get products", logger.Fields{
+		"error":       err.Error(),
+		"product_ids": ids,
+	})
+
+	return []int{}, domainerror.Wrap(err, domainerror.DependecyError, "erro ao buscar produtos", map[string]any{
+		"error":       err.Error(),
+		"product_ids": ids,
+	})
+}
@@ -0,0 +1,174 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/uesleicarvalhoo/aiqfome/event"
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	fixtureFavorite "github.com/uesleicarvalhoo/aiqfome/favorite/fixture"
+	favMocks "github.com/uesleicarvalhoo/aiqfome/favorite/mocks"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/usecase"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/product"
+	fixtureProduct "github.com/uesleicarvalhoo/aiqfome/product/fixture"
+	prodMocks "github.com/uesleicarvalhoo/aiqfome/product/mocks"
+)
+
+func TestArchiveUnavailableFavoritesUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	favoriteBuilder := fixtureFavorite.AnyFavorite().WithProductID(2)
+	fav1 := favoriteBuilder.WithClientID(uuid.NextID()).Build()
+	fav2 := favoriteBuilder.WithClientID(uuid.NextID()).Build()
+
+	counts := []favorite.ProductCount{
+		{ProductID: 1, Count: 3},
+		{ProductID: 2, Count: 2},
+	}
+
+	archivedBySystem := mock.MatchedBy(func(e event.Event) bool {
+		return e.Type == favorite.EventArchived && e.ActorID != nil && e.ActorID.IsZero()
+	})
+
+	testCases := []struct {
+		about            string
+		setupRepo        func(m *favMocks.Repository)
+		setupProducts    func(m *prodMocks.Repository)
+		expectedErr      string
+		expectedArchived int
+	}{
+		{
+			about: "when count by product fails",
+			setupRepo: func(m *favMocks.Repository) {
+				m.On("CountByProduct", mock.Anything).
+					Return([]favorite.ProductCount{}, errors.New("db error"))
+			},
+			expectedErr: "[AQF004] erro ao buscar produtos favoritados",
+		},
+		{
+			about: "when no product is favorited",
+			setupRepo: func(m *favMocks.Repository) {
+				m.On("CountByProduct", mock.Anything).
+					Return([]favorite.ProductCount{}, nil)
+			},
+		},
+		{
+			about: "when find products fails",
+			setupRepo: func(m *favMocks.Repository) {
+				m.On("CountByProduct", mock.Anything).Return(counts, nil)
+			},
+			setupProducts: func(m *prodMocks.Repository) {
+				m.On("FindMultiple", mock.Anything, []int{1, 2}).
+					Return([]product.Product{}, errors.New("service down"))
+			},
+			expectedErr: "[AQF004] erro ao buscar produtos",
+		},
+		{
+			about: "when the products are only unknown because the source failed",
+			setupRepo: func(m *favMocks.Repository) {
+				m.On("CountByProduct", mock.Anything).Return(counts, nil)
+			},
+			setupProducts: func(m *prodMocks.Repository) {
+				m.On("FindMultiple", mock.Anything, []int{1, 2}).
+					Return([]product.Product{
+						fixtureProduct.AnyProduct().WithID(1).Build(),
+					}, &product.ErrProductsNotFound{IDs: []int{2}, Cause: errors.New("service down")})
+			},
+			expectedErr: "[AQF004] erro ao buscar produtos",
+		},
+		{
+			about: "when all products are available",
+			setupRepo: func(m *favMocks.Repository) {
+				m.On("CountByProduct", mock.Anything).Return(counts, nil)
+			},
+			setupProducts: func(m *prodMocks.Repository) {
+				m.On("FindMultiple", mock.Anything, []int{1, 2}).
+					Return([]product.Product{
+						fixtureProduct.AnyProduct().WithID(1).Build(),
+						fixtureProduct.AnyProduct().WithID(2).Build(),
+					}, nil)
+			},
+		},
+		{
+			about: "when all products are unavailable",
+			setupRepo: func(m *favMocks.Repository) {
+				m.On("CountByProduct", mock.Anything).Return(counts, nil)
+			},
+			setupProducts: func(m *prodMocks.Repository) {
+				m.On("FindMultiple", mock.Anything, []int{1, 2}).
+					Return([]product.Product{}, &product.ErrProductsNotFound{IDs: []int{1, 2}})
+			},
+		},
+		{
+			about: "when archive fails",
+			setupRepo: func(m *favMocks.Repository) {
+				m.On("CountByProduct", mock.Anything).Return(counts, nil)
+				m.On("AllByProductIDs", mock.Anything, []int{2}).
+					Return([]favorite.Favorite{fav1, fav2}, nil)
+				m.On("Archive", mock.Anything, []favorite.Favorite{fav1, fav2}, mock.Anything, mock.Anything).
+					Return(errors.New("db error"))
+			},
+			setupProducts: func(m *prodMocks.Repository) {
+				m.On("FindMultiple", mock.Anything, []int{1, 2}).
+					Return([]product.Product{
+						fixtureProduct.AnyProduct().WithID(1).Build(),
+					}, &product.ErrProductsNotFound{IDs: []int{2}})
+			},
+			expectedErr: "[AQF004] erro ao arquivar favoritos",
+		},
+		{
+			about: "when the favorites of the unavailable products are archived by the system",
+			setupRepo: func(m *favMocks.Repository) {
+				m.On("CountByProduct", mock.Anything).Return(counts, nil)
+				m.On("AllByProductIDs", mock.Anything, []int{2}).
+					Return([]favorite.Favorite{fav1, fav2}, nil)
+				m.On("Archive", mock.Anything, []favorite.Favorite{fav1, fav2}, archivedBySystem, archivedBySystem).
+					Return(nil)
+			},
+			setupProducts: func(m *prodMocks.Repository) {
+				m.On("FindMultiple", mock.Anything, []int{1, 2}).
+					Return([]product.Product{
+						fixtureProduct.AnyProduct().WithID(1).Build(),
+					}, &product.ErrProductsNotFound{IDs: []int{2}})
+			},
+			expectedArchived: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			repo := favMocks.NewRepository(t)
+			tc.setupRepo(repo)
+
+			products := prodMocks.NewRepository(t)
+			if tc.setupProducts != nil {
+				tc.setupProducts(products)
+			}
+
+			uc := usecase.NewArchiveUnavailableFavoritesUseCase(repo, products)
+
+			// Action
+			archived, err := uc.Execute(context.Background())
+
+			// Assert
+			if tc.expectedErr != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedArchived, archived)
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/domainerror"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/logger"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/product"
+)
+
+func findClientList(ctx context.Context, repo favorite.Reader, clientID, listID uuid.ID) (favorite.List, error) {
+	l, err := repo.FindList(ctx, clientID, listID)
+	if err != nil {
+		logger.ErrorF(ctx, "error while trying to find favorite list", logger.Fields{
+			"client_id": clientID,
+			"list_id":   listID,
+			"error":     err.Error(),
+		})
+
+		if _, ok := err.(*favorite.ErrListNotFound); ok {
+			return favorite.List{}, domainerror.New(domainerror.ResourceNotFound, "lista não encontrada", map[string]any{
+				"client_id": clientID,
+				"list_id":   listID,
+			})
+		}
+
+		return favorite.List{}, domainerror.Wrap(err, domainerror.DependecyError, "erro ao buscar lista", map[string]any{
+			"client_id": clientID,
+			"list_id":   listID,
+			"error":     err.Error(),
+		})
+	}
+
+	return l, nil
+}
+
+type TrashOptions struct {
+	Retention time.Duration
+}
+
+// trashCutoff returns the moment from which removed favorites are still in the trash
+func trashCutoff(opts TrashOptions) time.Time {
+	return time.Now().Add(-opts.Retention)
+}
+
+type BatchOptions struct {
+	MaxBatchSize int
+}
+
+func checkBatchSize(ids []int, opts BatchOptions) error {
+	if len(ids) <= opts.MaxBatchSize {
+		return nil
+	}
+
+	return domainerror.New(
+		domainerror.InvalidParams,
+		fmt.Sprintf("é permitido no máximo %d produtos por requisição", opts.MaxBatchSize),
+		map[string]any{
+			"products_count": len(ids),
+			"max_batch_size": opts.MaxBatchSize,
+		})
+}
+
+// uniqueIDs returns the ids without repetition, keeping the order, and the repeated ones
+func uniqueIDs(ids []int) ([]int, []int) {
+	unique := make([]int, 0, len(ids))
+	repeated := make([]int, 0)
+
+	for _, id := range ids {
+		if slices.Contains(unique, id) {
+			repeated = append(repeated, id)
+			continue
+		}
+
+		unique = append(unique, id)
+	}
+
+	return unique, repeated
+}
+
+// buildFavoriteItems joins the favorites with their products, favorites of products removed upstream get a placeholder
+func buildFavoriteItems(ctx context.Context, products product.Reader, fvs []favorite.Favorite) ([]dto.FavoriteItem, error) {
+	pIds := make([]int, 0, len(fvs))
+
+	for _, f := range fvs {
+		pIds = append(pIds, f.ProductID)
+	}
+
+	pds, err := products.FindMultiple(ctx, pIds)
+	if err != nil {
+		nfErr, ok := err.(*product.ErrProductsNotFound)
+		if !ok {
+			logger.ErrorF(ctx, "error while trying to get products", logger.Fields{
+				"error":       err.Error(),
+				"product_ids": pIds,
+			})
+
+			return []dto.FavoriteItem{}, domainerror.Wrap(err, domainerror.DependecyError, "erro ao buscar produtos", map[string]any{
+				"error":       err.Error(),
+				"product_ids": pIds,
+			})
+		}
+
+		logger.WarnF(ctx, "favorited products not found", logger.Fields{
+			"products_not_found": nfErr.IDs,
+		})
+	}
+
+	items := make([]dto.FavoriteItem, 0, len(fvs))
+	for _, f := range fvs {
+		idx := slices.IndexFunc(pds, func(pd product.Product) bool {
+			return pd.ID == f.ProductID
+		})
+		if idx < 0 {
+			items = append(items, dto.NewUnavailableFavoriteItem(f))
+			continue
+		}
+
+		items = append(items, dto.NewFavoriteItem(f, pds[idx]))
+	}
+
+	return items, nil
+}
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/domainerror"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/logger"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/trace"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+type createFavoriteListUseCase struct {
+	uuid uuid.Generator
+	repo favorite.Repository
+}
+
+func NewCreateFavoriteListUseCase(idGen uuid.Generator, repo favorite.Repository) favorites.CreateFavoriteListUseCase {
+	return &createFavoriteListUseCase{
+		uuid: idGen,
+		repo: repo,
+	}
+}
+
+func (u *createFavoriteListUseCase) Execute(ctx context.Context, p dto.CreateFavoriteListParams) (dto.FavoriteList, error) {
+	ctx, span := trace.NewSpan(ctx, "favorites.createFavoriteList")
+	defer span.End()
+
+	if err := p.Validate(); err != nil {
+		logger.ErrorF(ctx, "invalid params", logger.Fields{
+			"params": p,
+			"error":  err.Error(),
+		})
+
+		return dto.FavoriteList{}, err
+	}
+
+	l, err := favorite.NewList(u.uuid.NextID(), p.ClientID, p.Name)
+	if err != nil {
+		logger.ErrorF(ctx, "invalid favorite list params", logger.Fields{
+			"client_id": p.ClientID,
+			"name":      p.Name,
+			"error":     err.Error(),
+		})
+
+		return dto.FavoriteList{}, err
+	}
+
+	if err := u.repo.CreateList(ctx, l); err != nil {
+		logger.ErrorF(ctx, "error while trying to create favorite list", logger.Fields{
+			"client_id": p.ClientID,
+			"error":     err.Error(),
+		})
+
+		return dto.FavoriteList{}, domainerror.Wrap(err, domainerror.DependecyError, "erro ao criar lista", map[string]any{
+			"client_id": p.ClientID,
+			"error":     err.Error(),
+		})
+	}
+
+	return dto.ListFromDomain(l), nil
+}
@@ -0,0 +1,115 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	mocksFavorite "github.com/uesleicarvalhoo/aiqfome/favorite/mocks"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	fixtureDto "github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto/fixture"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/usecase"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	mocksUuid "github.com/uesleicarvalhoo/aiqfome/pkg/uuid/mocks"
+)
+
+func TestCreateFavoriteListUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	clientID := uuid.NextID()
+	listID := uuid.NextID()
+
+	paramsBuilder := fixtureDto.AnyCreateFavoriteListParams().
+		WithClientID(clientID).
+		WithName("Almoço")
+
+	testCases := []struct {
+		about        string
+		params       dto.CreateFavoriteListParams
+		setupIDGen   func(g *mocksUuid.Generator)
+		setupRepo    func(m *mocksFavorite.Repository)
+		expectedErr  string
+		expectedName string
+	}{
+		{
+			about:       "when params are invalid",
+			params:      dto.CreateFavoriteListParams{},
+			expectedErr: "[AQF002] clientId: campo obrigatório; name: campo obrigatório",
+		},
+		{
+			about:  "when name is only whitespaces",
+			params: paramsBuilder.WithName("   ").Build(),
+			setupIDGen: func(g *mocksUuid.Generator) {
+				g.On("NextID").Return(listID)
+			},
+			expectedErr: "[AQF002] name: campo obrigatório",
+		},
+		{
+			about:  "when repository CreateList fails",
+			params: paramsBuilder.Build(),
+			setupIDGen: func(g *mocksUuid.Generator) {
+				g.On("NextID").Return(listID)
+			},
+			setupRepo: func(m *mocksFavorite.Repository) {
+				m.On("CreateList", mock.Anything, mock.AnythingOfType("favorite.List")).
+					Return(errors.New("db error"))
+			},
+			expectedErr: "[AQF004] erro ao criar lista",
+		},
+		{
+			about:  "when all is valid",
+			params: paramsBuilder.Build(),
+			setupIDGen: func(g *mocksUuid.Generator) {
+				g.On("NextID").Return(listID)
+			},
+			setupRepo: func(m *mocksFavorite.Repository) {
+				m.On("CreateList", mock.Anything, mock.MatchedBy(func(l favorite.List) bool {
+					return l.ID == listID && l.ClientID == clientID && l.Name == "Almoço"
+				})).Return(nil)
+			},
+			expectedName: "Almoço",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			idGen := mocksUuid.NewGenerator(t)
+			if tc.setupIDGen != nil {
+				tc.setupIDGen(idGen)
+			}
+
+			repo := mocksFavorite.NewRepository(t)
+			if tc.setupRepo != nil {
+				tc.setupRepo(repo)
+			}
+
+			uc := usecase.NewCreateFavoriteListUseCase(idGen, repo)
+
+			// Action
+			res, err := uc.Execute(context.Background(), tc.params)
+
+			// Assert
+			if tc.expectedErr != "" {
+				assert.Equal(t, dto.FavoriteList{}, res)
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, listID, res.ID)
+				assert.Equal(t, tc.expectedName, res.Name)
+			}
+
+			idGen.AssertExpectations(t)
+			repo.AssertExpectations(t)
+		})
+	}
+}
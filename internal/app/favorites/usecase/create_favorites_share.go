@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/domainerror"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/logger"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/trace"
+)
+
+type createFavoritesShareUseCase struct {
+	repo favorite.Repository
+}
+
+func NewCreateFavoritesShareUseCase(repo favorite.Repository) favorites.CreateFavoritesShareUseCase {
+	return &createFavoritesShareUseCase{
+		repo: repo,
+	}
+}
+
+func (u *createFavoritesShareUseCase) Execute(ctx context.Context, p dto.CreateFavoritesShareParams) (dto.FavoritesShare, error) {
+	ctx, span := trace.NewSpan(ctx, "favorites.createFavoritesShare")
+	defer span.End()
+
+	if err := p.Validate(); err != nil {
+		logger.ErrorF(ctx, "invalid params", logger.Fields{
+			"params": p,
+			"error":  err.Error(),
+		})
+
+		return dto.FavoritesShare{}, err
+	}
+
+	s, err := favorite.NewShare(p.ClientID, p.ExpiresAt)
+	if err != nil {
+		logger.ErrorF(ctx, "invalid share params", logger.Fields{
+			"params": p,
+			"error":  err.Error(),
+		})
+
+		return dto.FavoritesShare{}, err
+	}
+
+	if err := u.repo.CreateShare(ctx, s); err != nil {
+		logger.ErrorF(ctx, "error while trying to create favorites share", logger.Fields{
+			"client_id": p.ClientID,
+			"error":     err.Error(),
+		})
+
+		return dto.FavoritesShare{}, domainerror.Wrap(err, domainerror.DependecyError, "erro ao criar link de compartilhamento", map[string]any{
+			"client_id": p.ClientID,
+			"error":     err.Error(),
+		})
+	}
+
+	return dto.ShareFromDomain(s), nil
+}
@@ -0,0 +1,98 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	mocksFavorite "github.com/uesleicarvalhoo/aiqfome/favorite/mocks"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	fixtureDto "github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto/fixture"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/usecase"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/test"
+)
+
+func TestCreateFavoritesShareUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	clientID := uuid.NextID()
+	expiresAt := time.Now().Add(24 * time.Hour)
+
+	paramsBuilder := fixtureDto.AnyCreateFavoritesShareParams().WithClientID(clientID)
+
+	testCases := []struct {
+		about       string
+		params      dto.CreateFavoritesShareParams
+		setupRepo   func(m *mocksFavorite.Repository)
+		expectedErr string
+	}{
+		{
+			about:       "when params are invalid",
+			params:      dto.CreateFavoritesShareParams{},
+			expectedErr: "[AQF002] clientId: campo obrigatório",
+		},
+		{
+			about:       "when expiresAt is in the past",
+			params:      paramsBuilder.WithExpiresAt(test.Ptr(time.Now().Add(-time.Hour))).Build(),
+			expectedErr: "[AQF002] expiresAt: deve ser uma data futura",
+		},
+		{
+			about:  "when repository fails",
+			params: paramsBuilder.Build(),
+			setupRepo: func(m *mocksFavorite.Repository) {
+				m.On("CreateShare", mock.Anything, mock.AnythingOfType("favorite.Share")).
+					Return(errors.New("db error"))
+			},
+			expectedErr: "[AQF004] erro ao criar link de compartilhamento",
+		},
+		{
+			about:  "when all is valid",
+			params: paramsBuilder.WithExpiresAt(&expiresAt).Build(),
+			setupRepo: func(m *mocksFavorite.Repository) {
+				m.On("CreateShare", mock.Anything, mock.MatchedBy(func(s favorite.Share) bool {
+					return s.ClientID == clientID && s.Token != "" && s.ExpiresAt.Equal(expiresAt)
+				})).Return(nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			repo := mocksFavorite.NewRepository(t)
+			if tc.setupRepo != nil {
+				tc.setupRepo(repo)
+			}
+
+			uc := usecase.NewCreateFavoritesShareUseCase(repo)
+
+			// Action
+			res, err := uc.Execute(context.Background(), tc.params)
+
+			// Assert
+			if tc.expectedErr != "" {
+				assert.Equal(t, dto.FavoritesShare{}, res)
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.NotEmpty(t, res.Token)
+				assert.Equal(t, "/shared/"+res.Token, res.Path)
+				assert.Equal(t, &expiresAt, res.ExpiresAt)
+				assert.False(t, res.Expired)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
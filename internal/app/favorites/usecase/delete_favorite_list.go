@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/domainerror"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/logger"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/trace"
+)
+
+type deleteFavoriteListUseCase struct {
+	repo favorite.Repository
+}
+
+func NewDeleteFavoriteListUseCase(repo favorite.Repository) favorites.DeleteFavoriteListUseCase {
+	return &deleteFavoriteListUseCase{
+		repo: repo,
+	}
+}
+
+func (u *deleteFavoriteListUseCase) Execute(ctx context.Context, p dto.DeleteFavoriteListParams) error {
+	ctx, span := trace.NewSpan(ctx, "favorites.deleteFavoriteList")
+	defer span.End()
+
+	if err := p.Validate(); err != nil {
+		logger.ErrorF(ctx, "invalid params", logger.Fields{
+			"params": p,
+			"error":  err.Error(),
+		})
+
+		return err
+	}
+
+	l, err := findClientList(ctx, u.repo, p.ClientID, p.ListID)
+	if err != nil {
+		return err
+	}
+
+	if err := u.repo.DeleteList(ctx, l); err != nil {
+		logger.ErrorF(ctx, "error while trying to delete favorite list", logger.Fields{
+			"list_id": p.ListID,
+			"error":   err.Error(),
+		})
+
+		return domainerror.Wrap(err, domainerror.DependecyError, "erro ao remover lista", map[string]any{
+			"client_id": p.ClientID,
+			"list_id":   p.ListID,
+			"error":     err.Error(),
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	fixtureFavorite "github.com/uesleicarvalhoo/aiqfome/favorite/fixture"
+	mocksFavorite "github.com/uesleicarvalhoo/aiqfome/favorite/mocks"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	fixtureDto "github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto/fixture"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/usecase"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+func TestDeleteFavoriteListUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	clientID := uuid.NextID()
+	listID := uuid.NextID()
+
+	paramsBuilder := fixtureDto.AnyDeleteFavoriteListParams().
+		WithClientID(clientID).
+		WithListID(listID)
+
+	list := fixtureFavorite.AnyList().
+		WithID(listID).
+		WithClientID(clientID).
+		Build()
+
+	testCases := []struct {
+		about       string
+		params      dto.DeleteFavoriteListParams
+		setupRepo   func(m *mocksFavorite.Repository)
+		expectedErr string
+	}{
+		{
+			about:       "when params are invalid",
+			params:      dto.DeleteFavoriteListParams{},
+			expectedErr: "[AQF002] clientId: campo obrigatório; listId: campo obrigatório",
+		},
+		{
+			about:  "when list not found",
+			params: paramsBuilder.Build(),
+			setupRepo: func(m *mocksFavorite.Repository) {
+				m.On("FindList", mock.Anything, clientID, listID).
+					Return(favorite.List{}, &favorite.ErrListNotFound{ClientID: clientID, ListID: listID})
+			},
+			expectedErr: "[AQF003] lista não encontrada",
+		},
+		{
+			about:  "when delete fails",
+			params: paramsBuilder.Build(),
+			setupRepo: func(m *mocksFavorite.Repository) {
+				m.On("FindList", mock.Anything, clientID, listID).
+					Return(list, nil)
+				m.On("DeleteList", mock.Anything, list).
+					Return(errors.New("db error"))
+			},
+			expectedErr: "[AQF004] erro ao remover lista",
+		},
+		{
+			about:  "when all is valid",
+			params: paramsBuilder.Build(),
+			setupRepo: func(m *mocksFavorite.Repository) {
+				m.On("FindList", mock.Anything, clientID, listID).
+					Return(list, nil)
+				m.On("DeleteList", mock.Anything, list).
+					Return(nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			repo := mocksFavorite.NewRepository(t)
+			if tc.setupRepo != nil {
+				tc.setupRepo(repo)
+			}
+
+			uc := usecase.NewDeleteFavoriteListUseCase(repo)
+
+			// Action
+			err := uc.Execute(context.Background(), tc.params)
+
+			// Assert
+			if tc.expectedErr != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/uesleicarvalhoo/aiqfome/event"
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	appContext "github.com/uesleicarvalhoo/aiqfome/internal/app/context"
+)
+
+// favoriteEvents returns an event of the given type for each favorite, acted by the authenticated user.
+// Without one in the context the events are taken as made by the client itself
+func favoriteEvents(ctx context.Context, t event.Type, ff ...favorite.Favorite) []event.Event {
+	ee := favorite.Events(t, ff)
+
+	usr, err := appContext.GetClient(ctx)
+	if err != nil {
+		return ee
+	}
+
+	for i := range ee {
+		ee[i] = ee[i].WithActor(usr.ID)
+	}
+
+	return ee
+}
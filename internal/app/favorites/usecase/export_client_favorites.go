@@ -0,0 +1,129 @@
+package usecase
+
+import (
+	"context"
+	"io"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/domainerror"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/logger"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/trace"
+	"github.com/uesleicarvalhoo/aiqfome/product"
+)
+
+type ExportOptions struct {
+	BatchSize int
+}
+
+type exportClientFavoritesUseCase struct {
+	favorites favorite.Reader
+	products  product.Reader
+	opts      ExportOptions
+}
+
+func NewExportClientFavoritesUseCase(favoritesRepo favorite.Reader, productsRepo product.Reader, opts ExportOptions) favorites.ExportClientFavoritesUseCase {
+	return &exportClientFavoritesUseCase{
+		favorites: favoritesRepo,
+		products:  productsRepo,
+		opts:      opts,
+	}
+}
+
+func (u *exportClientFavoritesUseCase) Execute(ctx context.Context, p dto.ExportFavoritesParams, w io.Writer) error {
+	ctx, span := trace.NewSpan(ctx, "favorites.exportClientFavorites")
+	defer span.End()
+
+	if err := p.Validate(); err != nil {
+		logger.ErrorF(ctx, "invalid params", logger.Fields{
+			"params": p,
+			"error":  err.Error(),
+		})
+
+		return err
+	}
+
+	enc := newExportEncoder(p.Format, w)
+	if err := enc.Begin(); err != nil {
+		return err
+	}
+
+	// The favorites are read by keyset, one batch at time, so rows added or removed while exporting don't shift the result
+	cursor := favorite.Cursor{Direction: favorite.DirectionNext}
+	for {
+		fvs, err := u.favorites.ScrollByClientID(ctx, p.ClientID, favorite.Filter{}, cursor, u.opts.BatchSize)
+		if err != nil {
+			logger.ErrorF(ctx, "error while trying to scroll favorites", logger.Fields{
+				"client_id": p.ClientID,
+				"error":     err.Error(),
+			})
+
+			return domainerror.Wrap(err, domainerror.DependecyError, "erro ao exportar favoritos", map[string]any{
+				"client_id": p.ClientID,
+				"error":     err.Error(),
+			})
+		}
+
+		if len(fvs) == 0 {
+			break
+		}
+
+		if err := u.encodeBatch(ctx, enc, fvs); err != nil {
+			return err
+		}
+
+		if len(fvs) < u.opts.BatchSize {
+			break
+		}
+
+		cursor = favorite.NewCursor(fvs[len(fvs)-1], favorite.DirectionNext)
+	}
+
+	return enc.End()
+}
+
+func (u *exportClientFavoritesUseCase) encodeBatch(ctx context.Context, enc exportEncoder, fvs []favorite.Favorite) error {
+	ids := make([]int, 0, len(fvs))
+	for _, f := range fvs {
+		ids = append(ids, f.ProductID)
+	}
+
+	pp, err := u.products.FindMultiple(ctx, ids)
+	if err != nil {
+		nfErr, ok := err.(*product.ErrProductsNotFound)
+		if !ok {
+			logger.ErrorF(ctx, "error while trying to get products", logger.Fields{
+				"error":       err.Error(),
+				"product_ids": ids,
+			})
+
+			return domainerror.Wrap(err, domainerror.DependecyError, "erro ao buscar produtos", map[string]any{
+				"error":       err.Error(),
+				"product_ids": ids,
+			})
+		}
+
+		logger.WarnF(ctx, "exporting favorites of products not found", logger.Fields{
+			"products_not_found": nfErr.IDs,
+		})
+	}
+
+	pds := make(map[int]product.Product, len(pp))
+	for _, pd := range pp {
+		pds[pd.ID] = pd
+	}
+
+	for _, f := range fvs {
+		var pd *product.Product
+		if found, ok := pds[f.ProductID]; ok {
+			pd = &found
+		}
+
+		if err := enc.Encode(dto.NewExportedFavorite(f, pd)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,160 @@
+package usecase_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	fixtureFavorite "github.com/uesleicarvalhoo/aiqfome/favorite/fixture"
+	mocksFavorite "github.com/uesleicarvalhoo/aiqfome/favorite/mocks"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	fixtureDto "github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto/fixture"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/usecase"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/product"
+	fixtureProd "github.com/uesleicarvalhoo/aiqfome/product/fixture"
+	mocksProduct "github.com/uesleicarvalhoo/aiqfome/product/mocks"
+)
+
+func TestExportClientFavoritesUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	clientID := uuid.NextID()
+	registredAt := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	paramsBuilder := fixtureDto.AnyExportFavoritesParams().WithClientID(clientID)
+	favoriteBuilder := fixtureFavorite.AnyFavorite().WithClientID(clientID).WithRegistredAt(registredAt)
+	productBuilder := fixtureProd.AnyProduct().WithCategory("electronics")
+
+	first := favoriteBuilder.WithProductID(1).WithNote("presente").WithTags([]string{"natal", "casa"}).WithSnapshot("Old title", 10).Build()
+	second := favoriteBuilder.WithProductID(2).Build()
+	third := favoriteBuilder.WithProductID(3).WithSnapshot("Removed product", 5.5).Build()
+
+	scrollAll := func(m *mocksFavorite.Repository) {
+		m.On("ScrollByClientID", mock.Anything, clientID, favorite.Filter{}, favorite.Cursor{Direction: favorite.DirectionNext}, 2).
+			Return([]favorite.Favorite{first, second}, nil)
+		m.On("ScrollByClientID", mock.Anything, clientID, favorite.Filter{}, favorite.NewCursor(second, favorite.DirectionNext), 2).
+			Return([]favorite.Favorite{third}, nil)
+	}
+
+	findAll := func(m *mocksProduct.Reader) {
+		m.On("FindMultiple", mock.Anything, []int{1, 2}).
+			Return([]product.Product{
+				productBuilder.WithID(1).WithTitle("Phone").WithPrice(8).Build(),
+				productBuilder.WithID(2).WithTitle("Tablet").WithPrice(20).Build(),
+			}, nil)
+		m.On("FindMultiple", mock.Anything, []int{3}).
+			Return([]product.Product{}, &product.ErrProductsNotFound{IDs: []int{3}})
+	}
+
+	testCases := []struct {
+		about          string
+		params         dto.ExportFavoritesParams
+		setupFavorites func(m *mocksFavorite.Repository)
+		setupProducts  func(m *mocksProduct.Reader)
+		expectedErr    string
+		expectedOutput string
+	}{
+		{
+			about:       "when params are invalid",
+			params:      dto.ExportFavoritesParams{Format: "xml"},
+			expectedErr: "[AQF002] clientId: campo obrigatório; format: deve ser csv ou json",
+		},
+		{
+			about:  "when scroll fails",
+			params: paramsBuilder.Build(),
+			setupFavorites: func(m *mocksFavorite.Repository) {
+				m.On("ScrollByClientID", mock.Anything, clientID, favorite.Filter{}, favorite.Cursor{Direction: favorite.DirectionNext}, 2).
+					Return([]favorite.Favorite{}, errors.New("db error"))
+			},
+			expectedErr: "[AQF004] erro ao exportar favoritos",
+		},
+		{
+			about:  "when product reader fails",
+			params: paramsBuilder.Build(),
+			setupFavorites: func(m *mocksFavorite.Repository) {
+				m.On("ScrollByClientID", mock.Anything, clientID, favorite.Filter{}, favorite.Cursor{Direction: favorite.DirectionNext}, 2).
+					Return([]favorite.Favorite{first, second}, nil)
+			},
+			setupProducts: func(m *mocksProduct.Reader) {
+				m.On("FindMultiple", mock.Anything, []int{1, 2}).
+					Return([]product.Product{}, errors.New("service down"))
+			},
+			expectedErr: "[AQF004] erro ao buscar produtos",
+		},
+		{
+			about:  "when client has no favorites",
+			params: paramsBuilder.WithFormat(dto.ExportJSON).Build(),
+			setupFavorites: func(m *mocksFavorite.Repository) {
+				m.On("ScrollByClientID", mock.Anything, clientID, favorite.Filter{}, favorite.Cursor{Direction: favorite.DirectionNext}, 2).
+					Return([]favorite.Favorite{}, nil)
+			},
+			expectedOutput: "[]",
+		},
+		{
+			about:          "when format is csv",
+			params:         paramsBuilder.WithFormat(dto.ExportCSV).Build(),
+			setupFavorites: scrollAll,
+			setupProducts:  findAll,
+			expectedOutput: "product_id,title,price,category,note,tags,price_when_favorited,registred_at\n" +
+				"1,Phone,8.00,electronics,presente,natal|casa,10.00,2026-10-01T12:00:00Z\n" +
+				"2,Tablet,20.00,electronics,,,,2026-10-01T12:00:00Z\n" +
+				"3,Removed product,,,,,5.50,2026-10-01T12:00:00Z\n",
+		},
+		{
+			about:          "when format is json",
+			params:         paramsBuilder.WithFormat(dto.ExportJSON).Build(),
+			setupFavorites: scrollAll,
+			setupProducts:  findAll,
+			expectedOutput: `[` +
+				`{"productId":1,"title":"Phone","price":8,"category":"electronics","note":"presente","tags":["natal","casa"],"priceWhenFavorited":10,"registredAt":"2026-10-01T12:00:00Z"},` +
+				`{"productId":2,"title":"Tablet","price":20,"category":"electronics","note":"","tags":[],"priceWhenFavorited":null,"registredAt":"2026-10-01T12:00:00Z"},` +
+				`{"productId":3,"title":"Removed product","price":null,"category":"","note":"","tags":[],"priceWhenFavorited":5.5,"registredAt":"2026-10-01T12:00:00Z"}` +
+				`]`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			favRepo := mocksFavorite.NewRepository(t)
+			if tc.setupFavorites != nil {
+				tc.setupFavorites(favRepo)
+			}
+
+			prodReader := mocksProduct.NewReader(t)
+			if tc.setupProducts != nil {
+				tc.setupProducts(prodReader)
+			}
+
+			uc := usecase.NewExportClientFavoritesUseCase(favRepo, prodReader, usecase.ExportOptions{BatchSize: 2})
+
+			var out bytes.Buffer
+
+			// Action
+			err := uc.Execute(context.Background(), tc.params, &out)
+
+			// Assert
+			if tc.expectedErr != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedOutput, out.String())
+			}
+
+			favRepo.AssertExpectations(t)
+			prodReader.AssertExpectations(t)
+		})
+	}
+}
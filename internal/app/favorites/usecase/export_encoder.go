@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+)
+
+// exportEncoder writes the exported favorites one by one, so the export is never fully kept in memory
+type exportEncoder interface {
+	Begin() error
+	Encode(e dto.ExportedFavorite) error
+	End() error
+}
+
+func newExportEncoder(format dto.ExportFormat, w io.Writer) exportEncoder {
+	if format == dto.ExportCSV {
+		return &csvExportEncoder{w: csv.NewWriter(w)}
+	}
+
+	return &jsonExportEncoder{w: w}
+}
+
+type csvExportEncoder struct {
+	w *csv.Writer
+}
+
+func (e *csvExportEncoder) Begin() error {
+	return e.w.Write(dto.ExportCSVHeader)
+}
+
+func (e *csvExportEncoder) Encode(f dto.ExportedFavorite) error {
+	return e.w.Write(f.CSVRecord())
+}
+
+func (e *csvExportEncoder) End() error {
+	e.w.Flush()
+
+	return e.w.Error()
+}
+
+type jsonExportEncoder struct {
+	w       io.Writer
+	written int
+}
+
+func (e *jsonExportEncoder) Begin() error {
+	_, err := io.WriteString(e.w, "[")
+
+	return err
+}
+
+func (e *jsonExportEncoder) Encode(f dto.ExportedFavorite) error {
+	if e.written > 0 {
+		if _, err := io.WriteString(e.w, ","); err != nil {
+			return err
+		}
+	}
+
+	data, err := json.Marshal(f)
+	if err != nil {
+		return err
+	}
+
+	if _, err := e.w.Write(data); err != nil {
+		return err
+	}
+
+	e.written++
+
+	return nil
+}
+
+func (e *jsonExportEncoder) End() error {
+	_, err := io.WriteString(e.w, "]")
+
+	return err
+}
@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/domainerror"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/logger"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/trace"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+type getClientFavoriteListsUseCase struct {
+	repo favorite.Repository
+}
+
+func NewGetClientFavoriteListsUseCase(repo favorite.Repository) favorites.GetClientFavoriteListsUseCase {
+	return &getClientFavoriteListsUseCase{
+		repo: repo,
+	}
+}
+
+func (u *getClientFavoriteListsUseCase) Execute(ctx context.Context, clientID uuid.ID) (dto.ClientFavoriteLists, error) {
+	ctx, span := trace.NewSpan(ctx, "favorites.getClientFavoriteLists")
+	defer span.End()
+
+	ll, err := u.repo.ListsByClientID(ctx, clientID)
+	if err != nil {
+		logger.ErrorF(ctx, "error while trying to list favorite lists", logger.Fields{
+			"client_id": clientID,
+			"error":     err.Error(),
+		})
+
+		return dto.ClientFavoriteLists{}, domainerror.Wrap(err, domainerror.DependecyError, "erro ao buscar listas", map[string]any{
+			"client_id": clientID,
+			"error":     err.Error(),
+		})
+	}
+
+	lists := make([]dto.FavoriteList, 0, len(ll))
+	for _, l := range ll {
+		lists = append(lists, dto.ListFromDomain(l))
+	}
+
+	return dto.ClientFavoriteLists{
+		ClientID: clientID,
+		Lists:    lists,
+	}, nil
+}
@@ -0,0 +1,98 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	fixtureFavorite "github.com/uesleicarvalhoo/aiqfome/favorite/fixture"
+	mocksFavorite "github.com/uesleicarvalhoo/aiqfome/favorite/mocks"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/usecase"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+func TestGetClientFavoriteListsUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	clientID := uuid.NextID()
+
+	lunch := fixtureFavorite.AnyList().WithClientID(clientID).WithName("Almoço").Build()
+	gifts := fixtureFavorite.AnyList().WithClientID(clientID).WithName("Presentes").Build()
+
+	testCases := []struct {
+		about          string
+		setupRepo      func(m *mocksFavorite.Repository)
+		expectedErr    string
+		expectedResult dto.ClientFavoriteLists
+	}{
+		{
+			about: "when repository fails",
+			setupRepo: func(m *mocksFavorite.Repository) {
+				m.On("ListsByClientID", mock.Anything, clientID).
+					Return([]favorite.List{}, errors.New("db error"))
+			},
+			expectedErr: "[AQF004] erro ao buscar listas",
+		},
+		{
+			about: "when client has no lists",
+			setupRepo: func(m *mocksFavorite.Repository) {
+				m.On("ListsByClientID", mock.Anything, clientID).
+					Return([]favorite.List{}, nil)
+			},
+			expectedResult: dto.ClientFavoriteLists{
+				ClientID: clientID,
+				Lists:    []dto.FavoriteList{},
+			},
+		},
+		{
+			about: "when all is valid",
+			setupRepo: func(m *mocksFavorite.Repository) {
+				m.On("ListsByClientID", mock.Anything, clientID).
+					Return([]favorite.List{lunch, gifts}, nil)
+			},
+			expectedResult: dto.ClientFavoriteLists{
+				ClientID: clientID,
+				Lists: []dto.FavoriteList{
+					dto.ListFromDomain(lunch),
+					dto.ListFromDomain(gifts),
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			repo := mocksFavorite.NewRepository(t)
+			if tc.setupRepo != nil {
+				tc.setupRepo(repo)
+			}
+
+			uc := usecase.NewGetClientFavoriteListsUseCase(repo)
+
+			// Action
+			res, err := uc.Execute(context.Background(), clientID)
+
+			// Assert
+			if tc.expectedErr != "" {
+				assert.Equal(t, dto.ClientFavoriteLists{}, res)
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedResult, res)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
@@ -1,7 +1,10 @@
 package usecase
 
 import (
+	"cmp"
 	"context"
+	"slices"
+	"strings"
 
 	"github.com/uesleicarvalhoo/aiqfome/favorite"
 	usecase "github.com/uesleicarvalhoo/aiqfome/internal/app/favorites"
@@ -41,7 +44,32 @@ func (u *getClientFavoritesUseCase) Execute(ctx context.Context, p dto.GetClient
 		return dto.ClientFavorites{}, err
 	}
 
-	fvs, total, err := u.favorites.PaginateByClientID(ctx, p.ClientID, p.Page, p.PageSize)
+	filter := favorite.Filter{
+		Tag:  favorite.NormalizeTag(p.Tag),
+		Desc: p.Order == dto.OrderDesc,
+	}
+
+	switch p.Sort {
+	case dto.SortRegisteredAt:
+		filter.Order = favorite.OrderByRegistredAt
+	case dto.SortManual:
+		filter.Order = favorite.OrderByPosition
+	}
+
+	if p.UsesCursor() {
+		return u.scroll(ctx, p, filter)
+	}
+
+	// Product data lives upstream, so sorting and filtering by it can only be done after loading all favorites
+	if p.UsesProductAttributes() {
+		return u.paginateByProducts(ctx, p, filter)
+	}
+
+	return u.paginate(ctx, p, filter)
+}
+
+func (u *getClientFavoritesUseCase) paginate(ctx context.Context, p dto.GetClientFavoritesParams, filter favorite.Filter) (dto.ClientFavorites, error) {
+	fvs, total, err := u.favorites.PaginateByClientID(ctx, p.ClientID, filter, p.Page, p.PageSize)
 	if err != nil {
 		logger.ErrorF(ctx, "error while trying to paginate favorites", logger.Fields{
 			"error": err.Error(),
@@ -52,13 +80,7 @@ func (u *getClientFavoritesUseCase) Execute(ctx context.Context, p dto.GetClient
 		})
 	}
 
-	pIds := make([]int, 0, len(fvs))
-
-	for _, f := range fvs {
-		pIds = append(pIds, f.ProductID)
-	}
-
-	pds, err := u.getProducts(ctx, pIds)
+	items, err := buildFavoriteItems(ctx, u.products, fvs)
 	if err != nil {
 		return dto.ClientFavorites{}, err
 	}
@@ -67,35 +89,179 @@ func (u *getClientFavoritesUseCase) Execute(ctx context.Context, p dto.GetClient
 
 	return dto.ClientFavorites{
 		ClientID: p.ClientID,
-		Products: pds,
+		Products: items,
 		Total:    total,
 		Pages:    pages,
+		Warnings: dto.FavoriteItemsWarnings(items),
 	}, nil
 }
 
-func (u *getClientFavoritesUseCase) getProducts(ctx context.Context, ids []int) ([]product.Product, error) {
-	pp, err := u.products.FindMultiple(ctx, ids)
+func (u *getClientFavoritesUseCase) paginateByProducts(ctx context.Context, p dto.GetClientFavoritesParams, filter favorite.Filter) (dto.ClientFavorites, error) {
+	fvs, err := u.favorites.AllByClientID(ctx, p.ClientID, filter)
 	if err != nil {
-		if nfErr, ok := err.(*product.ErrProductsNotFound); ok {
-			logger.ErrorF(ctx, "products not found", logger.Fields{
-				"products_not_found": nfErr.IDs,
-			})
+		logger.ErrorF(ctx, "error while trying to list favorites", logger.Fields{
+			"error": err.Error(),
+		})
+		return dto.ClientFavorites{}, domainerror.Wrap(err, domainerror.DependecyError, "erro ao paginar favoritos", map[string]any{
+			"error":  err.Error(),
+			"params": p,
+		})
+	}
 
-			return []product.Product{}, domainerror.Wrap(err, domainerror.ResourceNotFound, "produtos não encontrados", map[string]any{
-				"products_not_found": nfErr.IDs,
+	items := []dto.FavoriteItem{}
+	if len(fvs) > 0 {
+		items, err = buildFavoriteItems(ctx, u.products, fvs)
+		if err != nil {
+			return dto.ClientFavorites{}, err
+		}
+	}
+
+	items = slices.DeleteFunc(items, func(i dto.FavoriteItem) bool {
+		return !matchProductFilters(i, p)
+	})
+
+	sortItems(items, p.Sort, p.Order)
+
+	total := len(items)
+	start := min(p.Page*p.PageSize, total)
+	end := min(start+p.PageSize, total)
+
+	return dto.ClientFavorites{
+		ClientID: p.ClientID,
+		Products: items[start:end],
+		Total:    total,
+		Pages:    (total + p.PageSize - 1) / p.PageSize,
+		Warnings: dto.FavoriteItemsWarnings(items[start:end]),
+	}, nil
+}
+
+func (u *getClientFavoritesUseCase) scroll(ctx context.Context, p dto.GetClientFavoritesParams, filter favorite.Filter) (dto.ClientFavorites, error) {
+	cursor := favorite.Cursor{Direction: favorite.DirectionNext}
+	if p.Cursor != "" {
+		c, err := favorite.DecodeCursor(p.Cursor)
+		if err != nil {
+			return dto.ClientFavorites{}, domainerror.Wrap(err, domainerror.InvalidParams, "cursor inválido", map[string]any{
+				"cursor": p.Cursor,
 			})
 		}
 
-		logger.ErrorF(ctx, "error while trying to get products", logger.Fields{
-			"error":       err.Error(),
-			"product_ids": ids,
-		})
+		cursor = c
+	}
 
-		return []product.Product{}, domainerror.Wrap(err, domainerror.DependecyError, "erro ao buscar produtos", map[string]any{
-			"error":       err.Error(),
-			"product_ids": ids,
+	// One more favorite is requested only to know if there is another page after this one
+	fvs, err := u.favorites.ScrollByClientID(ctx, p.ClientID, filter, cursor, p.PageSize+1)
+	if err != nil {
+		logger.ErrorF(ctx, "error while trying to scroll favorites", logger.Fields{
+			"error": err.Error(),
+		})
+		return dto.ClientFavorites{}, domainerror.Wrap(err, domainerror.DependecyError, "erro ao paginar favoritos", map[string]any{
+			"error":  err.Error(),
+			"params": p,
 		})
 	}
 
-	return pp, nil
+	hasMore := len(fvs) > p.PageSize
+	if hasMore {
+		if cursor.Direction == favorite.DirectionPrev {
+			fvs = fvs[1:]
+		} else {
+			fvs = fvs[:p.PageSize]
+		}
+	}
+
+	items, err := buildFavoriteItems(ctx, u.products, fvs)
+	if err != nil {
+		return dto.ClientFavorites{}, err
+	}
+
+	res := dto.ClientFavorites{
+		ClientID: p.ClientID,
+		Products: items,
+		Warnings: dto.FavoriteItemsWarnings(items),
+	}
+
+	if len(fvs) == 0 {
+		return res, nil
+	}
+
+	first, last := fvs[0], fvs[len(fvs)-1]
+
+	if cursor.Direction == favorite.DirectionPrev {
+		res.NextCursor = favorite.NewCursor(last, favorite.DirectionNext).Encode()
+		if hasMore {
+			res.PrevCursor = favorite.NewCursor(first, favorite.DirectionPrev).Encode()
+		}
+
+		return res, nil
+	}
+
+	if hasMore {
+		res.NextCursor = favorite.NewCursor(last, favorite.DirectionNext).Encode()
+	}
+
+	if !cursor.IsZero() {
+		res.PrevCursor = favorite.NewCursor(first, favorite.DirectionPrev).Encode()
+	}
+
+	return res, nil
+}
+
+func matchProductFilters(i dto.FavoriteItem, p dto.GetClientFavoritesParams) bool {
+	// Nothing is known about unavailable products, so they never match a filter by product
+	if i.Unavailable {
+		return !p.FiltersByProduct()
+	}
+
+	pd := i.Product
+
+	if p.PriceDropped && !i.PriceDropped() {
+		return false
+	}
+
+	if p.Category != "" && !strings.EqualFold(pd.Category, p.Category) {
+		return false
+	}
+
+	if p.MinPrice != nil && pd.Price < *p.MinPrice {
+		return false
+	}
+
+	if p.MaxPrice != nil && pd.Price > *p.MaxPrice {
+		return false
+	}
+
+	if p.MinRating != nil && pd.Rating.Rate < *p.MinRating {
+		return false
+	}
+
+	return true
+}
+
+func sortItems(items []dto.FavoriteItem, field dto.SortField, order dto.SortOrder) {
+	slices.SortStableFunc(items, func(a, b dto.FavoriteItem) int {
+		var c int
+
+		switch field {
+		case dto.SortRegisteredAt:
+			c = a.RegistredAt.Compare(b.RegistredAt)
+		case dto.SortPrice:
+			c = cmp.Compare(a.Price, b.Price)
+		case dto.SortTitle:
+			c = strings.Compare(strings.ToLower(a.Title), strings.ToLower(b.Title))
+		case dto.SortRating:
+			c = cmp.Compare(a.Rating.Rate, b.Rating.Rate)
+		case dto.SortManual:
+			c = cmp.Compare(a.Position, b.Position)
+		}
+
+		if c == 0 {
+			c = cmp.Compare(a.ID, b.ID)
+		}
+
+		if order == dto.OrderDesc {
+			return -c
+		}
+
+		return c
+	})
 }
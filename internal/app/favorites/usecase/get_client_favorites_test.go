@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -18,6 +19,7 @@ import (
 	"github.com/uesleicarvalhoo/aiqfome/product"
 	fixtureProduct "github.com/uesleicarvalhoo/aiqfome/product/fixture"
 	prodMocks "github.com/uesleicarvalhoo/aiqfome/product/mocks"
+	"github.com/uesleicarvalhoo/aiqfome/test"
 )
 
 func TestGetClientFavoritesUseCase_Execute(t *testing.T) {
@@ -32,6 +34,24 @@ func TestGetClientFavoritesUseCase_Execute(t *testing.T) {
 
 	productBuilder := fixtureProduct.AnyProduct()
 
+	now := time.Now()
+	fav1 := favoriteBuilder.WithProductID(1).WithRegistredAt(now).Build()
+	fav2 := favoriteBuilder.WithProductID(2).WithRegistredAt(now.Add(-time.Minute)).Build()
+	fav3 := favoriteBuilder.WithProductID(3).WithRegistredAt(now.Add(-time.Hour)).Build()
+	fav4 := favoriteBuilder.WithProductID(4).WithRegistredAt(now.Add(-2 * time.Hour)).Build()
+
+	electronicBuilder := productBuilder.
+		WithCategory("electronics").
+		WithRating(product.Rating{Rate: 4, Count: 10})
+
+	cursorParamsBuilder := paramsBuilder.
+		WithPage(0).
+		WithPageSize(2).
+		WithMode(dto.PaginationCursor)
+
+	cursorNext := favorite.NewCursor(fav1, favorite.DirectionNext)
+	cursorPrev := favorite.NewCursor(fav3, favorite.DirectionPrev)
+
 	testCases := []struct {
 		about          string
 		params         dto.GetClientFavoritesParams
@@ -49,29 +69,71 @@ func TestGetClientFavoritesUseCase_Execute(t *testing.T) {
 			about:  "when favorites paginate fails",
 			params: paramsBuilder.Build(),
 			setupFavorites: func(m *favMocks.Repository) {
-				m.On("PaginateByClientID", mock.Anything, clientID, 1, 20).
+				m.On("PaginateByClientID", mock.Anything, clientID, favorite.Filter{}, 1, 20).
 					Return([]favorite.Favorite{}, 0, errors.New("db error"))
 			},
 			expectedErr: "erro ao paginar favoritos",
 		},
 		{
-			about:  "when getProducts returns not found",
+			about:  "when some products were removed upstream",
 			params: paramsBuilder.Build(),
 			setupFavorites: func(m *favMocks.Repository) {
-				m.On("PaginateByClientID", mock.Anything, clientID, 1, 20).
-					Return([]favorite.Favorite{favoriteBuilder.Build()}, 1, nil)
+				m.On("PaginateByClientID", mock.Anything, clientID, favorite.Filter{}, 1, 20).
+					Return([]favorite.Favorite{
+						favoriteBuilder.WithProductID(1).Build(),
+						favoriteBuilder.WithProductID(2).Build(),
+					}, 2, nil)
 			},
 			setupProducts: func(m *prodMocks.Repository) {
-				m.On("FindMultiple", mock.Anything, []int{1}).
-					Return([]product.Product{}, &product.ErrProductsNotFound{IDs: []int{1}})
+				m.On("FindMultiple", mock.Anything, []int{1, 2}).
+					Return([]product.Product{
+						productBuilder.WithID(1).Build(),
+					}, &product.ErrProductsNotFound{IDs: []int{2}})
+			},
+			expectedResult: dto.ClientFavorites{
+				ClientID: clientID,
+				Products: []dto.FavoriteItem{
+					dto.NewFavoriteItem(favoriteBuilder.WithProductID(1).Build(), productBuilder.WithID(1).Build()),
+					dto.NewUnavailableFavoriteItem(favoriteBuilder.WithProductID(2).Build()),
+				},
+				Total: 2,
+				Pages: 1,
+				Warnings: []dto.Warning{
+					{
+						Code:       dto.WarningProductsUnavailable,
+						Message:    "alguns produtos favoritados não estão mais disponíveis",
+						ProductIDs: []int{2},
+					},
+				},
+			},
+		},
+		{
+			about:  "when filtering by product and a product was removed upstream",
+			params: paramsBuilder.WithPage(0).WithCategory("electronics").Build(),
+			setupFavorites: func(m *favMocks.Repository) {
+				m.On("AllByClientID", mock.Anything, clientID, favorite.Filter{}).
+					Return([]favorite.Favorite{fav1, fav2}, nil)
+			},
+			setupProducts: func(m *prodMocks.Repository) {
+				m.On("FindMultiple", mock.Anything, []int{1, 2}).
+					Return([]product.Product{
+						electronicBuilder.WithID(1).Build(),
+					}, &product.ErrProductsNotFound{IDs: []int{2}})
+			},
+			expectedResult: dto.ClientFavorites{
+				ClientID: clientID,
+				Products: []dto.FavoriteItem{
+					dto.NewFavoriteItem(fav1, electronicBuilder.WithID(1).Build()),
+				},
+				Total: 1,
+				Pages: 1,
 			},
-			expectedErr: "produtos não encontrados",
 		},
 		{
 			about:  "when getProducts returns other error",
 			params: paramsBuilder.Build(),
 			setupFavorites: func(m *favMocks.Repository) {
-				m.On("PaginateByClientID", mock.Anything, clientID, 1, 20).
+				m.On("PaginateByClientID", mock.Anything, clientID, favorite.Filter{}, 1, 20).
 					Return([]favorite.Favorite{favoriteBuilder.Build()}, 1, nil)
 			},
 			setupProducts: func(m *prodMocks.Repository) {
@@ -84,7 +146,7 @@ func TestGetClientFavoritesUseCase_Execute(t *testing.T) {
 			about:  "when all is valid",
 			params: paramsBuilder.Build(),
 			setupFavorites: func(m *favMocks.Repository) {
-				m.On("PaginateByClientID", mock.Anything, clientID, 1, 20).
+				m.On("PaginateByClientID", mock.Anything, clientID, favorite.Filter{}, 1, 20).
 					Return([]favorite.Favorite{
 						favoriteBuilder.WithProductID(1).Build(),
 						favoriteBuilder.WithProductID(2).Build(),
@@ -99,14 +161,234 @@ func TestGetClientFavoritesUseCase_Execute(t *testing.T) {
 			},
 			expectedResult: dto.ClientFavorites{
 				ClientID: clientID,
-				Products: []product.Product{
-					productBuilder.WithID(1).Build(),
-					productBuilder.WithID(2).Build(),
+				Products: []dto.FavoriteItem{
+					dto.NewFavoriteItem(favoriteBuilder.WithProductID(1).Build(), productBuilder.WithID(1).Build()),
+					dto.NewFavoriteItem(favoriteBuilder.WithProductID(2).Build(), productBuilder.WithID(2).Build()),
 				},
 				Total: 2,
 				Pages: 1,
 			},
 		},
+		{
+			about:  "when filtering by tag",
+			params: paramsBuilder.WithTag(" Presentes ").Build(),
+			setupFavorites: func(m *favMocks.Repository) {
+				m.On("PaginateByClientID", mock.Anything, clientID, favorite.Filter{Tag: "presentes"}, 1, 20).
+					Return([]favorite.Favorite{
+						favoriteBuilder.WithProductID(2).WithNote("aniversário").WithTags([]string{"presentes"}).Build(),
+					}, 1, nil)
+			},
+			setupProducts: func(m *prodMocks.Repository) {
+				m.On("FindMultiple", mock.Anything, []int{2}).
+					Return([]product.Product{
+						productBuilder.WithID(2).Build(),
+					}, nil)
+			},
+			expectedResult: dto.ClientFavorites{
+				ClientID: clientID,
+				Products: []dto.FavoriteItem{
+					{
+						Product:      productBuilder.WithID(2).Build(),
+						Note:         "aniversário",
+						Tags:         []string{"presentes"},
+						CurrentPrice: productBuilder.WithID(2).Build().Price,
+						RegistredAt:  favoriteBuilder.Build().RegistredAt,
+					},
+				},
+				Total: 1,
+				Pages: 1,
+			},
+		},
+		{
+			about:  "when sorting by registeredAt",
+			params: paramsBuilder.WithSort(dto.SortRegisteredAt, dto.OrderDesc).Build(),
+			setupFavorites: func(m *favMocks.Repository) {
+				m.On("PaginateByClientID", mock.Anything, clientID, favorite.Filter{Order: favorite.OrderByRegistredAt, Desc: true}, 1, 20).
+					Return([]favorite.Favorite{fav1}, 21, nil)
+			},
+			setupProducts: func(m *prodMocks.Repository) {
+				m.On("FindMultiple", mock.Anything, []int{1}).
+					Return([]product.Product{productBuilder.WithID(1).Build()}, nil)
+			},
+			expectedResult: dto.ClientFavorites{
+				ClientID: clientID,
+				Products: []dto.FavoriteItem{
+					dto.NewFavoriteItem(fav1, productBuilder.WithID(1).Build()),
+				},
+				Total: 21,
+				Pages: 2,
+			},
+		},
+		{
+			about:  "when listing all favorites fails",
+			params: paramsBuilder.WithSort(dto.SortPrice, dto.OrderAsc).Build(),
+			setupFavorites: func(m *favMocks.Repository) {
+				m.On("AllByClientID", mock.Anything, clientID, favorite.Filter{}).
+					Return([]favorite.Favorite{}, errors.New("db error"))
+			},
+			expectedErr: "erro ao paginar favoritos",
+		},
+		{
+			about: "when sorting and filtering by product attributes",
+			params: paramsBuilder.
+				WithPage(0).
+				WithPageSize(2).
+				WithSort(dto.SortPrice, dto.OrderDesc).
+				WithCategory("Electronics").
+				WithMinRating(test.Ptr[float32](3)).
+				Build(),
+			setupFavorites: func(m *favMocks.Repository) {
+				m.On("AllByClientID", mock.Anything, clientID, favorite.Filter{Desc: true}).
+					Return([]favorite.Favorite{fav1, fav2, fav3, fav4}, nil)
+			},
+			setupProducts: func(m *prodMocks.Repository) {
+				m.On("FindMultiple", mock.Anything, []int{1, 2, 3, 4}).
+					Return([]product.Product{
+						electronicBuilder.WithID(1).WithPrice(10).Build(),
+						electronicBuilder.WithID(2).WithPrice(30).Build(),
+						electronicBuilder.WithID(3).WithPrice(20).Build(),
+						productBuilder.WithID(4).WithPrice(50).Build(),
+					}, nil)
+			},
+			expectedResult: dto.ClientFavorites{
+				ClientID: clientID,
+				Products: []dto.FavoriteItem{
+					dto.NewFavoriteItem(fav2, electronicBuilder.WithID(2).WithPrice(30).Build()),
+					dto.NewFavoriteItem(fav3, electronicBuilder.WithID(3).WithPrice(20).Build()),
+				},
+				Total: 3,
+				Pages: 2,
+			},
+		},
+		{
+			about:  "when filtering by price dropped",
+			params: paramsBuilder.WithPage(0).WithPriceDropped(true).Build(),
+			setupFavorites: func(m *favMocks.Repository) {
+				m.On("AllByClientID", mock.Anything, clientID, favorite.Filter{}).
+					Return([]favorite.Favorite{
+						favoriteBuilder.WithProductID(1).WithSnapshot("Notebook", 100).Build(),
+						favoriteBuilder.WithProductID(2).WithSnapshot("Celular", 50).Build(),
+						favoriteBuilder.WithProductID(3).Build(),
+					}, nil)
+			},
+			setupProducts: func(m *prodMocks.Repository) {
+				m.On("FindMultiple", mock.Anything, []int{1, 2, 3}).
+					Return([]product.Product{
+						productBuilder.WithID(1).WithPrice(89.9).Build(),
+						productBuilder.WithID(2).WithPrice(60).Build(),
+						productBuilder.WithID(3).WithPrice(10).Build(),
+					}, nil)
+			},
+			expectedResult: dto.ClientFavorites{
+				ClientID: clientID,
+				Products: []dto.FavoriteItem{
+					{
+						Product:            productBuilder.WithID(1).WithPrice(89.9).Build(),
+						Tags:               []string{},
+						PriceWhenFavorited: test.Ptr[float32](100),
+						CurrentPrice:       89.9,
+						PriceDelta:         test.Ptr[float32](-10.1),
+						RegistredAt:        favoriteBuilder.Build().RegistredAt,
+					},
+				},
+				Total: 1,
+				Pages: 1,
+			},
+		},
+		{
+			about:  "when no favorite matches the product filters",
+			params: paramsBuilder.WithPage(0).WithCategory("jewelery").Build(),
+			setupFavorites: func(m *favMocks.Repository) {
+				m.On("AllByClientID", mock.Anything, clientID, favorite.Filter{}).
+					Return([]favorite.Favorite{}, nil)
+			},
+			expectedResult: dto.ClientFavorites{
+				ClientID: clientID,
+				Products: []dto.FavoriteItem{},
+			},
+		},
+		{
+			about:  "when scroll fails",
+			params: cursorParamsBuilder.Build(),
+			setupFavorites: func(m *favMocks.Repository) {
+				m.On("ScrollByClientID", mock.Anything, clientID, favorite.Filter{}, favorite.Cursor{Direction: favorite.DirectionNext}, 3).
+					Return([]favorite.Favorite{}, errors.New("db error"))
+			},
+			expectedErr: "erro ao paginar favoritos",
+		},
+		{
+			about:  "when scrolling the first page",
+			params: cursorParamsBuilder.Build(),
+			setupFavorites: func(m *favMocks.Repository) {
+				m.On("ScrollByClientID", mock.Anything, clientID, favorite.Filter{}, favorite.Cursor{Direction: favorite.DirectionNext}, 3).
+					Return([]favorite.Favorite{fav1, fav2, fav3}, nil)
+			},
+			setupProducts: func(m *prodMocks.Repository) {
+				m.On("FindMultiple", mock.Anything, []int{1, 2}).
+					Return([]product.Product{
+						productBuilder.WithID(1).Build(),
+						productBuilder.WithID(2).Build(),
+					}, nil)
+			},
+			expectedResult: dto.ClientFavorites{
+				ClientID: clientID,
+				Products: []dto.FavoriteItem{
+					dto.NewFavoriteItem(fav1, productBuilder.WithID(1).Build()),
+					dto.NewFavoriteItem(fav2, productBuilder.WithID(2).Build()),
+				},
+				NextCursor: favorite.NewCursor(fav2, favorite.DirectionNext).Encode(),
+			},
+		},
+		{
+			about:  "when scrolling to the last page",
+			params: cursorParamsBuilder.WithMode("").WithCursor(cursorNext.Encode()).Build(),
+			setupFavorites: func(m *favMocks.Repository) {
+				m.On("ScrollByClientID", mock.Anything, clientID, favorite.Filter{}, mock.MatchedBy(func(c favorite.Cursor) bool {
+					return c.ProductID == 1 && c.Direction == favorite.DirectionNext
+				}), 3).
+					Return([]favorite.Favorite{fav2, fav3}, nil)
+			},
+			setupProducts: func(m *prodMocks.Repository) {
+				m.On("FindMultiple", mock.Anything, []int{2, 3}).
+					Return([]product.Product{
+						productBuilder.WithID(2).Build(),
+						productBuilder.WithID(3).Build(),
+					}, nil)
+			},
+			expectedResult: dto.ClientFavorites{
+				ClientID: clientID,
+				Products: []dto.FavoriteItem{
+					dto.NewFavoriteItem(fav2, productBuilder.WithID(2).Build()),
+					dto.NewFavoriteItem(fav3, productBuilder.WithID(3).Build()),
+				},
+				PrevCursor: favorite.NewCursor(fav2, favorite.DirectionPrev).Encode(),
+			},
+		},
+		{
+			about:  "when scrolling backwards",
+			params: cursorParamsBuilder.WithCursor(cursorPrev.Encode()).Build(),
+			setupFavorites: func(m *favMocks.Repository) {
+				m.On("ScrollByClientID", mock.Anything, clientID, favorite.Filter{}, mock.MatchedBy(func(c favorite.Cursor) bool {
+					return c.ProductID == 3 && c.Direction == favorite.DirectionPrev
+				}), 3).
+					Return([]favorite.Favorite{fav1, fav2}, nil)
+			},
+			setupProducts: func(m *prodMocks.Repository) {
+				m.On("FindMultiple", mock.Anything, []int{1, 2}).
+					Return([]product.Product{
+						productBuilder.WithID(1).Build(),
+						productBuilder.WithID(2).Build(),
+					}, nil)
+			},
+			expectedResult: dto.ClientFavorites{
+				ClientID: clientID,
+				Products: []dto.FavoriteItem{
+					dto.NewFavoriteItem(fav1, productBuilder.WithID(1).Build()),
+					dto.NewFavoriteItem(fav2, productBuilder.WithID(2).Build()),
+				},
+				NextCursor: favorite.NewCursor(fav2, favorite.DirectionNext).Encode(),
+			},
+		},
 	}
 
 	for _, tc := range testCases {
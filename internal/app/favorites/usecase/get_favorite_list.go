@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/domainerror"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/logger"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/trace"
+	"github.com/uesleicarvalhoo/aiqfome/product"
+)
+
+type getFavoriteListUseCase struct {
+	favorites favorite.Repository
+	products  product.Repository
+}
+
+func NewGetFavoriteListUseCase(favoritesRepo favorite.Repository, productsRepo product.Repository) favorites.GetFavoriteListUseCase {
+	return &getFavoriteListUseCase{
+		favorites: favoritesRepo,
+		products:  productsRepo,
+	}
+}
+
+func (u *getFavoriteListUseCase) Execute(ctx context.Context, p dto.GetFavoriteListParams) (dto.FavoriteListProducts, error) {
+	ctx, span := trace.NewSpan(ctx, "favorites.getFavoriteList")
+	defer span.End()
+
+	if p.PageSize == 0 {
+		p.PageSize = 10
+	}
+
+	if err := p.Validate(); err != nil {
+		logger.ErrorF(ctx, "invalid params", logger.Fields{
+			"params": p,
+			"error":  err.Error(),
+		})
+
+		return dto.FavoriteListProducts{}, err
+	}
+
+	l, err := findClientList(ctx, u.favorites, p.ClientID, p.ListID)
+	if err != nil {
+		return dto.FavoriteListProducts{}, err
+	}
+
+	ii, total, err := u.favorites.PaginateListItems(ctx, l.ID, p.Page, p.PageSize)
+	if err != nil {
+		logger.ErrorF(ctx, "error while trying to paginate list items", logger.Fields{
+			"list_id": l.ID,
+			"error":   err.Error(),
+		})
+
+		return dto.FavoriteListProducts{}, domainerror.Wrap(err, domainerror.DependecyError, "erro ao paginar produtos da lista", map[string]any{
+			"error":  err.Error(),
+			"params": p,
+		})
+	}
+
+	pIds := make([]int, 0, len(ii))
+	for _, i := range ii {
+		pIds = append(pIds, i.ProductID)
+	}
+
+	pds, err := u.getProducts(ctx, pIds)
+	if err != nil {
+		return dto.FavoriteListProducts{}, err
+	}
+
+	pages := (total + p.PageSize - 1) / p.PageSize
+
+	return dto.FavoriteListProducts{
+		List:     dto.ListFromDomain(l),
+		Products: pds,
+		Total:    total,
+		Pages:    pages,
+	}, nil
+}
+
+func (u *getFavoriteListUseCase) getProducts(ctx context.Context, ids []int) ([]product.Product, error) {
+	if len(ids) == 0 {
+		return []product.Product{}, nil
+	}
+
+	pp, err := u.products.FindMultiple(ctx, ids)
+	if err != nil {
+		if nfErr, ok := err.(*product.ErrProductsNotFound); ok {
+			logger.ErrorF(ctx, "products not found", logger.Fields{
+				"products_not_found": nfErr.IDs,
+			})
+
+			return []product.Product{}, domainerror.Wrap(err, domainerror.ResourceNotFound, "produtos não encontrados", map[string]any{
+				"products_not_found": nfErr.IDs,
+			})
+		}
+
+		logger.ErrorF(ctx, "error while trying to get products", logger.Fields{
+			"error":       err.Error(),
+			"product_ids": ids,
+		})
+
+		return []product.Product{}, domainerror.Wrap(err, domainerror.DependecyError, "erro ao buscar produtos", map[string]any{
+			"error":       err.Error(),
+			"product_ids": ids,
+		})
+	}
+
+	return pp, nil
+}
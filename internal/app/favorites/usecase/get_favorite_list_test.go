@@ -0,0 +1,173 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	fixtureFavorite "github.com/uesleicarvalhoo/aiqfome/favorite/fixture"
+	favMocks "github.com/uesleicarvalhoo/aiqfome/favorite/mocks"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	fixtureDto "github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto/fixture"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/usecase"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/product"
+	fixtureProduct "github.com/uesleicarvalhoo/aiqfome/product/fixture"
+	prodMocks "github.com/uesleicarvalhoo/aiqfome/product/mocks"
+)
+
+func TestGetFavoriteListUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	clientID := uuid.NextID()
+	listID := uuid.NextID()
+
+	paramsBuilder := fixtureDto.AnyGetFavoriteListParams().
+		WithClientID(clientID).
+		WithListID(listID)
+
+	list := fixtureFavorite.AnyList().
+		WithID(listID).
+		WithClientID(clientID).
+		Build()
+
+	itemBuilder := fixtureFavorite.AnyListItem().
+		WithListID(listID)
+
+	productBuilder := fixtureProduct.AnyProduct()
+
+	testCases := []struct {
+		about          string
+		params         dto.GetFavoriteListParams
+		setupFavorites func(m *favMocks.Repository)
+		setupProducts  func(m *prodMocks.Repository)
+		expectedErr    string
+		expectedResult dto.FavoriteListProducts
+	}{
+		{
+			about:       "when params invalid",
+			params:      dto.GetFavoriteListParams{},
+			expectedErr: "[AQF002] clientId: campo obrigatório; listId: campo obrigatório",
+		},
+		{
+			about:  "when list not found",
+			params: paramsBuilder.Build(),
+			setupFavorites: func(m *favMocks.Repository) {
+				m.On("FindList", mock.Anything, clientID, listID).
+					Return(favorite.List{}, &favorite.ErrListNotFound{ClientID: clientID, ListID: listID})
+			},
+			expectedErr: "[AQF003] lista não encontrada",
+		},
+		{
+			about:  "when paginate list items fails",
+			params: paramsBuilder.Build(),
+			setupFavorites: func(m *favMocks.Repository) {
+				m.On("FindList", mock.Anything, clientID, listID).
+					Return(list, nil)
+				m.On("PaginateListItems", mock.Anything, listID, 1, 20).
+					Return([]favorite.ListItem{}, 0, errors.New("db error"))
+			},
+			expectedErr: "erro ao paginar produtos da lista",
+		},
+		{
+			about:  "when getProducts returns not found",
+			params: paramsBuilder.Build(),
+			setupFavorites: func(m *favMocks.Repository) {
+				m.On("FindList", mock.Anything, clientID, listID).
+					Return(list, nil)
+				m.On("PaginateListItems", mock.Anything, listID, 1, 20).
+					Return([]favorite.ListItem{itemBuilder.WithProductID(1).Build()}, 1, nil)
+			},
+			setupProducts: func(m *prodMocks.Repository) {
+				m.On("FindMultiple", mock.Anything, []int{1}).
+					Return([]product.Product{}, &product.ErrProductsNotFound{IDs: []int{1}})
+			},
+			expectedErr: "produtos não encontrados",
+		},
+		{
+			about:  "when list is empty",
+			params: paramsBuilder.Build(),
+			setupFavorites: func(m *favMocks.Repository) {
+				m.On("FindList", mock.Anything, clientID, listID).
+					Return(list, nil)
+				m.On("PaginateListItems", mock.Anything, listID, 1, 20).
+					Return([]favorite.ListItem{}, 0, nil)
+			},
+			expectedResult: dto.FavoriteListProducts{
+				List:     dto.ListFromDomain(list),
+				Products: []product.Product{},
+				Total:    0,
+				Pages:    0,
+			},
+		},
+		{
+			about:  "when all is valid",
+			params: paramsBuilder.Build(),
+			setupFavorites: func(m *favMocks.Repository) {
+				m.On("FindList", mock.Anything, clientID, listID).
+					Return(list, nil)
+				m.On("PaginateListItems", mock.Anything, listID, 1, 20).
+					Return([]favorite.ListItem{
+						itemBuilder.WithProductID(1).Build(),
+						itemBuilder.WithProductID(2).Build(),
+					}, 2, nil)
+			},
+			setupProducts: func(m *prodMocks.Repository) {
+				m.On("FindMultiple", mock.Anything, []int{1, 2}).
+					Return([]product.Product{
+						productBuilder.WithID(1).Build(),
+						productBuilder.WithID(2).Build(),
+					}, nil)
+			},
+			expectedResult: dto.FavoriteListProducts{
+				List: dto.ListFromDomain(list),
+				Products: []product.Product{
+					productBuilder.WithID(1).Build(),
+					productBuilder.WithID(2).Build(),
+				},
+				Total: 2,
+				Pages: 1,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// arrange
+			favRepo := favMocks.NewRepository(t)
+			if tc.setupFavorites != nil {
+				tc.setupFavorites(favRepo)
+			}
+
+			prodRepo := prodMocks.NewRepository(t)
+			if tc.setupProducts != nil {
+				tc.setupProducts(prodRepo)
+			}
+
+			uc := usecase.NewGetFavoriteListUseCase(favRepo, prodRepo)
+
+			// act
+			res, err := uc.Execute(context.Background(), tc.params)
+
+			// assert
+			if tc.expectedErr != "" {
+				assert.Equal(t, dto.FavoriteListProducts{}, res)
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedResult, res)
+			}
+
+			favRepo.AssertExpectations(t)
+			prodRepo.AssertExpectations(t)
+		})
+	}
+}
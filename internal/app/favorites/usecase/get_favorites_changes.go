@@ -0,0 +1,144 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/domainerror"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/logger"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/trace"
+	"github.com/uesleicarvalhoo/aiqfome/product"
+)
+
+type getFavoritesChangesUseCase struct {
+	favorites favorite.Reader
+	products  product.Reader
+	opts      TrashOptions
+}
+
+// NewGetFavoritesChangesUseCase returns the use case, sync tokens expire with the trash retention since removals are forgotten after it
+func NewGetFavoritesChangesUseCase(favoritesRepo favorite.Reader, productsRepo product.Reader, opts TrashOptions) favorites.GetFavoritesChangesUseCase {
+	return &getFavoritesChangesUseCase{
+		favorites: favoritesRepo,
+		products:  productsRepo,
+		opts:      opts,
+	}
+}
+
+func (u *getFavoritesChangesUseCase) Execute(ctx context.Context, p dto.GetFavoritesChangesParams) (dto.FavoritesChanges, error) {
+	ctx, span := trace.NewSpan(ctx, "favorites.getFavoritesChanges")
+	defer span.End()
+
+	if p.Limit == 0 {
+		p.Limit = 100
+	}
+
+	if err := p.Validate(); err != nil {
+		logger.ErrorF(ctx, "invalid params", logger.Fields{
+			"params": p,
+			"error":  err.Error(),
+		})
+
+		return dto.FavoritesChanges{}, err
+	}
+
+	var token favorite.SyncToken
+	if p.Since != "" {
+		t, err := favorite.DecodeSyncToken(p.Since)
+		if err != nil {
+			return dto.FavoritesChanges{}, domainerror.Wrap(err, domainerror.InvalidParams, "token de sincronização inválido", map[string]any{
+				"since": p.Since,
+			})
+		}
+
+		token = t
+	}
+
+	if p.Since != "" && token.Expired(trashCutoff(u.opts)) {
+		logger.InfoF(ctx, "sync token expired, full resync required", logger.Fields{
+			"client_id": p.ClientID,
+			"issued_at": token.IssuedAt,
+		})
+
+		return dto.FavoritesChanges{
+			ClientID:   p.ClientID,
+			Upserted:   []dto.FavoriteItem{},
+			Removed:    []int{},
+			FullResync: true,
+		}, nil
+	}
+
+	// Taken before reading, so the removals this response misses are never older than the token
+	issuedAt := time.Now()
+
+	// One more change is requested only to know if there is another page after this one
+	cc, err := u.favorites.ChangesByClientID(ctx, p.ClientID, token, p.Limit+1)
+	if err != nil {
+		logger.ErrorF(ctx, "error while trying to list favorites changes", logger.Fields{
+			"client_id": p.ClientID,
+			"error":     err.Error(),
+		})
+
+		return dto.FavoritesChanges{}, domainerror.Wrap(err, domainerror.DependecyError, "erro ao buscar alterações de favoritos", map[string]any{
+			"client_id": p.ClientID,
+			"error":     err.Error(),
+		})
+	}
+
+	hasMore := len(cc) > p.Limit
+	if hasMore {
+		cc = cc[:p.Limit]
+	}
+
+	next := favorite.SyncToken{Seq: token.Seq, ProductID: token.ProductID, IssuedAt: issuedAt}
+	if len(cc) > 0 {
+		next = favorite.NewSyncToken(cc[len(cc)-1], issuedAt)
+	}
+
+	upserted, removed := latestChanges(cc)
+
+	items := []dto.FavoriteItem{}
+	if len(upserted) > 0 {
+		items, err = buildFavoriteItems(ctx, u.products, upserted)
+		if err != nil {
+			return dto.FavoritesChanges{}, err
+		}
+	}
+
+	return dto.FavoritesChanges{
+		ClientID:  p.ClientID,
+		Upserted:  items,
+		Removed:   removed,
+		SyncToken: next.Encode(),
+		HasMore:   hasMore,
+		Warnings:  dto.FavoriteItemsWarnings(items),
+	}, nil
+}
+
+// latestChanges keeps only the last change of each product, a product archived and favorited again is just upserted
+func latestChanges(cc []favorite.Change) ([]favorite.Favorite, []int) {
+	latest := make(map[int]favorite.Change, len(cc))
+	for _, c := range cc {
+		latest[c.Favorite.ProductID] = c
+	}
+
+	upserted := make([]favorite.Favorite, 0, len(latest))
+	removed := make([]int, 0)
+	for _, c := range cc {
+		if latest[c.Favorite.ProductID].Seq != c.Seq {
+			continue
+		}
+
+		if c.Removed {
+			removed = append(removed, c.Favorite.ProductID)
+			continue
+		}
+
+		upserted = append(upserted, c.Favorite)
+	}
+
+	return upserted, removed
+}
@@ -0,0 +1,191 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	fixtureFavorite "github.com/uesleicarvalhoo/aiqfome/favorite/fixture"
+	favMocks "github.com/uesleicarvalhoo/aiqfome/favorite/mocks"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	fixtureDto "github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto/fixture"
+	usecase "github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/usecase"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/product"
+	fixtureProduct "github.com/uesleicarvalhoo/aiqfome/product/fixture"
+	prodMocks "github.com/uesleicarvalhoo/aiqfome/product/mocks"
+)
+
+func TestGetFavoritesChangesUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	clientID := uuid.NextID()
+	retention := 24 * time.Hour
+
+	paramsBuilder := fixtureDto.AnyGetFavoritesChangesParams().
+		WithClientID(clientID).
+		WithLimit(2)
+
+	favoriteBuilder := fixtureFavorite.AnyFavorite().
+		WithClientID(clientID)
+
+	productBuilder := fixtureProduct.AnyProduct()
+
+	fav1 := favoriteBuilder.WithProductID(1).Build()
+	fav2 := favoriteBuilder.WithProductID(2).Build()
+
+	since := favorite.SyncToken{Seq: 3, ProductID: 1, IssuedAt: time.Now().Add(-time.Hour)}
+	expired := favorite.SyncToken{Seq: 3, ProductID: 1, IssuedAt: time.Now().Add(-2 * retention)}
+
+	testCases := []struct {
+		about          string
+		params         dto.GetFavoritesChangesParams
+		setupFavorites func(m *favMocks.Repository)
+		setupProducts  func(m *prodMocks.Repository)
+		expectedErr    string
+		expectedResult dto.FavoritesChanges
+		expectedToken  *favorite.SyncToken
+	}{
+		{
+			about:       "when params invalid",
+			params:      paramsBuilder.WithSince("invalid").Build(),
+			expectedErr: "[AQF002] since: token de sincronização inválido",
+		},
+		{
+			about:  "when token is older than the retention",
+			params: paramsBuilder.WithSince(expired.Encode()).Build(),
+			expectedResult: dto.FavoritesChanges{
+				ClientID:   clientID,
+				Upserted:   []dto.FavoriteItem{},
+				Removed:    []int{},
+				FullResync: true,
+			},
+		},
+		{
+			about:  "when list changes fails",
+			params: paramsBuilder.WithSince(since.Encode()).Build(),
+			setupFavorites: func(m *favMocks.Repository) {
+				m.On("ChangesByClientID", mock.Anything, clientID, mock.AnythingOfType("favorite.SyncToken"), 3).
+					Return([]favorite.Change{}, errors.New("db error"))
+			},
+			expectedErr: "[AQF004] erro ao buscar alterações de favoritos",
+		},
+		{
+			about:  "when there is no change the token keeps its position",
+			params: paramsBuilder.WithSince(since.Encode()).Build(),
+			setupFavorites: func(m *favMocks.Repository) {
+				m.On("ChangesByClientID", mock.Anything, clientID, mock.MatchedBy(func(t favorite.SyncToken) bool {
+					return t.Seq == 3 && t.ProductID == 1
+				}), 3).Return([]favorite.Change{}, nil)
+			},
+			expectedResult: dto.FavoritesChanges{
+				ClientID: clientID,
+				Upserted: []dto.FavoriteItem{},
+				Removed:  []int{},
+			},
+			expectedToken: &favorite.SyncToken{Seq: 3, ProductID: 1},
+		},
+		{
+			about:  "when syncing from scratch with more changes than the limit",
+			params: paramsBuilder.Build(),
+			setupFavorites: func(m *favMocks.Repository) {
+				m.On("ChangesByClientID", mock.Anything, clientID, favorite.SyncToken{}, 3).
+					Return([]favorite.Change{
+						{Seq: 1, Favorite: fav1},
+						{Seq: 1, Favorite: fav2},
+						{Seq: 2, Favorite: favoriteBuilder.WithProductID(3).Build()},
+					}, nil)
+			},
+			setupProducts: func(m *prodMocks.Repository) {
+				m.On("FindMultiple", mock.Anything, []int{1, 2}).
+					Return([]product.Product{
+						productBuilder.WithID(1).Build(),
+						productBuilder.WithID(2).Build(),
+					}, nil)
+			},
+			expectedResult: dto.FavoritesChanges{
+				ClientID: clientID,
+				Upserted: []dto.FavoriteItem{
+					dto.NewFavoriteItem(fav1, productBuilder.WithID(1).Build()),
+					dto.NewFavoriteItem(fav2, productBuilder.WithID(2).Build()),
+				},
+				Removed: []int{},
+				HasMore: true,
+			},
+			expectedToken: &favorite.SyncToken{Seq: 1, ProductID: 2},
+		},
+		{
+			about:  "when a product is removed and favorited again only the last change is returned",
+			params: paramsBuilder.WithLimit(10).WithSince(since.Encode()).Build(),
+			setupFavorites: func(m *favMocks.Repository) {
+				m.On("ChangesByClientID", mock.Anything, clientID, mock.AnythingOfType("favorite.SyncToken"), 11).
+					Return([]favorite.Change{
+						{Seq: 4, Removed: true, Favorite: favorite.Favorite{ClientID: clientID, ProductID: 1}},
+						{Seq: 5, Removed: true, Favorite: favorite.Favorite{ClientID: clientID, ProductID: 2}},
+						{Seq: 6, Favorite: fav1},
+					}, nil)
+			},
+			setupProducts: func(m *prodMocks.Repository) {
+				m.On("FindMultiple", mock.Anything, []int{1}).
+					Return([]product.Product{productBuilder.WithID(1).Build()}, nil)
+			},
+			expectedResult: dto.FavoritesChanges{
+				ClientID: clientID,
+				Upserted: []dto.FavoriteItem{
+					dto.NewFavoriteItem(fav1, productBuilder.WithID(1).Build()),
+				},
+				Removed: []int{2},
+			},
+			expectedToken: &favorite.SyncToken{Seq: 6, ProductID: 1},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			favRepo := favMocks.NewRepository(t)
+			if tc.setupFavorites != nil {
+				tc.setupFavorites(favRepo)
+			}
+
+			prodRepo := prodMocks.NewRepository(t)
+			if tc.setupProducts != nil {
+				tc.setupProducts(prodRepo)
+			}
+
+			uc := usecase.NewGetFavoritesChangesUseCase(favRepo, prodRepo, usecase.TrashOptions{Retention: retention})
+
+			// Action
+			res, err := uc.Execute(context.Background(), tc.params)
+
+			// Assert
+			if tc.expectedErr != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+
+				return
+			}
+
+			assert.NoError(t, err)
+
+			if tc.expectedToken != nil {
+				token, err := favorite.DecodeSyncToken(res.SyncToken)
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedToken.Seq, token.Seq)
+				assert.Equal(t, tc.expectedToken.ProductID, token.ProductID)
+			}
+
+			res.SyncToken = ""
+			assert.Equal(t, tc.expectedResult, res)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/domainerror"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/logger"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/trace"
+)
+
+type getFavoritesHistoryUseCase struct {
+	favorites favorite.Reader
+}
+
+func NewGetFavoritesHistoryUseCase(favoritesRepo favorite.Reader) favorites.GetFavoritesHistoryUseCase {
+	return &getFavoritesHistoryUseCase{
+		favorites: favoritesRepo,
+	}
+}
+
+func (u *getFavoritesHistoryUseCase) Execute(ctx context.Context, p dto.GetFavoritesHistoryParams) (dto.FavoritesHistory, error) {
+	ctx, span := trace.NewSpan(ctx, "favorites.getFavoritesHistory")
+	defer span.End()
+
+	if p.PageSize == 0 {
+		p.PageSize = 10
+	}
+
+	if err := p.Validate(); err != nil {
+		logger.ErrorF(ctx, "invalid params", logger.Fields{
+			"params": p,
+			"error":  err.Error(),
+		})
+
+		return dto.FavoritesHistory{}, err
+	}
+
+	aa, total, err := u.favorites.PaginateActivities(ctx, p.ClientID, p.Page, p.PageSize)
+	if err != nil {
+		logger.ErrorF(ctx, "error while trying to paginate favorites history", logger.Fields{
+			"error": err.Error(),
+		})
+
+		return dto.FavoritesHistory{}, domainerror.Wrap(err, domainerror.DependecyError, "erro ao paginar histórico de favoritos", map[string]any{
+			"error":  err.Error(),
+			"params": p,
+		})
+	}
+
+	entries := make([]dto.HistoryEntry, 0, len(aa))
+	for _, a := range aa {
+		entries = append(entries, dto.HistoryEntryFromDomain(a))
+	}
+
+	return dto.FavoritesHistory{
+		ClientID: p.ClientID,
+		Entries:  entries,
+		Total:    total,
+		Pages:    (total + p.PageSize - 1) / p.PageSize,
+	}, nil
+}
@@ -0,0 +1,142 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	favMocks "github.com/uesleicarvalhoo/aiqfome/favorite/mocks"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	fixtureDto "github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto/fixture"
+	usecase "github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/usecase"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+func TestGetFavoritesHistoryUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	clientID := uuid.NextID()
+	adminID := uuid.NextID()
+	occurredAt := time.Now().Add(-time.Hour)
+
+	paramsBuilder := fixtureDto.AnyGetFavoritesHistoryParams().
+		WithClientID(clientID)
+
+	removedByAdmin := favorite.Activity{
+		ID:           2,
+		ClientID:     clientID,
+		ProductID:    1,
+		ProductTitle: "Mochila",
+		Action:       favorite.ActionRemoved,
+		ActorID:      adminID,
+		OccurredAt:   occurredAt,
+	}
+	added := favorite.Activity{
+		ID:           1,
+		ClientID:     clientID,
+		ProductID:    1,
+		ProductTitle: "Mochila",
+		Action:       favorite.ActionAdded,
+		ActorID:      clientID,
+		OccurredAt:   occurredAt.Add(-time.Hour),
+	}
+
+	testCases := []struct {
+		about          string
+		params         dto.GetFavoritesHistoryParams
+		setupFavorites func(m *favMocks.Reader)
+		expectedErr    string
+		expectedResult dto.FavoritesHistory
+	}{
+		{
+			about:       "when params invalid",
+			params:      dto.GetFavoritesHistoryParams{},
+			expectedErr: "[AQF002] clientId: campo obrigatório",
+		},
+		{
+			about:  "when paginate activities fails",
+			params: paramsBuilder.Build(),
+			setupFavorites: func(m *favMocks.Reader) {
+				m.On("PaginateActivities", mock.Anything, clientID, 1, 20).
+					Return([]favorite.Activity{}, 0, errors.New("db error"))
+			},
+			expectedErr: "[AQF004] erro ao paginar histórico de favoritos",
+		},
+		{
+			about:  "when history is empty",
+			params: paramsBuilder.Build(),
+			setupFavorites: func(m *favMocks.Reader) {
+				m.On("PaginateActivities", mock.Anything, clientID, 1, 20).
+					Return([]favorite.Activity{}, 0, nil)
+			},
+			expectedResult: dto.FavoritesHistory{
+				ClientID: clientID,
+				Entries:  []dto.HistoryEntry{},
+			},
+		},
+		{
+			about:  "when all is valid",
+			params: paramsBuilder.Build(),
+			setupFavorites: func(m *favMocks.Reader) {
+				m.On("PaginateActivities", mock.Anything, clientID, 1, 20).
+					Return([]favorite.Activity{removedByAdmin, added}, 22, nil)
+			},
+			expectedResult: dto.FavoritesHistory{
+				ClientID: clientID,
+				Entries: []dto.HistoryEntry{
+					{
+						ProductID:    1,
+						ProductTitle: "Mochila",
+						Action:       favorite.ActionRemoved,
+						ActorID:      adminID,
+						OnBehalf:     true,
+						OccurredAt:   occurredAt,
+					},
+					{
+						ProductID:    1,
+						ProductTitle: "Mochila",
+						Action:       favorite.ActionAdded,
+						ActorID:      clientID,
+						OccurredAt:   occurredAt.Add(-time.Hour),
+					},
+				},
+				Total: 22,
+				Pages: 2,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			favRepo := favMocks.NewReader(t)
+			if tc.setupFavorites != nil {
+				tc.setupFavorites(favRepo)
+			}
+
+			uc := usecase.NewGetFavoritesHistoryUseCase(favRepo)
+
+			// Action
+			res, err := uc.Execute(context.Background(), tc.params)
+
+			// Assert
+			if tc.expectedErr != "" {
+				assert.Equal(t, dto.FavoritesHistory{}, res)
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, res)
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/trace"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/user"
+)
+
+type getFavoritesQuotaUseCase struct {
+	favorites favorite.Reader
+	users     user.Reader
+	quota     QuotaOptions
+}
+
+func NewGetFavoritesQuotaUseCase(favoriteReader favorite.Reader, userReader user.Reader, quota QuotaOptions) favorites.GetFavoritesQuotaUseCase {
+	return &getFavoritesQuotaUseCase{
+		favorites: favoriteReader,
+		users:     userReader,
+		quota:     quota,
+	}
+}
+
+func (u *getFavoritesQuotaUseCase) Execute(ctx context.Context, clientID uuid.ID) (dto.FavoritesQuota, error) {
+	ctx, span := trace.NewSpan(ctx, "favorites.getFavoritesQuota")
+	defer span.End()
+
+	return favoritesQuota(ctx, u.favorites, u.users, clientID, u.quota)
+}
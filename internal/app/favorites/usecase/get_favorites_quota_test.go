@@ -0,0 +1,129 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	mocksFavorite "github.com/uesleicarvalhoo/aiqfome/favorite/mocks"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/usecase"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/role"
+	"github.com/uesleicarvalhoo/aiqfome/test"
+	fixtureUser "github.com/uesleicarvalhoo/aiqfome/user/fixture"
+	mocksUser "github.com/uesleicarvalhoo/aiqfome/user/mocks"
+)
+
+func TestGetFavoritesQuotaUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	clientID := uuid.NextID()
+	quota := usecase.QuotaOptions{ByRole: map[role.Role]int{role.RoleClient: 5}}
+
+	testCases := []struct {
+		about          string
+		setupFavorites func(m *mocksFavorite.Repository)
+		setupUsers     func(m *mocksUser.Reader)
+		expectedErr    string
+		expectedResult dto.FavoritesQuota
+	}{
+		{
+			about: "when find quota fails",
+			setupFavorites: func(m *mocksFavorite.Repository) {
+				m.On("FindQuota", mock.Anything, clientID).
+					Return(favorite.Quota{}, errors.New("db error"))
+			},
+			expectedErr: "[AQF004] erro ao buscar limite de favoritos",
+		},
+		{
+			about: "when count fails",
+			setupFavorites: func(m *mocksFavorite.Repository) {
+				m.On("FindQuota", mock.Anything, clientID).
+					Return(favorite.Quota{ClientID: clientID, MaxFavorites: 10}, nil)
+				m.On("CountByClientID", mock.Anything, clientID).
+					Return(0, errors.New("db error"))
+			},
+			expectedErr: "[AQF004] erro ao contar favoritos",
+		},
+		{
+			about: "when client has an override",
+			setupFavorites: func(m *mocksFavorite.Repository) {
+				m.On("FindQuota", mock.Anything, clientID).
+					Return(favorite.Quota{ClientID: clientID, MaxFavorites: 10}, nil)
+				m.On("CountByClientID", mock.Anything, clientID).
+					Return(4, nil)
+			},
+			expectedResult: dto.FavoritesQuota{ClientID: clientID, Limit: test.Ptr(10), Used: 4, Remaining: test.Ptr(6), Overridden: true},
+		},
+		{
+			about: "when client uses the role limit",
+			setupFavorites: func(m *mocksFavorite.Repository) {
+				m.On("FindQuota", mock.Anything, clientID).
+					Return(favorite.Quota{}, &favorite.ErrQuotaNotFound{ClientID: clientID})
+				m.On("CountByClientID", mock.Anything, clientID).
+					Return(2, nil)
+			},
+			setupUsers: func(m *mocksUser.Reader) {
+				m.On("Find", mock.Anything, clientID).
+					Return(fixtureUser.AnyUser().WithID(clientID).WithRole(role.RoleClient).Build(), nil)
+			},
+			expectedResult: dto.FavoritesQuota{ClientID: clientID, Limit: test.Ptr(5), Used: 2, Remaining: test.Ptr(3)},
+		},
+		{
+			about: "when role is unlimited",
+			setupFavorites: func(m *mocksFavorite.Repository) {
+				m.On("FindQuota", mock.Anything, clientID).
+					Return(favorite.Quota{}, &favorite.ErrQuotaNotFound{ClientID: clientID})
+				m.On("CountByClientID", mock.Anything, clientID).
+					Return(20, nil)
+			},
+			setupUsers: func(m *mocksUser.Reader) {
+				m.On("Find", mock.Anything, clientID).
+					Return(fixtureUser.AnyUser().WithID(clientID).WithRole(role.RoleAdmin).Build(), nil)
+			},
+			expectedResult: dto.FavoritesQuota{ClientID: clientID, Used: 20},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			favRepo := mocksFavorite.NewRepository(t)
+			if tc.setupFavorites != nil {
+				tc.setupFavorites(favRepo)
+			}
+
+			userReader := mocksUser.NewReader(t)
+			if tc.setupUsers != nil {
+				tc.setupUsers(userReader)
+			}
+
+			uc := usecase.NewGetFavoritesQuotaUseCase(favRepo, userReader, quota)
+
+			// Action
+			res, err := uc.Execute(context.Background(), clientID)
+
+			// Assert
+			if tc.expectedErr != "" {
+				assert.Equal(t, dto.FavoritesQuota{}, res)
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedResult, res)
+			}
+
+			favRepo.AssertExpectations(t)
+			userReader.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/domainerror"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/logger"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/trace"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+type getFavoritesSharesUseCase struct {
+	repo favorite.Repository
+}
+
+func NewGetFavoritesSharesUseCase(repo favorite.Repository) favorites.GetFavoritesSharesUseCase {
+	return &getFavoritesSharesUseCase{
+		repo: repo,
+	}
+}
+
+func (u *getFavoritesSharesUseCase) Execute(ctx context.Context, clientID uuid.ID) (dto.ClientFavoritesShares, error) {
+	ctx, span := trace.NewSpan(ctx, "favorites.getFavoritesShares")
+	defer span.End()
+
+	ss, err := u.repo.SharesByClientID(ctx, clientID)
+	if err != nil {
+		logger.ErrorF(ctx, "error while trying to list favorites shares", logger.Fields{
+			"client_id": clientID,
+			"error":     err.Error(),
+		})
+
+		return dto.ClientFavoritesShares{}, domainerror.Wrap(err, domainerror.DependecyError, "erro ao buscar links de compartilhamento", map[string]any{
+			"client_id": clientID,
+			"error":     err.Error(),
+		})
+	}
+
+	shares := make([]dto.FavoritesShare, 0, len(ss))
+	for _, s := range ss {
+		shares = append(shares, dto.ShareFromDomain(s))
+	}
+
+	return dto.ClientFavoritesShares{
+		ClientID: clientID,
+		Shares:   shares,
+	}, nil
+}
@@ -0,0 +1,92 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	fixtureFavorite "github.com/uesleicarvalhoo/aiqfome/favorite/fixture"
+	mocksFavorite "github.com/uesleicarvalhoo/aiqfome/favorite/mocks"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/usecase"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/test"
+)
+
+func TestGetFavoritesSharesUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	clientID := uuid.NextID()
+	createdAt := time.Now().Add(-48 * time.Hour)
+	expiredAt := time.Now().Add(-time.Hour)
+
+	builder := fixtureFavorite.AnyShare().WithClientID(clientID).WithCreatedAt(createdAt)
+	active := builder.WithToken("active").Build()
+	expired := builder.WithToken("expired").WithExpiresAt(&expiredAt).Build()
+
+	testCases := []struct {
+		about          string
+		setupRepo      func(m *mocksFavorite.Repository)
+		expectedErr    string
+		expectedResult dto.ClientFavoritesShares
+	}{
+		{
+			about: "when repository fails",
+			setupRepo: func(m *mocksFavorite.Repository) {
+				m.On("SharesByClientID", mock.Anything, clientID).
+					Return([]favorite.Share{}, errors.New("db error"))
+			},
+			expectedErr: "[AQF004] erro ao buscar links de compartilhamento",
+		},
+		{
+			about: "when client has shares",
+			setupRepo: func(m *mocksFavorite.Repository) {
+				m.On("SharesByClientID", mock.Anything, clientID).
+					Return([]favorite.Share{active, expired}, nil)
+			},
+			expectedResult: dto.ClientFavoritesShares{
+				ClientID: clientID,
+				Shares: []dto.FavoritesShare{
+					{Token: "active", Path: "/shared/active", CreatedAt: createdAt},
+					{Token: "expired", Path: "/shared/expired", CreatedAt: createdAt, ExpiresAt: test.Ptr(expiredAt), Expired: true},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			repo := mocksFavorite.NewRepository(t)
+			if tc.setupRepo != nil {
+				tc.setupRepo(repo)
+			}
+
+			uc := usecase.NewGetFavoritesSharesUseCase(repo)
+
+			// Action
+			res, err := uc.Execute(context.Background(), clientID)
+
+			// Assert
+			if tc.expectedErr != "" {
+				assert.Equal(t, dto.ClientFavoritesShares{}, res)
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedResult, res)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
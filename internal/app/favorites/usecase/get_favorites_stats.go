@@ -0,0 +1,211 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/cache"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/domainerror"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/logger"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/trace"
+	"github.com/uesleicarvalhoo/aiqfome/product"
+)
+
+type StatsOptions struct {
+	CacheDuration time.Duration
+}
+
+type getFavoritesStatsUseCase struct {
+	favorites favorite.Repository
+	products  product.Reader
+	cache     cache.Cache
+	opts      StatsOptions
+}
+
+func NewGetFavoritesStatsUseCase(favoritesRepo favorite.Repository, productsRepo product.Reader, cache cache.Cache, opts StatsOptions) favorites.GetFavoritesStatsUseCase {
+	return &getFavoritesStatsUseCase{
+		favorites: favoritesRepo,
+		products:  productsRepo,
+		cache:     cache,
+		opts:      opts,
+	}
+}
+
+func (u *getFavoritesStatsUseCase) Execute(ctx context.Context, p dto.GetFavoritesStatsParams) (dto.FavoritesStats, error) {
+	ctx, span := trace.NewSpan(ctx, "favorites.getFavoritesStats")
+	defer span.End()
+
+	if p.Days == 0 {
+		p.Days = 30
+	}
+
+	if p.Top == 0 {
+		p.Top = 10
+	}
+
+	if err := p.Validate(); err != nil {
+		logger.ErrorF(ctx, "invalid params", logger.Fields{
+			"params": p,
+			"error":  err.Error(),
+		})
+
+		return dto.FavoritesStats{}, err
+	}
+
+	key := fmt.Sprintf("favorites-stats:%d:%d", p.Days, p.Top)
+	if st, ok := u.fromCache(ctx, key); ok {
+		return st, nil
+	}
+
+	counts, err := u.favorites.CountByProduct(ctx)
+	if err != nil {
+		logger.ErrorF(ctx, "error while trying to count favorites by product", logger.Fields{
+			"error": err.Error(),
+		})
+
+		return dto.FavoritesStats{}, domainerror.Wrap(err, domainerror.DependecyError, "erro ao buscar estatísticas de favoritos", map[string]any{
+			"error": err.Error(),
+		})
+	}
+
+	daily, err := u.favorites.DailyActivity(ctx, time.Now().AddDate(0, 0, -p.Days))
+	if err != nil {
+		logger.ErrorF(ctx, "error while trying to get favorites daily activity", logger.Fields{
+			"days":  p.Days,
+			"error": err.Error(),
+		})
+
+		return dto.FavoritesStats{}, domainerror.Wrap(err, domainerror.DependecyError, "erro ao buscar estatísticas de favoritos", map[string]any{
+			"days":  p.Days,
+			"error": err.Error(),
+		})
+	}
+
+	pds, err := u.findProducts(ctx, counts)
+	if err != nil {
+		return dto.FavoritesStats{}, err
+	}
+
+	st := dto.FavoritesStats{
+		MostFavorited: make([]dto.ProductStats, 0, min(p.Top, len(counts))),
+		Daily:         daily,
+		Categories:    make([]dto.CategoryStats, 0),
+		GeneratedAt:   time.Now(),
+	}
+
+	for _, c := range counts[:min(p.Top, len(counts))] {
+		pd, ok := pds[c.ProductID]
+		if !ok {
+			pd = product.Product{ID: c.ProductID}
+		}
+
+		st.MostFavorited = append(st.MostFavorited, dto.ProductStats{Product: pd, Favorites: c.Count})
+	}
+
+	byCategory := make(map[string]int)
+	for _, c := range counts {
+		if pd, ok := pds[c.ProductID]; ok {
+			byCategory[pd.Category] += c.Count
+		}
+	}
+
+	for category, total := range byCategory {
+		st.Categories = append(st.Categories, dto.CategoryStats{Category: category, Favorites: total})
+	}
+
+	slices.SortFunc(st.Categories, func(a, b dto.CategoryStats) int {
+		if c := cmp.Compare(b.Favorites, a.Favorites); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(a.Category, b.Category)
+	})
+
+	u.toCache(ctx, key, st)
+
+	return st, nil
+}
+
+// findProducts returns the products indexed by id, products removed upstream are left out of the stats
+func (u *getFavoritesStatsUseCase) findProducts(ctx context.Context, counts []favorite.ProductCount) (map[int]product.Product, error) {
+	pds := make(map[int]product.Product, len(counts))
+	if len(counts) == 0 {
+		return pds, nil
+	}
+
+	ids := make([]int, 0, len(counts))
+	for _, c := range counts {
+		ids = append(ids, c.ProductID)
+	}
+
+	pp, err := u.products.FindMultiple(ctx, ids)
+	if err != nil {
+		nfErr, ok := err.(*product.ErrProductsNotFound)
+		if !ok {
+			logger.ErrorF(ctx, "error while trying to get products", logger.Fields{
+				"error":       err.Error(),
+				"product_ids": ids,
+			})
+
+			return nil, domainerror.Wrap(err, domainerror.DependecyError, "erro ao buscar produtos", map[string]any{
+				"error":       err.Error(),
+				"product_ids": ids,
+			})
+		}
+
+		logger.WarnF(ctx, "favorited products not found", logger.Fields{
+			"products_not_found": nfErr.IDs,
+		})
+	}
+
+	for _, pd := range pp {
+		pds[pd.ID] = pd
+	}
+
+	return pds, nil
+}
+
+func (u *getFavoritesStatsUseCase) fromCache(ctx context.Context, key string) (dto.FavoritesStats, bool) {
+	data, err := u.cache.Get(ctx, key)
+	if err != nil || data == nil {
+		return dto.FavoritesStats{}, false
+	}
+
+	var st dto.FavoritesStats
+	if err := json.Unmarshal(data, &st); err != nil {
+		logger.ErrorF(ctx, "failed to unmarshal favorites stats from cache", logger.Fields{
+			"key":   key,
+			"error": err.Error(),
+		})
+
+		return dto.FavoritesStats{}, false
+	}
+
+	return st, true
+}
+
+func (u *getFavoritesStatsUseCase) toCache(ctx context.Context, key string, st dto.FavoritesStats) {
+	data, err := json.Marshal(st)
+	if err != nil {
+		logger.ErrorF(ctx, "failed to marshal favorites stats", logger.Fields{
+			"key":   key,
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	if err := u.cache.Set(ctx, key, data, u.opts.CacheDuration); err != nil {
+		logger.ErrorF(ctx, "failed to save favorites stats on cache", logger.Fields{
+			"key":   key,
+			"error": err.Error(),
+		})
+	}
+}
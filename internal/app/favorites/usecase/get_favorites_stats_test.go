@@ -0,0 +1,217 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	favMocks "github.com/uesleicarvalhoo/aiqfome/favorite/mocks"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	fixtureDto "github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto/fixture"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/usecase"
+	mocksCache "github.com/uesleicarvalhoo/aiqfome/pkg/cache/mocks"
+	"github.com/uesleicarvalhoo/aiqfome/product"
+	fixtureProduct "github.com/uesleicarvalhoo/aiqfome/product/fixture"
+	prodMocks "github.com/uesleicarvalhoo/aiqfome/product/mocks"
+)
+
+func TestGetFavoritesStatsUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	cacheKey := "favorites-stats:30:2"
+	cacheDuration := time.Hour
+
+	paramsBuilder := fixtureDto.AnyGetFavoritesStatsParams().
+		WithDays(30).
+		WithTop(2)
+
+	counts := []favorite.ProductCount{
+		{ProductID: 1, Count: 5},
+		{ProductID: 2, Count: 3},
+		{ProductID: 3, Count: 1},
+	}
+
+	daily := []favorite.DailyActivity{
+		{Day: time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC), Added: 4, Removed: 1},
+	}
+
+	electronic1 := fixtureProduct.AnyProduct().WithID(1).WithCategory("electronics").Build()
+	jewelery := fixtureProduct.AnyProduct().WithID(2).WithCategory("jewelery").Build()
+	electronic3 := fixtureProduct.AnyProduct().WithID(3).WithCategory("electronics").Build()
+
+	cached := dto.FavoritesStats{
+		MostFavorited: []dto.ProductStats{{Product: electronic1, Favorites: 5}},
+		Daily:         []favorite.DailyActivity{},
+		Categories:    []dto.CategoryStats{{Category: "electronics", Favorites: 5}},
+		GeneratedAt:   time.Date(2026, 10, 18, 10, 0, 0, 0, time.UTC),
+	}
+	cachedData, err := json.Marshal(cached)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		about          string
+		params         dto.GetFavoritesStatsParams
+		setupFavorites func(m *favMocks.Repository)
+		setupProducts  func(m *prodMocks.Repository)
+		setupCache     func(m *mocksCache.Cache)
+		expectedErr    string
+		expectedResult dto.FavoritesStats
+	}{
+		{
+			about:       "when params are invalid",
+			params:      paramsBuilder.WithDays(dto.StatsMaxDays + 1).Build(),
+			expectedErr: "[AQF002] days: deve estar entre 1 e 365",
+		},
+		{
+			about:  "when stats are cached",
+			params: paramsBuilder.Build(),
+			setupCache: func(m *mocksCache.Cache) {
+				m.On("Get", mock.Anything, cacheKey).Return(cachedData, nil)
+			},
+			expectedResult: cached,
+		},
+		{
+			about:  "when count by product fails",
+			params: paramsBuilder.Build(),
+			setupCache: func(m *mocksCache.Cache) {
+				m.On("Get", mock.Anything, cacheKey).Return(nil, errors.New("data not found"))
+			},
+			setupFavorites: func(m *favMocks.Repository) {
+				m.On("CountByProduct", mock.Anything).Return([]favorite.ProductCount{}, errors.New("db error"))
+			},
+			expectedErr: "[AQF004] erro ao buscar estatísticas de favoritos",
+		},
+		{
+			about:  "when daily activity fails",
+			params: paramsBuilder.Build(),
+			setupCache: func(m *mocksCache.Cache) {
+				m.On("Get", mock.Anything, cacheKey).Return(nil, errors.New("data not found"))
+			},
+			setupFavorites: func(m *favMocks.Repository) {
+				m.On("CountByProduct", mock.Anything).Return(counts, nil)
+				m.On("DailyActivity", mock.Anything, mock.AnythingOfType("time.Time")).
+					Return([]favorite.DailyActivity{}, errors.New("db error"))
+			},
+			expectedErr: "[AQF004] erro ao buscar estatísticas de favoritos",
+		},
+		{
+			about:  "when products lookup fails",
+			params: paramsBuilder.Build(),
+			setupCache: func(m *mocksCache.Cache) {
+				m.On("Get", mock.Anything, cacheKey).Return(nil, errors.New("data not found"))
+			},
+			setupFavorites: func(m *favMocks.Repository) {
+				m.On("CountByProduct", mock.Anything).Return(counts, nil)
+				m.On("DailyActivity", mock.Anything, mock.AnythingOfType("time.Time")).Return(daily, nil)
+			},
+			setupProducts: func(m *prodMocks.Repository) {
+				m.On("FindMultiple", mock.Anything, []int{1, 2, 3}).
+					Return([]product.Product{}, errors.New("service down"))
+			},
+			expectedErr: "[AQF004] erro ao buscar produtos",
+		},
+		{
+			about:  "when some products are not found upstream",
+			params: paramsBuilder.Build(),
+			setupCache: func(m *mocksCache.Cache) {
+				m.On("Get", mock.Anything, cacheKey).Return(nil, errors.New("data not found"))
+				m.On("Set", mock.Anything, cacheKey, mock.Anything, cacheDuration).Return(nil)
+			},
+			setupFavorites: func(m *favMocks.Repository) {
+				m.On("CountByProduct", mock.Anything).Return(counts, nil)
+				m.On("DailyActivity", mock.Anything, mock.AnythingOfType("time.Time")).Return(daily, nil)
+			},
+			setupProducts: func(m *prodMocks.Repository) {
+				m.On("FindMultiple", mock.Anything, []int{1, 2, 3}).
+					Return([]product.Product{electronic1, electronic3}, &product.ErrProductsNotFound{IDs: []int{2}})
+			},
+			expectedResult: dto.FavoritesStats{
+				MostFavorited: []dto.ProductStats{
+					{Product: electronic1, Favorites: 5},
+					{Product: product.Product{ID: 2}, Favorites: 3},
+				},
+				Daily: daily,
+				Categories: []dto.CategoryStats{
+					{Category: "electronics", Favorites: 6},
+				},
+			},
+		},
+		{
+			about:  "when all is valid",
+			params: paramsBuilder.Build(),
+			setupCache: func(m *mocksCache.Cache) {
+				m.On("Get", mock.Anything, cacheKey).Return(nil, errors.New("data not found"))
+				m.On("Set", mock.Anything, cacheKey, mock.Anything, cacheDuration).Return(errors.New("cache down"))
+			},
+			setupFavorites: func(m *favMocks.Repository) {
+				m.On("CountByProduct", mock.Anything).Return(counts, nil)
+				m.On("DailyActivity", mock.Anything, mock.AnythingOfType("time.Time")).Return(daily, nil)
+			},
+			setupProducts: func(m *prodMocks.Repository) {
+				m.On("FindMultiple", mock.Anything, []int{1, 2, 3}).
+					Return([]product.Product{electronic1, jewelery, electronic3}, nil)
+			},
+			expectedResult: dto.FavoritesStats{
+				MostFavorited: []dto.ProductStats{
+					{Product: electronic1, Favorites: 5},
+					{Product: jewelery, Favorites: 3},
+				},
+				Daily: daily,
+				Categories: []dto.CategoryStats{
+					{Category: "electronics", Favorites: 6},
+					{Category: "jewelery", Favorites: 3},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			favRepo := favMocks.NewRepository(t)
+			if tc.setupFavorites != nil {
+				tc.setupFavorites(favRepo)
+			}
+
+			prodRepo := prodMocks.NewRepository(t)
+			if tc.setupProducts != nil {
+				tc.setupProducts(prodRepo)
+			}
+
+			cache := mocksCache.NewCache(t)
+			if tc.setupCache != nil {
+				tc.setupCache(cache)
+			}
+
+			uc := usecase.NewGetFavoritesStatsUseCase(favRepo, prodRepo, cache, usecase.StatsOptions{CacheDuration: cacheDuration})
+
+			// Action
+			res, err := uc.Execute(context.Background(), tc.params)
+
+			// Assert
+			if tc.expectedErr != "" {
+				assert.Equal(t, dto.FavoritesStats{}, res)
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedResult.MostFavorited, res.MostFavorited)
+			assert.Equal(t, tc.expectedResult.Daily, res.Daily)
+			assert.Equal(t, tc.expectedResult.Categories, res.Categories)
+			assert.False(t, res.GeneratedAt.IsZero())
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/domainerror"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/logger"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/trace"
+	"github.com/uesleicarvalhoo/aiqfome/product"
+)
+
+type getFavoritesTrashUseCase struct {
+	favorites favorite.Repository
+	products  product.Reader
+	opts      TrashOptions
+}
+
+func NewGetFavoritesTrashUseCase(favoritesRepo favorite.Repository, productsRepo product.Reader, opts TrashOptions) favorites.GetFavoritesTrashUseCase {
+	return &getFavoritesTrashUseCase{
+		favorites: favoritesRepo,
+		products:  productsRepo,
+		opts:      opts,
+	}
+}
+
+func (u *getFavoritesTrashUseCase) Execute(ctx context.Context, p dto.GetFavoritesTrashParams) (dto.FavoritesTrash, error) {
+	ctx, span := trace.NewSpan(ctx, "favorites.getFavoritesTrash")
+	defer span.End()
+
+	if p.PageSize == 0 {
+		p.PageSize = 10
+	}
+
+	if err := p.Validate(); err != nil {
+		logger.ErrorF(ctx, "invalid params", logger.Fields{
+			"params": p,
+			"error":  err.Error(),
+		})
+
+		return dto.FavoritesTrash{}, err
+	}
+
+	fvs, total, err := u.favorites.PaginateTrash(ctx, p.ClientID, trashCutoff(u.opts), p.Page, p.PageSize)
+	if err != nil {
+		logger.ErrorF(ctx, "error while trying to paginate favorites trash", logger.Fields{
+			"error": err.Error(),
+		})
+
+		return dto.FavoritesTrash{}, domainerror.Wrap(err, domainerror.DependecyError, "erro ao paginar lixeira", map[string]any{
+			"error":  err.Error(),
+			"params": p,
+		})
+	}
+
+	items := make([]dto.TrashItem, 0, len(fvs))
+	if len(fvs) > 0 {
+		fItems, err := buildFavoriteItems(ctx, u.products, fvs)
+		if err != nil {
+			return dto.FavoritesTrash{}, err
+		}
+
+		for _, i := range fItems {
+			for _, f := range fvs {
+				if f.ProductID != i.ID || f.DeletedAt == nil {
+					continue
+				}
+
+				items = append(items, dto.TrashItem{
+					FavoriteItem: i,
+					DeletedAt:    *f.DeletedAt,
+					ExpiresAt:    f.DeletedAt.Add(u.opts.Retention),
+				})
+			}
+		}
+	}
+
+	return dto.FavoritesTrash{
+		ClientID: p.ClientID,
+		Products: items,
+		Total:    total,
+		Pages:    (total + p.PageSize - 1) / p.PageSize,
+	}, nil
+}
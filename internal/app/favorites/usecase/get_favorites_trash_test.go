@@ -0,0 +1,159 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	fixtureFavorite "github.com/uesleicarvalhoo/aiqfome/favorite/fixture"
+	favMocks "github.com/uesleicarvalhoo/aiqfome/favorite/mocks"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	fixtureDto "github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto/fixture"
+	usecase "github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/usecase"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/product"
+	fixtureProduct "github.com/uesleicarvalhoo/aiqfome/product/fixture"
+	prodMocks "github.com/uesleicarvalhoo/aiqfome/product/mocks"
+)
+
+func TestGetFavoritesTrashUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	clientID := uuid.NextID()
+	retention := 24 * time.Hour
+	deletedAt := time.Now().Add(-time.Hour)
+
+	paramsBuilder := fixtureDto.AnyGetFavoritesTrashParams().
+		WithClientID(clientID)
+
+	favoriteBuilder := fixtureFavorite.AnyFavorite().
+		WithClientID(clientID).
+		WithDeletedAt(&deletedAt)
+
+	productBuilder := fixtureProduct.AnyProduct()
+
+	fav1 := favoriteBuilder.WithProductID(1).Build()
+	fav2 := favoriteBuilder.WithProductID(2).Build()
+
+	testCases := []struct {
+		about          string
+		params         dto.GetFavoritesTrashParams
+		setupFavorites func(m *favMocks.Repository)
+		setupProducts  func(m *prodMocks.Repository)
+		expectedErr    string
+		expectedResult dto.FavoritesTrash
+	}{
+		{
+			about:       "when params invalid",
+			params:      dto.GetFavoritesTrashParams{},
+			expectedErr: "[AQF002] clientId: campo obrigatório",
+		},
+		{
+			about:  "when paginate trash fails",
+			params: paramsBuilder.Build(),
+			setupFavorites: func(m *favMocks.Repository) {
+				m.On("PaginateTrash", mock.Anything, clientID, mock.AnythingOfType("time.Time"), 1, 20).
+					Return([]favorite.Favorite{}, 0, errors.New("db error"))
+			},
+			expectedErr: "[AQF004] erro ao paginar lixeira",
+		},
+		{
+			about:  "when products lookup fails",
+			params: paramsBuilder.Build(),
+			setupFavorites: func(m *favMocks.Repository) {
+				m.On("PaginateTrash", mock.Anything, clientID, mock.AnythingOfType("time.Time"), 1, 20).
+					Return([]favorite.Favorite{fav1}, 1, nil)
+			},
+			setupProducts: func(m *prodMocks.Repository) {
+				m.On("FindMultiple", mock.Anything, []int{1}).
+					Return([]product.Product{}, errors.New("service down"))
+			},
+			expectedErr: "erro ao buscar produtos",
+		},
+		{
+			about:  "when trash is empty",
+			params: paramsBuilder.Build(),
+			setupFavorites: func(m *favMocks.Repository) {
+				m.On("PaginateTrash", mock.Anything, clientID, mock.AnythingOfType("time.Time"), 1, 20).
+					Return([]favorite.Favorite{}, 0, nil)
+			},
+			expectedResult: dto.FavoritesTrash{
+				ClientID: clientID,
+				Products: []dto.TrashItem{},
+			},
+		},
+		{
+			about:  "when all is valid",
+			params: paramsBuilder.Build(),
+			setupFavorites: func(m *favMocks.Repository) {
+				m.On("PaginateTrash", mock.Anything, clientID, mock.AnythingOfType("time.Time"), 1, 20).
+					Return([]favorite.Favorite{fav1, fav2}, 2, nil)
+			},
+			setupProducts: func(m *prodMocks.Repository) {
+				m.On("FindMultiple", mock.Anything, []int{1, 2}).
+					Return([]product.Product{
+						productBuilder.WithID(1).Build(),
+						productBuilder.WithID(2).Build(),
+					}, nil)
+			},
+			expectedResult: dto.FavoritesTrash{
+				ClientID: clientID,
+				Products: []dto.TrashItem{
+					{
+						FavoriteItem: dto.NewFavoriteItem(fav1, productBuilder.WithID(1).Build()),
+						DeletedAt:    deletedAt,
+						ExpiresAt:    deletedAt.Add(retention),
+					},
+					{
+						FavoriteItem: dto.NewFavoriteItem(fav2, productBuilder.WithID(2).Build()),
+						DeletedAt:    deletedAt,
+						ExpiresAt:    deletedAt.Add(retention),
+					},
+				},
+				Total: 2,
+				Pages: 1,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			favRepo := favMocks.NewRepository(t)
+			if tc.setupFavorites != nil {
+				tc.setupFavorites(favRepo)
+			}
+
+			prodRepo := prodMocks.NewRepository(t)
+			if tc.setupProducts != nil {
+				tc.setupProducts(prodRepo)
+			}
+
+			uc := usecase.NewGetFavoritesTrashUseCase(favRepo, prodRepo, usecase.TrashOptions{Retention: retention})
+
+			// Action
+			res, err := uc.Execute(context.Background(), tc.params)
+
+			// Assert
+			if tc.expectedErr != "" {
+				assert.Equal(t, dto.FavoritesTrash{}, res)
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedResult, res)
+			}
+
+			favRepo.AssertExpectations(t)
+			prodRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/cache"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/domainerror"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/logger"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/trace"
+)
+
+type getImportJobUseCase struct {
+	cache cache.Cache
+	opts  ImportOptions
+}
+
+func NewGetImportJobUseCase(cache cache.Cache, opts ImportOptions) favorites.GetImportJobUseCase {
+	return &getImportJobUseCase{
+		cache: cache,
+		opts:  opts,
+	}
+}
+
+func (u *getImportJobUseCase) Execute(ctx context.Context, p dto.GetImportJobParams) (dto.ImportJob, error) {
+	ctx, span := trace.NewSpan(ctx, "favorites.getImportJob")
+	defer span.End()
+
+	if err := p.Validate(); err != nil {
+		logger.ErrorF(ctx, "invalid params", logger.Fields{
+			"params": p,
+			"error":  err.Error(),
+		})
+
+		return dto.ImportJob{}, err
+	}
+
+	// Jobs are kept on cache only until they expire, so a missing key is reported as not found
+	data, err := u.cache.Get(ctx, importJobKey(p.ClientID, p.JobID))
+	if err != nil && !errors.Is(err, cache.ErrNotFound) {
+		logger.ErrorF(ctx, "failed to get import job from cache", logger.Fields{
+			"job_id": p.JobID,
+			"error":  err.Error(),
+		})
+
+		return dto.ImportJob{}, domainerror.Wrap(err, domainerror.DependecyError, "erro ao buscar importação", map[string]any{
+			"job_id": p.JobID,
+			"error":  err.Error(),
+		})
+	}
+
+	if data == nil {
+		logger.WarnF(ctx, "import job not found", logger.Fields{
+			"client_id": p.ClientID,
+			"job_id":    p.JobID,
+		})
+
+		return dto.ImportJob{}, domainerror.New(domainerror.ResourceNotFound, "importação não encontrada", map[string]any{
+			"job_id": p.JobID,
+		})
+	}
+
+	var job dto.ImportJob
+	if err := json.Unmarshal(data, &job); err != nil {
+		logger.ErrorF(ctx, "failed to unmarshal import job", logger.Fields{
+			"job_id": p.JobID,
+			"error":  err.Error(),
+		})
+
+		return dto.ImportJob{}, domainerror.Wrap(err, domainerror.DependecyError, "erro ao buscar importação", map[string]any{
+			"job_id": p.JobID,
+			"error":  err.Error(),
+		})
+	}
+
+	// A job still pending after its timeout was lost with the process that ran it
+	if job.Status == dto.ImportJobPending && u.opts.JobTimeout > 0 && time.Since(job.CreatedAt) > u.opts.JobTimeout {
+		job = finishImportJob(job, dto.ImportFavoritesReport{}, errImportInterrupted)
+	}
+
+	return job, nil
+}
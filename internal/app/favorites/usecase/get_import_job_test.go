@@ -0,0 +1,139 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	fixtureDto "github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto/fixture"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/usecase"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/cache"
+	mocksCache "github.com/uesleicarvalhoo/aiqfome/pkg/cache/mocks"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+func TestGetImportJobUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	clientID := uuid.NextID()
+	jobID := uuid.NextID()
+	key := fmt.Sprintf("favorites-import:%s:%s", clientID, jobID)
+
+	job := dto.ImportJob{
+		ID:        jobID,
+		ClientID:  clientID,
+		Status:    dto.ImportJobPending,
+		Rows:      200,
+		CreatedAt: time.Date(2026, 10, 18, 10, 0, 0, 0, time.UTC),
+	}
+	data, _ := json.Marshal(job)
+
+	params := fixtureDto.AnyGetImportJobParams().WithClientID(clientID).WithJobID(jobID).Build()
+
+	testCases := []struct {
+		about          string
+		params         dto.GetImportJobParams
+		setupCache     func(m *mocksCache.Cache)
+		expectedErr    string
+		expectedResult dto.ImportJob
+	}{
+		{
+			about:       "when params are invalid",
+			params:      dto.GetImportJobParams{},
+			expectedErr: "[AQF002] clientId: campo obrigatório; jobId: campo obrigatório",
+		},
+		{
+			about:  "when job is not found",
+			params: params,
+			setupCache: func(m *mocksCache.Cache) {
+				m.On("Get", mock.Anything, key).Return(nil, cache.ErrNotFound)
+			},
+			expectedErr: "[AQF003] importação não encontrada",
+		},
+		{
+			about:  "when the cache fails",
+			params: params,
+			setupCache: func(m *mocksCache.Cache) {
+				m.On("Get", mock.Anything, key).Return(nil, errors.New("connection refused"))
+			},
+			expectedErr: "[AQF004] erro ao buscar importação",
+		},
+		{
+			about:  "when cached job is invalid",
+			params: params,
+			setupCache: func(m *mocksCache.Cache) {
+				m.On("Get", mock.Anything, key).Return([]byte("{"), nil)
+			},
+			expectedErr: "[AQF004] erro ao buscar importação",
+		},
+		{
+			about:  "when job exists",
+			params: params,
+			setupCache: func(m *mocksCache.Cache) {
+				m.On("Get", mock.Anything, key).Return(data, nil)
+			},
+			expectedResult: job,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			cache := mocksCache.NewCache(t)
+			if tc.setupCache != nil {
+				tc.setupCache(cache)
+			}
+
+			uc := usecase.NewGetImportJobUseCase(cache, usecase.ImportOptions{})
+
+			// Action
+			res, err := uc.Execute(context.Background(), tc.params)
+
+			// Assert
+			if tc.expectedErr != "" {
+				assert.Equal(t, dto.ImportJob{}, res)
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedResult, res)
+			}
+
+			cache.AssertExpectations(t)
+		})
+	}
+
+	t.Run("when the job is pending past its timeout it is reported as interrupted", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		lost := job
+		lost.CreatedAt = time.Now().Add(-2 * time.Hour)
+		lostData, _ := json.Marshal(lost)
+
+		cache := mocksCache.NewCache(t)
+		cache.On("Get", mock.Anything, key).Return(lostData, nil)
+
+		uc := usecase.NewGetImportJobUseCase(cache, usecase.ImportOptions{JobTimeout: time.Hour})
+
+		// Action
+		res, err := uc.Execute(context.Background(), params)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, dto.ImportJobFailed, res.Status)
+		assert.Equal(t, "importação interrompida, tente novamente", res.Error)
+		assert.NotNil(t, res.FinishedAt)
+	})
+}
@@ -0,0 +1,173 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/cache"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/domainerror"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/logger"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/trace"
+	"github.com/uesleicarvalhoo/aiqfome/product"
+)
+
+type RecommendationsOptions struct {
+	CacheDuration time.Duration
+	// MaxSimilar is how many similar products are kept for each product when the similarities are refreshed
+	MaxSimilar int
+}
+
+type getRecommendationsUseCase struct {
+	favorites favorite.Reader
+	products  product.Reader
+	cache     cache.Cache
+	opts      RecommendationsOptions
+}
+
+func NewGetRecommendationsUseCase(favoritesRepo favorite.Reader, productsRepo product.Reader, cache cache.Cache, opts RecommendationsOptions) favorites.GetRecommendationsUseCase {
+	return &getRecommendationsUseCase{
+		favorites: favoritesRepo,
+		products:  productsRepo,
+		cache:     cache,
+		opts:      opts,
+	}
+}
+
+func (u *getRecommendationsUseCase) Execute(ctx context.Context, p dto.GetRecommendationsParams) (dto.Recommendations, error) {
+	ctx, span := trace.NewSpan(ctx, "favorites.getRecommendations")
+	defer span.End()
+
+	if p.Limit == 0 {
+		p.Limit = 10
+	}
+
+	if err := p.Validate(); err != nil {
+		logger.ErrorF(ctx, "invalid params", logger.Fields{
+			"params": p,
+			"error":  err.Error(),
+		})
+
+		return dto.Recommendations{}, err
+	}
+
+	key := fmt.Sprintf("favorites-recommendations:%s:%d", p.ClientID, p.Limit)
+	if rc, ok := u.fromCache(ctx, key); ok {
+		return rc, nil
+	}
+
+	rr, err := u.favorites.RecommendByClientID(ctx, p.ClientID, p.Limit)
+	if err != nil {
+		logger.ErrorF(ctx, "error while trying to recommend products", logger.Fields{
+			"client_id": p.ClientID,
+			"error":     err.Error(),
+		})
+
+		return dto.Recommendations{}, domainerror.Wrap(err, domainerror.DependecyError, "erro ao buscar recomendações", map[string]any{
+			"client_id": p.ClientID,
+			"error":     err.Error(),
+		})
+	}
+
+	pds, err := u.findProducts(ctx, rr)
+	if err != nil {
+		return dto.Recommendations{}, err
+	}
+
+	rc := dto.Recommendations{
+		ClientID:    p.ClientID,
+		Products:    make([]dto.RecommendedProduct, 0, len(rr)),
+		GeneratedAt: time.Now(),
+	}
+
+	for _, r := range rr {
+		if pd, ok := pds[r.ProductID]; ok {
+			rc.Products = append(rc.Products, dto.RecommendedProduct{Product: pd, Score: r.Score})
+		}
+	}
+
+	u.toCache(ctx, key, rc)
+
+	return rc, nil
+}
+
+// findProducts returns the products indexed by id, products removed upstream aren't recommended
+func (u *getRecommendationsUseCase) findProducts(ctx context.Context, rr []favorite.Recommendation) (map[int]product.Product, error) {
+	pds := make(map[int]product.Product, len(rr))
+	if len(rr) == 0 {
+		return pds, nil
+	}
+
+	ids := make([]int, 0, len(rr))
+	for _, r := range rr {
+		ids = append(ids, r.ProductID)
+	}
+
+	pp, err := u.products.FindMultiple(ctx, ids)
+	if err != nil {
+		nfErr, ok := err.(*product.ErrProductsNotFound)
+		if !ok {
+			logger.ErrorF(ctx, "error while trying to get products", logger.Fields{
+				"error":       err.Error(),
+				"product_ids": ids,
+			})
+
+			return nil, domainerror.Wrap(err, domainerror.DependecyError, "erro ao buscar produtos", map[string]any{
+				"error":       err.Error(),
+				"product_ids": ids,
+			})
+		}
+
+		logger.WarnF(ctx, "recommended products not found", logger.Fields{
+			"products_not_found": nfErr.IDs,
+		})
+	}
+
+	for _, pd := range pp {
+		pds[pd.ID] = pd
+	}
+
+	return pds, nil
+}
+
+func (u *getRecommendationsUseCase) fromCache(ctx context.Context, key string) (dto.Recommendations, bool) {
+	data, err := u.cache.Get(ctx, key)
+	if err != nil || data == nil {
+		return dto.Recommendations{}, false
+	}
+
+	var rc dto.Recommendations
+	if err := json.Unmarshal(data, &rc); err != nil {
+		logger.ErrorF(ctx, "failed to unmarshal recommendations from cache", logger.Fields{
+			"key":   key,
+			"error": err.Error(),
+		})
+
+		return dto.Recommendations{}, false
+	}
+
+	return rc, true
+}
+
+func (u *getRecommendationsUseCase) toCache(ctx context.Context, key string, rc dto.Recommendations) {
+	data, err := json.Marshal(rc)
+	if err != nil {
+		logger.ErrorF(ctx, "failed to marshal recommendations", logger.Fields{
+			"key":   key,
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	if err := u.cache.Set(ctx, key, data, u.opts.CacheDuration); err != nil {
+		logger.ErrorF(ctx, "failed to save recommendations on cache", logger.Fields{
+			"key":   key,
+			"error": err.Error(),
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	favMocks "github.com/uesleicarvalhoo/aiqfome/favorite/mocks"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	fixtureDto "github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto/fixture"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/usecase"
+	mocksCache "github.com/uesleicarvalhoo/aiqfome/pkg/cache/mocks"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/product"
+	fixtureProduct "github.com/uesleicarvalhoo/aiqfome/product/fixture"
+	prodMocks "github.com/uesleicarvalhoo/aiqfome/product/mocks"
+)
+
+func TestGetRecommendationsUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	clientID := uuid.NextID()
+	cacheKey := fmt.Sprintf("favorites-recommendations:%s:5", clientID)
+	cacheDuration := time.Hour
+
+	paramsBuilder := fixtureDto.AnyGetRecommendationsParams().
+		WithClientID(clientID).
+		WithLimit(5)
+
+	recommendations := []favorite.Recommendation{
+		{ProductID: 3, Score: 1.5},
+		{ProductID: 4, Score: 0.5},
+	}
+
+	product3 := fixtureProduct.AnyProduct().WithID(3).Build()
+	product4 := fixtureProduct.AnyProduct().WithID(4).Build()
+
+	cached := dto.Recommendations{
+		ClientID:    clientID,
+		Products:    []dto.RecommendedProduct{{Product: product3, Score: 1.5}},
+		GeneratedAt: time.Date(2026, 10, 18, 10, 0, 0, 0, time.UTC),
+	}
+	cachedData, err := json.Marshal(cached)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		about            string
+		params           dto.GetRecommendationsParams
+		setupFavorites   func(m *favMocks.Reader)
+		setupProducts    func(m *prodMocks.Reader)
+		setupCache       func(m *mocksCache.Cache)
+		expectedErr      string
+		expectedProducts []dto.RecommendedProduct
+	}{
+		{
+			about:       "when params are invalid",
+			params:      paramsBuilder.WithLimit(dto.RecommendationsMaxLimit + 1).Build(),
+			expectedErr: "[AQF002] limit: deve estar entre 1 e 50",
+		},
+		{
+			about:  "when recommendations are cached",
+			params: paramsBuilder.Build(),
+			setupCache: func(m *mocksCache.Cache) {
+				m.On("Get", mock.Anything, cacheKey).Return(cachedData, nil)
+			},
+			expectedProducts: cached.Products,
+		},
+		{
+			about:  "when recommend fails",
+			params: paramsBuilder.Build(),
+			setupCache: func(m *mocksCache.Cache) {
+				m.On("Get", mock.Anything, cacheKey).Return(nil, nil)
+			},
+			setupFavorites: func(m *favMocks.Reader) {
+				m.On("RecommendByClientID", mock.Anything, clientID, 5).Return(nil, errors.New("db error"))
+			},
+			expectedErr: "[AQF004] erro ao buscar recomendações",
+		},
+		{
+			about:  "when products lookup fails",
+			params: paramsBuilder.Build(),
+			setupCache: func(m *mocksCache.Cache) {
+				m.On("Get", mock.Anything, cacheKey).Return(nil, nil)
+			},
+			setupFavorites: func(m *favMocks.Reader) {
+				m.On("RecommendByClientID", mock.Anything, clientID, 5).Return(recommendations, nil)
+			},
+			setupProducts: func(m *prodMocks.Reader) {
+				m.On("FindMultiple", mock.Anything, []int{3, 4}).Return(nil, errors.New("service down"))
+			},
+			expectedErr: "[AQF004] erro ao buscar produtos",
+		},
+		{
+			about:  "when there is nothing to recommend",
+			params: paramsBuilder.Build(),
+			setupCache: func(m *mocksCache.Cache) {
+				m.On("Get", mock.Anything, cacheKey).Return(nil, nil)
+				m.On("Set", mock.Anything, cacheKey, mock.Anything, cacheDuration).Return(nil)
+			},
+			setupFavorites: func(m *favMocks.Reader) {
+				m.On("RecommendByClientID", mock.Anything, clientID, 5).Return([]favorite.Recommendation{}, nil)
+			},
+			expectedProducts: []dto.RecommendedProduct{},
+		},
+		{
+			about:  "when a product was removed upstream it isn't recommended",
+			params: paramsBuilder.Build(),
+			setupCache: func(m *mocksCache.Cache) {
+				m.On("Get", mock.Anything, cacheKey).Return(nil, nil)
+				m.On("Set", mock.Anything, cacheKey, mock.Anything, cacheDuration).Return(nil)
+			},
+			setupFavorites: func(m *favMocks.Reader) {
+				m.On("RecommendByClientID", mock.Anything, clientID, 5).Return(recommendations, nil)
+			},
+			setupProducts: func(m *prodMocks.Reader) {
+				m.On("FindMultiple", mock.Anything, []int{3, 4}).
+					Return([]product.Product{product3}, &product.ErrProductsNotFound{IDs: []int{4}})
+			},
+			expectedProducts: []dto.RecommendedProduct{{Product: product3, Score: 1.5}},
+		},
+		{
+			about:  "when all is valid",
+			params: paramsBuilder.Build(),
+			setupCache: func(m *mocksCache.Cache) {
+				m.On("Get", mock.Anything, cacheKey).Return(nil, nil)
+				m.On("Set", mock.Anything, cacheKey, mock.Anything, cacheDuration).Return(errors.New("cache down"))
+			},
+			setupFavorites: func(m *favMocks.Reader) {
+				m.On("RecommendByClientID", mock.Anything, clientID, 5).Return(recommendations, nil)
+			},
+			setupProducts: func(m *prodMocks.Reader) {
+				m.On("FindMultiple", mock.Anything, []int{3, 4}).Return([]product.Product{product4, product3}, nil)
+			},
+			expectedProducts: []dto.RecommendedProduct{
+				{Product: product3, Score: 1.5},
+				{Product: product4, Score: 0.5},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			favRepo := favMocks.NewReader(t)
+			if tc.setupFavorites != nil {
+				tc.setupFavorites(favRepo)
+			}
+
+			prodRepo := prodMocks.NewReader(t)
+			if tc.setupProducts != nil {
+				tc.setupProducts(prodRepo)
+			}
+
+			cache := mocksCache.NewCache(t)
+			if tc.setupCache != nil {
+				tc.setupCache(cache)
+			}
+
+			uc := usecase.NewGetRecommendationsUseCase(favRepo, prodRepo, cache, usecase.RecommendationsOptions{CacheDuration: cacheDuration})
+
+			// Action
+			res, err := uc.Execute(context.Background(), tc.params)
+
+			// Assert
+			if tc.expectedErr != "" {
+				assert.Equal(t, dto.Recommendations{}, res)
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, clientID, res.ClientID)
+			assert.Equal(t, tc.expectedProducts, res.Products)
+			assert.False(t, res.GeneratedAt.IsZero())
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/domainerror"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/logger"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/trace"
+	"github.com/uesleicarvalhoo/aiqfome/product"
+)
+
+type getSharedFavoritesUseCase struct {
+	favorites favorite.Reader
+	products  product.Reader
+}
+
+func NewGetSharedFavoritesUseCase(favoritesRepo favorite.Reader, productsRepo product.Reader) favorites.GetSharedFavoritesUseCase {
+	return &getSharedFavoritesUseCase{
+		favorites: favoritesRepo,
+		products:  productsRepo,
+	}
+}
+
+func (u *getSharedFavoritesUseCase) Execute(ctx context.Context, p dto.GetSharedFavoritesParams) (dto.SharedFavorites, error) {
+	ctx, span := trace.NewSpan(ctx, "favorites.getSharedFavorites")
+	defer span.End()
+
+	if p.PageSize == 0 {
+		p.PageSize = 10
+	}
+
+	if err := p.Validate(); err != nil {
+		logger.ErrorF(ctx, "invalid params", logger.Fields{
+			"error": err.Error(),
+		})
+
+		return dto.SharedFavorites{}, err
+	}
+
+	s, err := u.favorites.FindShare(ctx, p.Token)
+	if err != nil {
+		if _, ok := err.(*favorite.ErrShareNotFound); ok {
+			return dto.SharedFavorites{}, shareNotFound(p.Token)
+		}
+
+		logger.ErrorF(ctx, "error while trying to find favorites share", logger.Fields{
+			"error": err.Error(),
+		})
+
+		return dto.SharedFavorites{}, domainerror.Wrap(err, domainerror.DependecyError, "erro ao buscar link de compartilhamento", map[string]any{
+			"error": err.Error(),
+		})
+	}
+
+	// Expired and revoked links behave as if they never existed
+	if !s.IsActive(time.Now()) {
+		return dto.SharedFavorites{}, shareNotFound(p.Token)
+	}
+
+	fvs, total, err := u.favorites.PaginateByClientID(ctx, s.ClientID, favorite.Filter{}, p.Page, p.PageSize)
+	if err != nil {
+		logger.ErrorF(ctx, "error while trying to paginate shared favorites", logger.Fields{
+			"client_id": s.ClientID,
+			"error":     err.Error(),
+		})
+
+		return dto.SharedFavorites{}, domainerror.Wrap(err, domainerror.DependecyError, "erro ao paginar favoritos", map[string]any{
+			"error": err.Error(),
+		})
+	}
+
+	items := []dto.FavoriteItem{}
+	if len(fvs) > 0 {
+		items, err = buildFavoriteItems(ctx, u.products, fvs)
+		if err != nil {
+			return dto.SharedFavorites{}, err
+		}
+	}
+
+	return dto.SharedFavorites{
+		Products:  dto.NewSharedFavorites(items),
+		Total:     total,
+		Pages:     (total + p.PageSize - 1) / p.PageSize,
+		ExpiresAt: s.ExpiresAt,
+		Warnings:  dto.FavoriteItemsWarnings(items),
+	}, nil
+}
@@ -0,0 +1,204 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	fixtureFavorite "github.com/uesleicarvalhoo/aiqfome/favorite/fixture"
+	mocksFavorite "github.com/uesleicarvalhoo/aiqfome/favorite/mocks"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	fixtureDto "github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto/fixture"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/usecase"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/product"
+	fixtureProd "github.com/uesleicarvalhoo/aiqfome/product/fixture"
+	mocksProduct "github.com/uesleicarvalhoo/aiqfome/product/mocks"
+	"github.com/uesleicarvalhoo/aiqfome/test"
+)
+
+func TestGetSharedFavoritesUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	clientID := uuid.NextID()
+	token := "share-token"
+	expiresAt := time.Now().Add(time.Hour)
+
+	params := fixtureDto.AnyGetSharedFavoritesParams().WithToken(token).WithPageSize(2).Build()
+	shareBuilder := fixtureFavorite.AnyShare().WithClientID(clientID).WithToken(token).WithCreatedAt(time.Now().Add(-time.Hour))
+	favoriteBuilder := fixtureFavorite.AnyFavorite().WithClientID(clientID)
+	productBuilder := fixtureProd.AnyProduct()
+
+	testCases := []struct {
+		about          string
+		params         dto.GetSharedFavoritesParams
+		setupFavorites func(m *mocksFavorite.Repository)
+		setupProducts  func(m *mocksProduct.Reader)
+		expectedErr    string
+		expectedResult dto.SharedFavorites
+	}{
+		{
+			about:       "when params are invalid",
+			params:      fixtureDto.AnyGetSharedFavoritesParams().WithToken("").WithPage(-1).Build(),
+			expectedErr: "[AQF002] token: campo obrigatório; page: não pode ser negativo",
+		},
+		{
+			about:  "when share is not found",
+			params: params,
+			setupFavorites: func(m *mocksFavorite.Repository) {
+				m.On("FindShare", mock.Anything, token).
+					Return(favorite.Share{}, &favorite.ErrShareNotFound{Token: token})
+			},
+			expectedErr: "[AQF003] link de compartilhamento não encontrado",
+		},
+		{
+			about:  "when find share fails",
+			params: params,
+			setupFavorites: func(m *mocksFavorite.Repository) {
+				m.On("FindShare", mock.Anything, token).
+					Return(favorite.Share{}, errors.New("db error"))
+			},
+			expectedErr: "[AQF004] erro ao buscar link de compartilhamento",
+		},
+		{
+			about:  "when share is expired",
+			params: params,
+			setupFavorites: func(m *mocksFavorite.Repository) {
+				m.On("FindShare", mock.Anything, token).
+					Return(shareBuilder.WithExpiresAt(test.Ptr(time.Now().Add(-time.Minute))).Build(), nil)
+			},
+			expectedErr: "[AQF003] link de compartilhamento não encontrado",
+		},
+		{
+			about:  "when share is revoked",
+			params: params,
+			setupFavorites: func(m *mocksFavorite.Repository) {
+				m.On("FindShare", mock.Anything, token).
+					Return(shareBuilder.WithRevokedAt(test.Ptr(time.Now())).Build(), nil)
+			},
+			expectedErr: "[AQF003] link de compartilhamento não encontrado",
+		},
+		{
+			about:  "when paginate fails",
+			params: params,
+			setupFavorites: func(m *mocksFavorite.Repository) {
+				m.On("FindShare", mock.Anything, token).
+					Return(shareBuilder.Build(), nil)
+				m.On("PaginateByClientID", mock.Anything, clientID, favorite.Filter{}, 0, 2).
+					Return([]favorite.Favorite{}, 0, errors.New("db error"))
+			},
+			expectedErr: "[AQF004] erro ao paginar favoritos",
+		},
+		{
+			about:  "when client has no favorites",
+			params: params,
+			setupFavorites: func(m *mocksFavorite.Repository) {
+				m.On("FindShare", mock.Anything, token).
+					Return(shareBuilder.Build(), nil)
+				m.On("PaginateByClientID", mock.Anything, clientID, favorite.Filter{}, 0, 2).
+					Return([]favorite.Favorite{}, 0, nil)
+			},
+			expectedResult: dto.SharedFavorites{Products: []dto.SharedFavorite{}},
+		},
+		{
+			about:  "when all is valid",
+			params: params,
+			setupFavorites: func(m *mocksFavorite.Repository) {
+				m.On("FindShare", mock.Anything, token).
+					Return(shareBuilder.WithExpiresAt(&expiresAt).Build(), nil)
+				m.On("PaginateByClientID", mock.Anything, clientID, favorite.Filter{}, 0, 2).
+					Return([]favorite.Favorite{
+						favoriteBuilder.WithProductID(1).Build(),
+						favoriteBuilder.WithProductID(2).Build(),
+					}, 3, nil)
+			},
+			setupProducts: func(m *mocksProduct.Reader) {
+				m.On("FindMultiple", mock.Anything, []int{1, 2}).
+					Return([]product.Product{productBuilder.WithID(1).Build(), productBuilder.WithID(2).Build()}, nil)
+			},
+			expectedResult: dto.SharedFavorites{
+				Products: []dto.SharedFavorite{
+					{Product: productBuilder.WithID(1).Build()},
+					{Product: productBuilder.WithID(2).Build()},
+				},
+				Total:     3,
+				Pages:     2,
+				ExpiresAt: &expiresAt,
+			},
+		},
+		{
+			about:  "when a product was removed upstream it is shown as unavailable",
+			params: params,
+			setupFavorites: func(m *mocksFavorite.Repository) {
+				m.On("FindShare", mock.Anything, token).
+					Return(shareBuilder.Build(), nil)
+				m.On("PaginateByClientID", mock.Anything, clientID, favorite.Filter{}, 0, 2).
+					Return([]favorite.Favorite{
+						favoriteBuilder.WithProductID(1).Build(),
+						favoriteBuilder.WithProductID(2).WithSnapshot("Mochila", 10).Build(),
+					}, 2, nil)
+			},
+			setupProducts: func(m *mocksProduct.Reader) {
+				m.On("FindMultiple", mock.Anything, []int{1, 2}).
+					Return([]product.Product{productBuilder.WithID(1).Build()}, &product.ErrProductsNotFound{IDs: []int{2}})
+			},
+			expectedResult: dto.SharedFavorites{
+				Products: []dto.SharedFavorite{
+					{Product: productBuilder.WithID(1).Build()},
+					{Product: product.Product{ID: 2, Title: "Mochila"}, Unavailable: true},
+				},
+				Total: 2,
+				Pages: 1,
+				Warnings: []dto.Warning{
+					{
+						Code:       dto.WarningProductsUnavailable,
+						Message:    "alguns produtos favoritados não estão mais disponíveis",
+						ProductIDs: []int{2},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			favRepo := mocksFavorite.NewRepository(t)
+			if tc.setupFavorites != nil {
+				tc.setupFavorites(favRepo)
+			}
+
+			prodReader := mocksProduct.NewReader(t)
+			if tc.setupProducts != nil {
+				tc.setupProducts(prodReader)
+			}
+
+			uc := usecase.NewGetSharedFavoritesUseCase(favRepo, prodReader)
+
+			// Action
+			res, err := uc.Execute(context.Background(), tc.params)
+
+			// Assert
+			if tc.expectedErr != "" {
+				assert.Equal(t, dto.SharedFavorites{}, res)
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedResult, res)
+			}
+
+			favRepo.AssertExpectations(t)
+			prodReader.AssertExpectations(t)
+		})
+	}
+}
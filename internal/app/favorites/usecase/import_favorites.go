@@ -0,0 +1,400 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/cache"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/domainerror"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/logger"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/trace"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/product"
+	"github.com/uesleicarvalhoo/aiqfome/user"
+)
+
+type ImportOptions struct {
+	// MaxRows is the limit of favorites in a single file
+	MaxRows int
+	// SyncMaxRows is the limit of favorites imported during the request, bigger files are imported in background
+	SyncMaxRows int
+	BatchSize   int
+	JobTTL      time.Duration
+	// JobTimeout is how long a background import may run, a job still pending after it was interrupted
+	JobTimeout time.Duration
+}
+
+// JobRunner runs the imports of big files in background, tracked so the shutdown can wait for them or cancel them
+type JobRunner interface {
+	Go(ctx context.Context, name string, task func(ctx context.Context) error) error
+}
+
+var errImportInterrupted = domainerror.New(domainerror.DependecyError, "importação interrompida, tente novamente", nil)
+
+type importFavoritesUseCase struct {
+	products  product.Reader
+	favorites favorite.Repository
+	users     user.Reader
+	cache     cache.Cache
+	runner    JobRunner
+	opts      ImportOptions
+	quota     QuotaOptions
+}
+
+func NewImportFavoritesUseCase(
+	productReader product.Reader,
+	favoriteRepo favorite.Repository,
+	userReader user.Reader,
+	cache cache.Cache,
+	runner JobRunner,
+	opts ImportOptions,
+	quota QuotaOptions,
+) favorites.ImportFavoritesUseCase {
+	return &importFavoritesUseCase{
+		products:  productReader,
+		favorites: favoriteRepo,
+		users:     userReader,
+		cache:     cache,
+		runner:    runner,
+		opts:      opts,
+		quota:     quota,
+	}
+}
+
+func (u *importFavoritesUseCase) Execute(ctx context.Context, p dto.ImportFavoritesParams) (dto.ImportJob, error) {
+	ctx, span := trace.NewSpan(ctx, "favorites.importFavorites")
+	defer span.End()
+
+	if err := p.Validate(); err != nil {
+		logger.ErrorF(ctx, "invalid params", logger.Fields{
+			"error":     err.Error(),
+			"client_id": p.ClientID,
+		})
+
+		return dto.ImportJob{}, err
+	}
+
+	if len(p.Rows) > u.opts.MaxRows {
+		return dto.ImportJob{}, domainerror.New(
+			domainerror.InvalidParams,
+			fmt.Sprintf("é permitido no máximo %d favoritos por importação", u.opts.MaxRows),
+			map[string]any{
+				"rows_count": len(p.Rows),
+				"max_rows":   u.opts.MaxRows,
+			})
+	}
+
+	limit, _, err := favoritesLimit(ctx, u.favorites, u.users, p.ClientID, u.quota)
+	if err != nil {
+		return dto.ImportJob{}, err
+	}
+
+	job := dto.ImportJob{
+		ID:        uuid.NextID(),
+		ClientID:  p.ClientID,
+		Status:    dto.ImportJobPending,
+		Rows:      len(p.Rows),
+		CreatedAt: time.Now(),
+	}
+
+	if len(p.Rows) <= u.opts.SyncMaxRows {
+		report, err := u.importRows(ctx, p, limit)
+		if err != nil {
+			return dto.ImportJob{}, err
+		}
+
+		job = finishImportJob(job, report, nil)
+		if err := u.saveJob(ctx, job); err != nil {
+			logger.WarnF(ctx, "imported favorites without tracking the job", logger.Fields{
+				"job_id": job.ID,
+				"error":  err.Error(),
+			})
+		}
+
+		return job, nil
+	}
+
+	if err := u.saveJob(ctx, job); err != nil {
+		return dto.ImportJob{}, domainerror.Wrap(err, domainerror.DependecyError, "erro ao iniciar importação", map[string]any{
+			"client_id": p.ClientID,
+			"error":     err.Error(),
+		})
+	}
+
+	err = u.runner.Go(ctx, "favorites.importFavoritesJob", func(ctx context.Context) error {
+		return u.runJob(ctx, job, p, limit)
+	})
+	if err != nil {
+		_ = u.saveJob(ctx, finishImportJob(job, dto.ImportFavoritesReport{}, errImportInterrupted))
+
+		return dto.ImportJob{}, domainerror.Wrap(err, domainerror.DependecyError, "erro ao iniciar importação", map[string]any{
+			"client_id": p.ClientID,
+			"error":     err.Error(),
+		})
+	}
+
+	return job, nil
+}
+
+// runJob is canceled by the shutdown or when it runs past JobTimeout, then the job is saved as failed
+func (u *importFavoritesUseCase) runJob(ctx context.Context, job dto.ImportJob, p dto.ImportFavoritesParams, limit int) error {
+	ctx, span := trace.NewSpan(ctx, "favorites.importFavoritesJob")
+	defer span.End()
+
+	if u.opts.JobTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithDeadline(ctx, job.CreatedAt.Add(u.opts.JobTimeout))
+		defer cancel()
+	}
+
+	report, err := u.importRows(ctx, p, limit)
+	if ctx.Err() != nil {
+		err = errImportInterrupted
+	}
+
+	job = finishImportJob(job, report, err)
+
+	if err := u.saveJob(context.WithoutCancel(ctx), job); err != nil {
+		return err
+	}
+
+	logger.InfoF(ctx, "favorites import finished", logger.Fields{
+		"job_id":    job.ID,
+		"client_id": job.ClientID,
+		"status":    job.Status,
+	})
+
+	return nil
+}
+
+// importRows imports the favorites in batches, a row is only added when its product exists, it isn't a favorite yet
+// and the client still has room for it
+func (u *importFavoritesUseCase) importRows(ctx context.Context, p dto.ImportFavoritesParams, limit int) (dto.ImportFavoritesReport, error) {
+	results := make([]dto.ImportRowResult, 0, len(p.Rows))
+	pending := make([]dto.ImportRow, 0, len(p.Rows))
+	seen := make(map[int]bool, len(p.Rows))
+
+	for _, row := range p.Rows {
+		switch {
+		case row.Error != "":
+			results = append(results, dto.ImportRowResult{Row: row.Row, ProductID: row.ProductID, Status: dto.BatchItemInvalid, Error: row.Error})
+		case seen[row.ProductID]:
+			results = append(results, dto.ImportRowResult{Row: row.Row, ProductID: row.ProductID, Status: dto.BatchItemDuplicate})
+		default:
+			seen[row.ProductID] = true
+			pending = append(pending, row)
+		}
+	}
+
+	for start := 0; start < len(pending); start += u.opts.BatchSize {
+		end := min(start+u.opts.BatchSize, len(pending))
+
+		rr, err := u.importBatch(ctx, p.ClientID, pending[start:end], limit)
+		if err != nil {
+			return dto.ImportFavoritesReport{}, err
+		}
+
+		results = append(results, rr...)
+	}
+
+	return dto.NewImportFavoritesReport(results), nil
+}
+
+func (u *importFavoritesUseCase) importBatch(ctx context.Context, clientID uuid.ID, rows []dto.ImportRow, limit int) ([]dto.ImportRowResult, error) {
+	ids := make([]int, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.ProductID)
+	}
+
+	pp, err := u.products.FindMultiple(ctx, ids)
+	if err != nil {
+		// Products only unknown because the source failed are an outage, not products to reject
+		if nfErr, ok := err.(*product.ErrProductsNotFound); !ok || nfErr.Cause != nil {
+			logger.ErrorF(ctx, "error while trying to find products", logger.Fields{
+				"product_ids": ids,
+				"error":       err.Error(),
+			})
+
+			return nil, domainerror.Wrap(err, domainerror.DependecyError, "erro ao obter dados dos produtos", map[string]any{
+				"product_ids": ids,
+				"error":       err.Error(),
+			})
+		}
+	}
+
+	found := make(map[int]product.Product, len(pp))
+	foundIDs := make([]int, 0, len(pp))
+	for _, pd := range pp {
+		found[pd.ID] = pd
+		foundIDs = append(foundIDs, pd.ID)
+	}
+
+	existing := make(map[int]bool)
+	if len(foundIDs) > 0 {
+		ff, err := u.favorites.FindMultiple(ctx, clientID, foundIDs)
+		if err != nil {
+			logger.ErrorF(ctx, "error while trying to find favorites", logger.Fields{
+				"client_id":   clientID,
+				"product_ids": foundIDs,
+				"error":       err.Error(),
+			})
+
+			return nil, domainerror.Wrap(err, domainerror.DependecyError, "erro ao buscar favoritos", map[string]any{
+				"client_id":   clientID,
+				"product_ids": foundIDs,
+				"error":       err.Error(),
+			})
+		}
+
+		for _, f := range ff {
+			existing[f.ProductID] = true
+		}
+	}
+
+	results := make([]dto.ImportRowResult, 0, len(rows))
+	ff := make([]favorite.Favorite, 0, len(rows))
+	added := make([]int, 0, len(rows))
+
+	for _, row := range rows {
+		res := dto.ImportRowResult{Row: row.Row, ProductID: row.ProductID}
+
+		pd, ok := found[row.ProductID]
+		switch {
+		case !ok:
+			res.Status = dto.BatchItemNotFound
+		case existing[row.ProductID]:
+			res.Status = dto.BatchItemDuplicate
+		default:
+			f, err := newImportedFavorite(clientID, row, pd)
+			if err != nil {
+				res.Status = dto.BatchItemInvalid
+				res.Error = errorMessage(err)
+				break
+			}
+
+			res.Status = dto.BatchItemAdded
+			ff = append(ff, f)
+			added = append(added, len(results))
+		}
+
+		results = append(results, res)
+	}
+
+	// When the batch doesn't fit in the quota the first rows are kept, so the report matches the order of the file.
+	// A favorite added meanwhile by another request is reported as duplicate and the batch is retried without it
+	for len(ff) > 0 {
+		err := u.favorites.CreateWithinQuota(ctx, clientID, ff, limit, favoriteEvents(ctx, favorite.EventAdded, ff...)...)
+		if err == nil {
+			break
+		}
+
+		if qErr, ok := err.(*favorite.ErrQuotaExceeded); ok {
+			n := qErr.Available()
+			for _, idx := range added[n:] {
+				results[idx].Status = dto.BatchItemQuotaExceeded
+			}
+
+			ff, added = ff[:n], added[:n]
+			continue
+		}
+
+		if aErr, ok := err.(*favorite.ErrAlreadyFavorite); ok {
+			if i := slices.IndexFunc(ff, func(f favorite.Favorite) bool { return f.ProductID == aErr.ProductID }); i >= 0 {
+				results[added[i]].Status = dto.BatchItemDuplicate
+
+				ff, added = slices.Delete(ff, i, i+1), slices.Delete(added, i, i+1)
+				continue
+			}
+		}
+
+		logger.ErrorF(ctx, "error while trying to create favorites", logger.Fields{
+			"client_id": clientID,
+			"error":     err.Error(),
+		})
+
+		return nil, domainerror.Wrap(err, domainerror.DependecyError, "erro ao adicionar os produtos aos favoritos", map[string]any{
+			"client_id": clientID,
+			"error":     err.Error(),
+		})
+	}
+
+	return results, nil
+}
+
+func (u *importFavoritesUseCase) saveJob(ctx context.Context, job dto.ImportJob) error {
+	key := importJobKey(job.ClientID, job.ID)
+
+	data, err := json.Marshal(job)
+	if err != nil {
+		logger.ErrorF(ctx, "failed to marshal import job", logger.Fields{
+			"key":   key,
+			"error": err.Error(),
+		})
+
+		return err
+	}
+
+	if err := u.cache.Set(ctx, key, data, u.opts.JobTTL); err != nil {
+		logger.ErrorF(ctx, "failed to save import job on cache", logger.Fields{
+			"key":   key,
+			"error": err.Error(),
+		})
+
+		return err
+	}
+
+	return nil
+}
+
+func newImportedFavorite(clientID uuid.ID, row dto.ImportRow, pd product.Product) (favorite.Favorite, error) {
+	f, err := favorite.New(clientID, row.ProductID)
+	if err != nil {
+		return favorite.Favorite{}, err
+	}
+
+	if err := f.Annotate(row.Note, row.Tags); err != nil {
+		return favorite.Favorite{}, err
+	}
+
+	f.Snapshot(pd.Title, pd.Price)
+
+	return f, nil
+}
+
+func finishImportJob(job dto.ImportJob, report dto.ImportFavoritesReport, err error) dto.ImportJob {
+	now := time.Now()
+	job.FinishedAt = &now
+
+	if err != nil {
+		job.Status = dto.ImportJobFailed
+		job.Error = errorMessage(err)
+
+		return job
+	}
+
+	job.Status = dto.ImportJobDone
+	job.Report = &report
+
+	return job
+}
+
+// errorMessage returns the message of domain errors without the code and the cause, so it can be shown to the client
+func errorMessage(err error) string {
+	var dErr *domainerror.Error
+	if errors.As(err, &dErr) {
+		return dErr.Message
+	}
+
+	return err.Error()
+}
+
+func importJobKey(clientID, jobID uuid.ID) string {
+	return fmt.Sprintf("favorites-import:%s:%s", clientID, jobID)
+}
@@ -0,0 +1,420 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	fixtureFavorite "github.com/uesleicarvalhoo/aiqfome/favorite/fixture"
+	mocksFavorite "github.com/uesleicarvalhoo/aiqfome/favorite/mocks"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	fixtureDto "github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto/fixture"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/usecase"
+	"github.com/uesleicarvalhoo/aiqfome/internal/worker"
+	mocksCache "github.com/uesleicarvalhoo/aiqfome/pkg/cache/mocks"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/product"
+	fixtureProd "github.com/uesleicarvalhoo/aiqfome/product/fixture"
+	mocksProduct "github.com/uesleicarvalhoo/aiqfome/product/mocks"
+	"github.com/uesleicarvalhoo/aiqfome/role"
+	"github.com/uesleicarvalhoo/aiqfome/test"
+	"github.com/uesleicarvalhoo/aiqfome/user"
+	fixtureUser "github.com/uesleicarvalhoo/aiqfome/user/fixture"
+	mocksUser "github.com/uesleicarvalhoo/aiqfome/user/mocks"
+)
+
+func TestImportFavoritesUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	clientID := uuid.NextID()
+	opts := usecase.ImportOptions{MaxRows: 10, SyncMaxRows: 5, BatchSize: 2, JobTTL: time.Hour}
+	quota := usecase.QuotaOptions{ByRole: map[role.Role]int{role.RoleClient: 5}}
+
+	paramsBuilder := fixtureDto.AnyImportFavoritesParams().WithClientID(clientID)
+	productBuilder := fixtureProd.AnyProduct()
+
+	rows := []dto.ImportRow{
+		{Row: 1, ProductID: 1, Note: "presente", Tags: []string{"Natal"}},
+		{Row: 2, Tags: []string{}, Error: "id do produto inválido"},
+		{Row: 3, ProductID: 2, Tags: []string{}},
+		{Row: 4, ProductID: 1, Tags: []string{}},
+		{Row: 5, ProductID: 3, Tags: []string{}},
+	}
+
+	jobKey := mock.MatchedBy(func(key string) bool {
+		return strings.HasPrefix(key, "favorites-import:"+clientID.String()+":")
+	})
+
+	testCases := []struct {
+		about          string
+		params         dto.ImportFavoritesParams
+		setupProducts  func(m *mocksProduct.Reader)
+		setupFavorites func(m *mocksFavorite.Repository)
+		setupUsers     func(m *mocksUser.Reader)
+		setupCache     func(m *mocksCache.Cache)
+		expectedErr    string
+		expectedStatus dto.ImportJobStatus
+		expectedReport *dto.ImportFavoritesReport
+	}{
+		{
+			about:       "when params are invalid",
+			params:      dto.ImportFavoritesParams{},
+			expectedErr: "[AQF002] clientId: campo obrigatório; rows: o arquivo não possui favoritos",
+		},
+		{
+			about:       "when file has too many rows",
+			params:      paramsBuilder.WithRows(make([]dto.ImportRow, 11)).Build(),
+			expectedErr: "[AQF002] é permitido no máximo 10 favoritos por importação",
+		},
+		{
+			about:  "when client is not found",
+			params: paramsBuilder.WithRows(rows).Build(),
+			setupFavorites: func(m *mocksFavorite.Repository) {
+				m.On("FindQuota", mock.Anything, clientID).
+					Return(favorite.Quota{}, &favorite.ErrQuotaNotFound{ClientID: clientID})
+			},
+			setupUsers: func(m *mocksUser.Reader) {
+				m.On("Find", mock.Anything, clientID).
+					Return(user.User{}, user.ErrNotFound)
+			},
+			expectedErr: "[AQF003] cliente não encontrado",
+		},
+		{
+			about:  "when product reader fails",
+			params: paramsBuilder.WithRows(rows).Build(),
+			setupProducts: func(m *mocksProduct.Reader) {
+				m.On("FindMultiple", mock.Anything, []int{1, 2}).
+					Return([]product.Product{}, errors.New("service down"))
+			},
+			setupFavorites: func(m *mocksFavorite.Repository) {
+				m.On("FindQuota", mock.Anything, clientID).
+					Return(favorite.Quota{ClientID: clientID, MaxFavorites: 10}, nil)
+			},
+			expectedErr: "[AQF004] erro ao obter dados dos produtos",
+		},
+		{
+			about:  "when create many fails",
+			params: paramsBuilder.WithRows(rows).Build(),
+			setupProducts: func(m *mocksProduct.Reader) {
+				m.On("FindMultiple", mock.Anything, []int{1, 2}).
+					Return([]product.Product{productBuilder.WithID(1).Build(), productBuilder.WithID(2).Build()}, nil)
+			},
+			setupFavorites: func(m *mocksFavorite.Repository) {
+				m.On("FindMultiple", mock.Anything, clientID, []int{1, 2}).
+					Return([]favorite.Favorite{}, nil)
+				m.On("FindQuota", mock.Anything, clientID).
+					Return(favorite.Quota{ClientID: clientID, MaxFavorites: 10}, nil)
+				m.On("CreateWithinQuota", mock.Anything, clientID, mock.Anything, 10, test.MatchEvent(favorite.EventAdded), test.MatchEvent(favorite.EventAdded)).
+					Return(errors.New("db error"))
+			},
+			expectedErr: "[AQF004] erro ao adicionar os produtos aos favoritos",
+		},
+		{
+			about:  "when file is small",
+			params: paramsBuilder.WithRows(rows).Build(),
+			setupProducts: func(m *mocksProduct.Reader) {
+				m.On("FindMultiple", mock.Anything, []int{1, 2}).
+					Return([]product.Product{productBuilder.WithID(1).Build(), productBuilder.WithID(2).Build()}, nil)
+				m.On("FindMultiple", mock.Anything, []int{3}).
+					Return([]product.Product{}, &product.ErrProductsNotFound{IDs: []int{3}})
+			},
+			setupFavorites: func(m *mocksFavorite.Repository) {
+				m.On("FindMultiple", mock.Anything, clientID, []int{1, 2}).
+					Return([]favorite.Favorite{fixtureFavorite.AnyFavorite().WithClientID(clientID).WithProductID(2).Build()}, nil)
+				m.On("FindQuota", mock.Anything, clientID).
+					Return(favorite.Quota{}, &favorite.ErrQuotaNotFound{ClientID: clientID})
+				m.On("CreateWithinQuota", mock.Anything, clientID, mock.MatchedBy(func(ff []favorite.Favorite) bool {
+					pd := productBuilder.WithID(1).Build()
+
+					return len(ff) == 1 && ff[0].ClientID == clientID && ff[0].ProductID == 1 &&
+						ff[0].Note == "presente" && assert.ObjectsAreEqual([]string{"natal"}, ff[0].Tags) &&
+						ff[0].TitleWhenFavorited == pd.Title
+				}), 0, test.MatchEvent(favorite.EventAdded)).Return(nil)
+			},
+			setupUsers: func(m *mocksUser.Reader) {
+				m.On("Find", mock.Anything, clientID).
+					Return(fixtureUser.AnyUser().WithID(clientID).WithRole(role.RoleAdmin).Build(), nil)
+			},
+			setupCache: func(m *mocksCache.Cache) {
+				m.On("Set", mock.Anything, jobKey, mock.Anything, time.Hour).Return(nil)
+			},
+			expectedStatus: dto.ImportJobDone,
+			expectedReport: &dto.ImportFavoritesReport{
+				Total:      5,
+				Added:      1,
+				Duplicates: 2,
+				NotFound:   1,
+				Invalid:    1,
+				Rows: []dto.ImportRowResult{
+					{Row: 1, ProductID: 1, Status: dto.BatchItemAdded},
+					{Row: 2, Status: dto.BatchItemInvalid, Error: "id do produto inválido"},
+					{Row: 3, ProductID: 2, Status: dto.BatchItemDuplicate},
+					{Row: 4, ProductID: 1, Status: dto.BatchItemDuplicate},
+					{Row: 5, ProductID: 3, Status: dto.BatchItemNotFound},
+				},
+			},
+		},
+		{
+			about:  "when file doesn't fit in the quota",
+			params: paramsBuilder.WithRows(rows).Build(),
+			setupProducts: func(m *mocksProduct.Reader) {
+				m.On("FindMultiple", mock.Anything, []int{1, 2}).
+					Return([]product.Product{productBuilder.WithID(1).Build(), productBuilder.WithID(2).Build()}, nil)
+				m.On("FindMultiple", mock.Anything, []int{3}).
+					Return([]product.Product{}, &product.ErrProductsNotFound{IDs: []int{3}})
+			},
+			setupFavorites: func(m *mocksFavorite.Repository) {
+				m.On("FindMultiple", mock.Anything, clientID, []int{1, 2}).
+					Return([]favorite.Favorite{}, nil)
+				m.On("FindQuota", mock.Anything, clientID).
+					Return(favorite.Quota{ClientID: clientID, MaxFavorites: 3}, nil)
+				m.On("CreateWithinQuota", mock.Anything, clientID, mock.MatchedBy(func(ff []favorite.Favorite) bool {
+					return len(ff) == 2
+				}), 3, test.MatchEvent(favorite.EventAdded), test.MatchEvent(favorite.EventAdded)).Return(&favorite.ErrQuotaExceeded{ClientID: clientID, Limit: 3, Count: 2})
+				m.On("CreateWithinQuota", mock.Anything, clientID, mock.MatchedBy(func(ff []favorite.Favorite) bool {
+					return len(ff) == 1 && ff[0].ProductID == 1
+				}), 3, test.MatchEvent(favorite.EventAdded)).Return(nil)
+			},
+			setupCache: func(m *mocksCache.Cache) {
+				m.On("Set", mock.Anything, jobKey, mock.Anything, time.Hour).Return(nil)
+			},
+			expectedStatus: dto.ImportJobDone,
+			expectedReport: &dto.ImportFavoritesReport{
+				Total:         5,
+				Added:         1,
+				Duplicates:    1,
+				NotFound:      1,
+				Invalid:       1,
+				QuotaExceeded: 1,
+				Rows: []dto.ImportRowResult{
+					{Row: 1, ProductID: 1, Status: dto.BatchItemAdded},
+					{Row: 2, Status: dto.BatchItemInvalid, Error: "id do produto inválido"},
+					{Row: 3, ProductID: 2, Status: dto.BatchItemQuotaExceeded},
+					{Row: 4, ProductID: 1, Status: dto.BatchItemDuplicate},
+					{Row: 5, ProductID: 3, Status: dto.BatchItemNotFound},
+				},
+			},
+		},
+		{
+			about:  "when a product is favorited meanwhile by another request",
+			params: paramsBuilder.WithRows(rows).Build(),
+			setupProducts: func(m *mocksProduct.Reader) {
+				m.On("FindMultiple", mock.Anything, []int{1, 2}).
+					Return([]product.Product{productBuilder.WithID(1).Build(), productBuilder.WithID(2).Build()}, nil)
+				m.On("FindMultiple", mock.Anything, []int{3}).
+					Return([]product.Product{}, &product.ErrProductsNotFound{IDs: []int{3}})
+			},
+			setupFavorites: func(m *mocksFavorite.Repository) {
+				m.On("FindMultiple", mock.Anything, clientID, []int{1, 2}).
+					Return([]favorite.Favorite{}, nil)
+				m.On("FindQuota", mock.Anything, clientID).
+					Return(favorite.Quota{ClientID: clientID, MaxFavorites: 10}, nil)
+				m.On("CreateWithinQuota", mock.Anything, clientID, mock.MatchedBy(func(ff []favorite.Favorite) bool {
+					return len(ff) == 2
+				}), 10, test.MatchEvent(favorite.EventAdded), test.MatchEvent(favorite.EventAdded)).Return(&favorite.ErrAlreadyFavorite{ClientID: clientID, ProductID: 2})
+				m.On("CreateWithinQuota", mock.Anything, clientID, mock.MatchedBy(func(ff []favorite.Favorite) bool {
+					return len(ff) == 1 && ff[0].ProductID == 1
+				}), 10, test.MatchEvent(favorite.EventAdded)).Return(nil)
+			},
+			setupCache: func(m *mocksCache.Cache) {
+				m.On("Set", mock.Anything, jobKey, mock.Anything, time.Hour).Return(nil)
+			},
+			expectedStatus: dto.ImportJobDone,
+			expectedReport: &dto.ImportFavoritesReport{
+				Total:      5,
+				Added:      1,
+				Duplicates: 2,
+				NotFound:   1,
+				Invalid:    1,
+				Rows: []dto.ImportRowResult{
+					{Row: 1, ProductID: 1, Status: dto.BatchItemAdded},
+					{Row: 2, Status: dto.BatchItemInvalid, Error: "id do produto inválido"},
+					{Row: 3, ProductID: 2, Status: dto.BatchItemDuplicate},
+					{Row: 4, ProductID: 1, Status: dto.BatchItemDuplicate},
+					{Row: 5, ProductID: 3, Status: dto.BatchItemNotFound},
+				},
+			},
+		},
+		{
+			about:  "when file is big and the job can't be saved",
+			params: paramsBuilder.WithRows(make([]dto.ImportRow, 6)).Build(),
+			setupFavorites: func(m *mocksFavorite.Repository) {
+				m.On("FindQuota", mock.Anything, clientID).
+					Return(favorite.Quota{ClientID: clientID, MaxFavorites: 10}, nil)
+			},
+			setupCache: func(m *mocksCache.Cache) {
+				m.On("Set", mock.Anything, jobKey, mock.Anything, time.Hour).Return(errors.New("cache down"))
+			},
+			expectedErr: "[AQF004] erro ao iniciar importação",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			prodReader := mocksProduct.NewReader(t)
+			if tc.setupProducts != nil {
+				tc.setupProducts(prodReader)
+			}
+
+			favRepo := mocksFavorite.NewRepository(t)
+			if tc.setupFavorites != nil {
+				tc.setupFavorites(favRepo)
+			}
+
+			userReader := mocksUser.NewReader(t)
+			if tc.setupUsers != nil {
+				tc.setupUsers(userReader)
+			}
+
+			cache := mocksCache.NewCache(t)
+			if tc.setupCache != nil {
+				tc.setupCache(cache)
+			}
+
+			uc := usecase.NewImportFavoritesUseCase(prodReader, favRepo, userReader, cache, worker.NewBackground(), opts, quota)
+
+			// Action
+			job, err := uc.Execute(context.Background(), tc.params)
+
+			// Assert
+			if tc.expectedErr != "" {
+				assert.Equal(t, dto.ImportJob{}, job)
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.False(t, job.ID.IsZero())
+				assert.Equal(t, clientID, job.ClientID)
+				assert.Equal(t, tc.expectedStatus, job.Status)
+				assert.Equal(t, tc.expectedReport, job.Report)
+			}
+
+			prodReader.AssertExpectations(t)
+			favRepo.AssertExpectations(t)
+			userReader.AssertExpectations(t)
+			cache.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImportFavoritesUseCase_ExecuteInBackground(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	clientID := uuid.NextID()
+	rows := make([]dto.ImportRow, 0, 3)
+	for i := 1; i <= 3; i++ {
+		rows = append(rows, dto.ImportRow{Row: i, ProductID: i, Tags: []string{}})
+	}
+
+	prodReader := mocksProduct.NewReader(t)
+	prodReader.On("FindMultiple", mock.Anything, []int{1, 2, 3}).
+		Return([]product.Product{}, &product.ErrProductsNotFound{IDs: []int{1, 2, 3}})
+
+	finished := make(chan dto.ImportJob, 1)
+	cache := mocksCache.NewCache(t)
+	cache.On("Set", mock.Anything, mock.AnythingOfType("string"), mock.Anything, time.Hour).
+		Run(func(args mock.Arguments) {
+			var job dto.ImportJob
+			require.NoError(t, json.Unmarshal(args.Get(2).([]byte), &job))
+
+			if job.IsDone() {
+				finished <- job
+			}
+		}).
+		Return(nil)
+
+	favRepo := mocksFavorite.NewRepository(t)
+	favRepo.On("FindQuota", mock.Anything, clientID).
+		Return(favorite.Quota{ClientID: clientID, MaxFavorites: 10}, nil)
+
+	uc := usecase.NewImportFavoritesUseCase(prodReader, favRepo, mocksUser.NewReader(t), cache, worker.NewBackground(), usecase.ImportOptions{
+		MaxRows:     10,
+		SyncMaxRows: 2,
+		BatchSize:   5,
+		JobTTL:      time.Hour,
+	}, usecase.QuotaOptions{})
+
+	// Action
+	job, err := uc.Execute(context.Background(), fixtureDto.AnyImportFavoritesParams().WithClientID(clientID).WithRows(rows).Build())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, dto.ImportJobPending, job.Status)
+	assert.Nil(t, job.Report)
+
+	select {
+	case done := <-finished:
+		assert.Equal(t, job.ID, done.ID)
+		assert.Equal(t, dto.ImportJobDone, done.Status)
+		require.NotNil(t, done.Report)
+		assert.Equal(t, 3, done.Report.NotFound)
+	case <-time.After(time.Second):
+		t.Fatal("import job didn't finish")
+	}
+}
+
+func TestImportFavoritesUseCase_ExecuteInBackground_Interrupted(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	clientID := uuid.NextID()
+	rows := make([]dto.ImportRow, 0, 3)
+	for i := 1; i <= 3; i++ {
+		rows = append(rows, dto.ImportRow{Row: i, ProductID: i, Tags: []string{}})
+	}
+
+	prodReader := mocksProduct.NewReader(t)
+	prodReader.On("FindMultiple", mock.Anything, []int{1, 2, 3}).
+		Run(func(args mock.Arguments) {
+			<-args.Get(0).(context.Context).Done()
+		}).
+		Return([]product.Product{}, context.Canceled)
+
+	var saved dto.ImportJob
+	cache := mocksCache.NewCache(t)
+	cache.On("Set", mock.Anything, mock.AnythingOfType("string"), mock.Anything, time.Hour).
+		Run(func(args mock.Arguments) {
+			require.NoError(t, json.Unmarshal(args.Get(2).([]byte), &saved))
+		}).
+		Return(nil)
+
+	favRepo := mocksFavorite.NewRepository(t)
+	favRepo.On("FindQuota", mock.Anything, clientID).
+		Return(favorite.Quota{ClientID: clientID, MaxFavorites: 10}, nil)
+
+	runner := worker.NewBackground()
+	uc := usecase.NewImportFavoritesUseCase(prodReader, favRepo, mocksUser.NewReader(t), cache, runner, usecase.ImportOptions{
+		MaxRows:     10,
+		SyncMaxRows: 2,
+		BatchSize:   5,
+		JobTTL:      time.Hour,
+	}, usecase.QuotaOptions{})
+
+	job, err := uc.Execute(context.Background(), fixtureDto.AnyImportFavoritesParams().WithClientID(clientID).WithRows(rows).Build())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Action
+	runner.Shutdown(ctx)
+
+	// Assert
+	assert.Equal(t, job.ID, saved.ID)
+	assert.Equal(t, dto.ImportJobFailed, saved.Status)
+	assert.Equal(t, "importação interrompida, tente novamente", saved.Error)
+}
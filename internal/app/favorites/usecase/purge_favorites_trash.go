@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/domainerror"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/logger"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/trace"
+)
+
+type purgeFavoritesTrashUseCase struct {
+	repo favorite.Repository
+	opts TrashOptions
+}
+
+func NewPurgeFavoritesTrashUseCase(repo favorite.Repository, opts TrashOptions) favorites.PurgeFavoritesTrashUseCase {
+	return &purgeFavoritesTrashUseCase{
+		repo: repo,
+		opts: opts,
+	}
+}
+
+func (u *purgeFavoritesTrashUseCase) Execute(ctx context.Context) (int, error) {
+	ctx, span := trace.NewSpan(ctx, "favorites.purgeFavoritesTrash")
+	defer span.End()
+
+	cutoff := trashCutoff(u.opts)
+
+	purged, err := u.repo.PurgeTrash(ctx, cutoff)
+	if err != nil {
+		logger.ErrorF(ctx, "error while trying to purge favorites trash", logger.Fields{
+			"deleted_before": cutoff,
+			"error":          err.Error(),
+		})
+
+		return 0, domainerror.Wrap(err, domainerror.DependecyError, "erro ao esvaziar lixeira", map[string]any{
+			"deleted_before": cutoff,
+			"error":          err.Error(),
+		})
+	}
+
+	logger.InfoF(ctx, "favorites trash purged", logger.Fields{
+		"deleted_before": cutoff,
+		"purged":         purged,
+	})
+
+	return purged, nil
+}
@@ -0,0 +1,72 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	favMocks "github.com/uesleicarvalhoo/aiqfome/favorite/mocks"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/usecase"
+)
+
+func TestPurgeFavoritesTrashUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	retention := 24 * time.Hour
+
+	testCases := []struct {
+		about          string
+		setupRepo      func(m *favMocks.Repository)
+		expectedErr    string
+		expectedPurged int
+	}{
+		{
+			about: "when purge fails",
+			setupRepo: func(m *favMocks.Repository) {
+				m.On("PurgeTrash", mock.Anything, mock.AnythingOfType("time.Time")).
+					Return(0, errors.New("db error"))
+			},
+			expectedErr: "[AQF004] erro ao esvaziar lixeira",
+		},
+		{
+			about: "when only favorites removed before the retention are purged",
+			setupRepo: func(m *favMocks.Repository) {
+				m.On("PurgeTrash", mock.Anything, mock.MatchedBy(func(before time.Time) bool {
+					return before.Before(time.Now().Add(-retention).Add(time.Second))
+				})).Return(3, nil)
+			},
+			expectedPurged: 3,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			repo := favMocks.NewRepository(t)
+			tc.setupRepo(repo)
+
+			uc := usecase.NewPurgeFavoritesTrashUseCase(repo, usecase.TrashOptions{Retention: retention})
+
+			// Action
+			purged, err := uc.Execute(context.Background())
+
+			// Assert
+			if tc.expectedErr != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedPurged, purged)
+		})
+	}
+}
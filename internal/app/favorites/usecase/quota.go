@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/domainerror"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/logger"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/role"
+	"github.com/uesleicarvalhoo/aiqfome/user"
+)
+
+type QuotaOptions struct {
+	// ByRole is the max favorites of the clients of each role, roles without a positive limit are unlimited
+	ByRole map[role.Role]int
+}
+
+// favoritesLimit returns the max favorites of the client, the quota set by an admin takes precedence over the role limit
+func favoritesLimit(ctx context.Context, favorites favorite.Reader, users user.Reader, clientID uuid.ID, opts QuotaOptions) (int, bool, error) {
+	q, err := favorites.FindQuota(ctx, clientID)
+	if err == nil {
+		return q.MaxFavorites, true, nil
+	}
+
+	if _, ok := err.(*favorite.ErrQuotaNotFound); !ok {
+		logger.ErrorF(ctx, "error while trying to find favorites quota", logger.Fields{
+			"client_id": clientID,
+			"error":     err.Error(),
+		})
+
+		return 0, false, domainerror.Wrap(err, domainerror.DependecyError, "erro ao buscar limite de favoritos", map[string]any{
+			"client_id": clientID,
+			"error":     err.Error(),
+		})
+	}
+
+	usr, err := users.Find(ctx, clientID)
+	if err != nil {
+		logger.ErrorF(ctx, "error while trying to find client", logger.Fields{
+			"client_id": clientID,
+			"error":     err.Error(),
+		})
+
+		if errors.Is(err, user.ErrNotFound) {
+			return 0, false, domainerror.Wrap(err, domainerror.ResourceNotFound, "cliente não encontrado", map[string]any{
+				"client_id": clientID,
+			})
+		}
+
+		return 0, false, domainerror.Wrap(err, domainerror.DependecyError, "erro ao buscar cliente", map[string]any{
+			"client_id": clientID,
+			"error":     err.Error(),
+		})
+	}
+
+	return max(opts.ByRole[usr.Role], 0), false, nil
+}
+
+func favoritesQuota(ctx context.Context, favorites favorite.Reader, users user.Reader, clientID uuid.ID, opts QuotaOptions) (dto.FavoritesQuota, error) {
+	limit, overridden, err := favoritesLimit(ctx, favorites, users, clientID, opts)
+	if err != nil {
+		return dto.FavoritesQuota{}, err
+	}
+
+	used, err := favorites.CountByClientID(ctx, clientID)
+	if err != nil {
+		logger.ErrorF(ctx, "error while trying to count favorites", logger.Fields{
+			"client_id": clientID,
+			"error":     err.Error(),
+		})
+
+		return dto.FavoritesQuota{}, domainerror.Wrap(err, domainerror.DependecyError, "erro ao contar favoritos", map[string]any{
+			"client_id": clientID,
+			"error":     err.Error(),
+		})
+	}
+
+	return dto.NewFavoritesQuota(clientID, limit, used, overridden), nil
+}
+
+func quotaExceeded(err *favorite.ErrQuotaExceeded) error {
+	return domainerror.Wrap(err, domainerror.FavoritesQuotaExceeded, fmt.Sprintf("limite de %d favoritos atingido", err.Limit), map[string]any{
+		"client_id": err.ClientID,
+		"limit":     err.Limit,
+		"count":     err.Count,
+	})
+}
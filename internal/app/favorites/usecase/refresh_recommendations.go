@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/domainerror"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/logger"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/trace"
+)
+
+type refreshRecommendationsUseCase struct {
+	repo favorite.Repository
+	opts RecommendationsOptions
+}
+
+func NewRefreshRecommendationsUseCase(repo favorite.Repository, opts RecommendationsOptions) favorites.RefreshRecommendationsUseCase {
+	return &refreshRecommendationsUseCase{
+		repo: repo,
+		opts: opts,
+	}
+}
+
+func (u *refreshRecommendationsUseCase) Execute(ctx context.Context) (int, error) {
+	ctx, span := trace.NewSpan(ctx, "favorites.refreshRecommendations")
+	defer span.End()
+
+	pairs, err := u.repo.RefreshSimilarities(ctx, u.opts.MaxSimilar)
+	if err != nil {
+		logger.ErrorF(ctx, "error while trying to refresh product similarities", logger.Fields{
+			"max_similar": u.opts.MaxSimilar,
+			"error":       err.Error(),
+		})
+
+		return 0, domainerror.Wrap(err, domainerror.DependecyError, "erro ao atualizar recomendações", map[string]any{
+			"max_similar": u.opts.MaxSimilar,
+			"error":       err.Error(),
+		})
+	}
+
+	logger.InfoF(ctx, "product similarities refreshed", logger.Fields{
+		"pairs": pairs,
+	})
+
+	return pairs, nil
+}
@@ -0,0 +1,66 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	favMocks "github.com/uesleicarvalhoo/aiqfome/favorite/mocks"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/usecase"
+)
+
+func TestRefreshRecommendationsUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		about         string
+		setupRepo     func(m *favMocks.Repository)
+		expectedErr   string
+		expectedPairs int
+	}{
+		{
+			about: "when refresh fails",
+			setupRepo: func(m *favMocks.Repository) {
+				m.On("RefreshSimilarities", mock.Anything, 20).Return(0, errors.New("db error"))
+			},
+			expectedErr: "[AQF004] erro ao atualizar recomendações",
+		},
+		{
+			about: "when similarities are refreshed",
+			setupRepo: func(m *favMocks.Repository) {
+				m.On("RefreshSimilarities", mock.Anything, 20).Return(42, nil)
+			},
+			expectedPairs: 42,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			repo := favMocks.NewRepository(t)
+			tc.setupRepo(repo)
+
+			uc := usecase.NewRefreshRecommendationsUseCase(repo, usecase.RecommendationsOptions{MaxSimilar: 20})
+
+			// Action
+			pairs, err := uc.Execute(context.Background())
+
+			// Assert
+			if tc.expectedErr != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedPairs, pairs)
+		})
+	}
+}
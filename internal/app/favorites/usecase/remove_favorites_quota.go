@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/domainerror"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/logger"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/trace"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+type removeFavoritesQuotaUseCase struct {
+	repo favorite.Repository
+}
+
+func NewRemoveFavoritesQuotaUseCase(repo favorite.Repository) favorites.RemoveFavoritesQuotaUseCase {
+	return &removeFavoritesQuotaUseCase{
+		repo: repo,
+	}
+}
+
+func (u *removeFavoritesQuotaUseCase) Execute(ctx context.Context, clientID uuid.ID) error {
+	ctx, span := trace.NewSpan(ctx, "favorites.removeFavoritesQuota")
+	defer span.End()
+
+	if _, err := u.repo.FindQuota(ctx, clientID); err != nil {
+		logger.ErrorF(ctx, "error while trying to find favorites quota", logger.Fields{
+			"client_id": clientID,
+			"error":     err.Error(),
+		})
+
+		if _, ok := err.(*favorite.ErrQuotaNotFound); ok {
+			return domainerror.Wrap(err, domainerror.ResourceNotFound, "limite de favoritos não encontrado", map[string]any{
+				"client_id": clientID,
+			})
+		}
+
+		return domainerror.Wrap(err, domainerror.DependecyError, "erro ao buscar limite de favoritos", map[string]any{
+			"client_id": clientID,
+			"error":     err.Error(),
+		})
+	}
+
+	if err := u.repo.DeleteQuota(ctx, clientID); err != nil {
+		logger.ErrorF(ctx, "error while trying to delete favorites quota", logger.Fields{
+			"client_id": clientID,
+			"error":     err.Error(),
+		})
+
+		return domainerror.Wrap(err, domainerror.DependecyError, "erro ao remover limite de favoritos", map[string]any{
+			"client_id": clientID,
+			"error":     err.Error(),
+		})
+	}
+
+	return nil
+}
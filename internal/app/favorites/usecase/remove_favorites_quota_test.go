@@ -0,0 +1,84 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	mocksFavorite "github.com/uesleicarvalhoo/aiqfome/favorite/mocks"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/usecase"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+func TestRemoveFavoritesQuotaUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	clientID := uuid.NextID()
+
+	testCases := []struct {
+		about          string
+		setupFavorites func(m *mocksFavorite.Repository)
+		expectedErr    string
+	}{
+		{
+			about: "when quota is not found",
+			setupFavorites: func(m *mocksFavorite.Repository) {
+				m.On("FindQuota", mock.Anything, clientID).
+					Return(favorite.Quota{}, &favorite.ErrQuotaNotFound{ClientID: clientID})
+			},
+			expectedErr: "[AQF003] limite de favoritos não encontrado",
+		},
+		{
+			about: "when delete fails",
+			setupFavorites: func(m *mocksFavorite.Repository) {
+				m.On("FindQuota", mock.Anything, clientID).
+					Return(favorite.Quota{ClientID: clientID, MaxFavorites: 10}, nil)
+				m.On("DeleteQuota", mock.Anything, clientID).
+					Return(errors.New("db error"))
+			},
+			expectedErr: "[AQF004] erro ao remover limite de favoritos",
+		},
+		{
+			about: "when all is valid",
+			setupFavorites: func(m *mocksFavorite.Repository) {
+				m.On("FindQuota", mock.Anything, clientID).
+					Return(favorite.Quota{ClientID: clientID, MaxFavorites: 10}, nil)
+				m.On("DeleteQuota", mock.Anything, clientID).
+					Return(nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			favRepo := mocksFavorite.NewRepository(t)
+			if tc.setupFavorites != nil {
+				tc.setupFavorites(favRepo)
+			}
+
+			uc := usecase.NewRemoveFavoritesQuotaUseCase(favRepo)
+
+			// Action
+			err := uc.Execute(context.Background(), clientID)
+
+			// Assert
+			if tc.expectedErr != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			favRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -49,7 +49,7 @@ func (u removeProductFromFavoritesUseCase) Execute(ctx context.Context, p dto.Re
 		})
 	}
 
-	if err := u.repo.Remove(ctx, f); err != nil {
+	if err := u.repo.Remove(ctx, f, favoriteEvents(ctx, favorite.EventRemoved, f)...); err != nil {
 		return domainerror.Wrap(err, domainerror.DependecyError, "error while trying to remove favorite", map[string]any{
 			"client_id":  p.ClientID,
 			"product_id": p.ProductID,
@@ -8,12 +8,17 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	"github.com/uesleicarvalhoo/aiqfome/event"
 	"github.com/uesleicarvalhoo/aiqfome/favorite"
 	mocksFavorite "github.com/uesleicarvalhoo/aiqfome/favorite/mocks"
+	appContext "github.com/uesleicarvalhoo/aiqfome/internal/app/context"
 	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
 	fixtureDto "github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto/fixture"
 	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/usecase"
 	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/role"
+	"github.com/uesleicarvalhoo/aiqfome/test"
+	fixtureUser "github.com/uesleicarvalhoo/aiqfome/user/fixture"
 )
 
 func TestRemoveProductFromFavoritesUseCase_Execute(t *testing.T) {
@@ -62,7 +67,7 @@ func TestRemoveProductFromFavoritesUseCase_Execute(t *testing.T) {
 			setupRepo: func(m *mocksFavorite.Repository) {
 				m.On("Find", mock.Anything, clientID, productID).
 					Return(favorite.Favorite{ClientID: clientID, ProductID: productID}, nil)
-				m.On("Remove", mock.Anything, favorite.Favorite{ClientID: clientID, ProductID: productID}).
+				m.On("Remove", mock.Anything, favorite.Favorite{ClientID: clientID, ProductID: productID}, test.MatchEvent(favorite.EventRemoved)).
 					Return(errors.New("db remove error"))
 			},
 			expectedErr: "[AQF004] error while trying to remove favorite",
@@ -73,7 +78,7 @@ func TestRemoveProductFromFavoritesUseCase_Execute(t *testing.T) {
 			setupRepo: func(m *mocksFavorite.Repository) {
 				m.On("Find", mock.Anything, clientID, productID).
 					Return(favorite.Favorite{ClientID: clientID, ProductID: productID}, nil)
-				m.On("Remove", mock.Anything, favorite.Favorite{ClientID: clientID, ProductID: productID}).
+				m.On("Remove", mock.Anything, favorite.Favorite{ClientID: clientID, ProductID: productID}, test.MatchEvent(favorite.EventRemoved)).
 					Return(nil)
 			},
 			expectedErr: "",
@@ -109,3 +114,27 @@ func TestRemoveProductFromFavoritesUseCase_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestRemoveProductFromFavoritesUseCase_ExecuteOnBehalf(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	clientID := uuid.NextID()
+	admin := fixtureUser.AnyUser().WithRole(role.RoleAdmin).Build()
+	f := favorite.Favorite{ClientID: clientID, ProductID: 1}
+
+	repo := mocksFavorite.NewRepository(t)
+	repo.On("Find", mock.Anything, clientID, 1).Return(f, nil)
+	repo.On("Remove", mock.Anything, f, mock.MatchedBy(func(e event.Event) bool {
+		return e.Type == favorite.EventRemoved && e.ActorID != nil && *e.ActorID == admin.ID
+	})).Return(nil)
+
+	uc := usecase.NewRemoveProductFromFavoritesUseCase(repo)
+	ctx := appContext.ContextWithUser(context.Background(), admin)
+
+	// Action
+	err := uc.Execute(ctx, fixtureDto.AnyRemoveProductFromFavoritesParams().WithClientID(clientID).WithProductID(1).Build())
+
+	// Assert
+	assert.NoError(t, err)
+}
@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/domainerror"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/logger"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/trace"
+)
+
+type removeProductFromListUseCase struct {
+	repo favorite.Repository
+}
+
+func NewRemoveProductFromListUseCase(repo favorite.Repository) favorites.RemoveProductFromListUseCase {
+	return &removeProductFromListUseCase{
+		repo: repo,
+	}
+}
+
+func (u *removeProductFromListUseCase) Execute(ctx context.Context, p dto.RemoveProductFromListParams) error {
+	ctx, span := trace.NewSpan(ctx, "favorites.removeProductFromList")
+	defer span.End()
+
+	if err := p.Validate(); err != nil {
+		logger.ErrorF(ctx, "invalid params", logger.Fields{
+			"params": p,
+			"error":  err.Error(),
+		})
+		return err
+	}
+
+	l, err := findClientList(ctx, u.repo, p.ClientID, p.ListID)
+	if err != nil {
+		return err
+	}
+
+	i, err := u.repo.FindListItem(ctx, l.ID, p.ProductID)
+	if err != nil {
+		if nfErr, ok := err.(*favorite.ErrListItemNotFound); ok {
+			return domainerror.New(domainerror.ResourceNotFound, "produto não encontrado na lista", map[string]any{
+				"list_id":    nfErr.ListID,
+				"product_id": nfErr.ProductID,
+			})
+		}
+
+		return domainerror.Wrap(err, domainerror.DependecyError, "erro ao buscar produto na lista", map[string]any{
+			"list_id":    l.ID,
+			"product_id": p.ProductID,
+			"error":      err.Error(),
+		})
+	}
+
+	if err := u.repo.RemoveListItem(ctx, i); err != nil {
+		return domainerror.Wrap(err, domainerror.DependecyError, "erro ao remover produto da lista", map[string]any{
+			"list_id":    l.ID,
+			"product_id": p.ProductID,
+			"error":      err.Error(),
+		})
+	}
+
+	return nil
+}
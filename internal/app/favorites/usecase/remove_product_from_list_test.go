@@ -0,0 +1,139 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	fixtureFavorite "github.com/uesleicarvalhoo/aiqfome/favorite/fixture"
+	mocksFavorite "github.com/uesleicarvalhoo/aiqfome/favorite/mocks"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	fixtureDto "github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto/fixture"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/usecase"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+func TestRemoveProductFromListUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	clientID := uuid.NextID()
+	listID := uuid.NextID()
+	productID := 1
+
+	paramsBuilder := fixtureDto.AnyRemoveProductFromListParams().
+		WithClientID(clientID).
+		WithListID(listID).
+		WithProductID(productID)
+
+	list := fixtureFavorite.AnyList().
+		WithID(listID).
+		WithClientID(clientID).
+		Build()
+
+	item := fixtureFavorite.AnyListItem().
+		WithListID(listID).
+		WithProductID(productID).
+		Build()
+
+	testCases := []struct {
+		about       string
+		params      dto.RemoveProductFromListParams
+		setupRepo   func(m *mocksFavorite.Repository)
+		expectedErr string
+	}{
+		{
+			about:       "when params are invalid",
+			params:      dto.RemoveProductFromListParams{},
+			expectedErr: "clientId: campo obrigatório; listId: campo obrigatório; productId: campo obrigatório",
+		},
+		{
+			about:  "when list not found",
+			params: paramsBuilder.Build(),
+			setupRepo: func(m *mocksFavorite.Repository) {
+				m.On("FindList", mock.Anything, clientID, listID).
+					Return(favorite.List{}, &favorite.ErrListNotFound{ClientID: clientID, ListID: listID})
+			},
+			expectedErr: "[AQF003] lista não encontrada",
+		},
+		{
+			about:  "when product is not in the list",
+			params: paramsBuilder.Build(),
+			setupRepo: func(m *mocksFavorite.Repository) {
+				m.On("FindList", mock.Anything, clientID, listID).
+					Return(list, nil)
+				m.On("FindListItem", mock.Anything, listID, productID).
+					Return(favorite.ListItem{}, &favorite.ErrListItemNotFound{ListID: listID, ProductID: productID})
+			},
+			expectedErr: "[AQF003] produto não encontrado na lista",
+		},
+		{
+			about:  "when find list item returns other error",
+			params: paramsBuilder.Build(),
+			setupRepo: func(m *mocksFavorite.Repository) {
+				m.On("FindList", mock.Anything, clientID, listID).
+					Return(list, nil)
+				m.On("FindListItem", mock.Anything, listID, productID).
+					Return(favorite.ListItem{}, errors.New("db error"))
+			},
+			expectedErr: "[AQF004] erro ao buscar produto na lista",
+		},
+		{
+			about:  "when remove fails",
+			params: paramsBuilder.Build(),
+			setupRepo: func(m *mocksFavorite.Repository) {
+				m.On("FindList", mock.Anything, clientID, listID).
+					Return(list, nil)
+				m.On("FindListItem", mock.Anything, listID, productID).
+					Return(item, nil)
+				m.On("RemoveListItem", mock.Anything, item).
+					Return(errors.New("db error"))
+			},
+			expectedErr: "[AQF004] erro ao remover produto da lista",
+		},
+		{
+			about:  "when all is valid",
+			params: paramsBuilder.Build(),
+			setupRepo: func(m *mocksFavorite.Repository) {
+				m.On("FindList", mock.Anything, clientID, listID).
+					Return(list, nil)
+				m.On("FindListItem", mock.Anything, listID, productID).
+					Return(item, nil)
+				m.On("RemoveListItem", mock.Anything, item).
+					Return(nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			repo := mocksFavorite.NewRepository(t)
+			if tc.setupRepo != nil {
+				tc.setupRepo(repo)
+			}
+
+			uc := usecase.NewRemoveProductFromListUseCase(repo)
+
+			// Action
+			err := uc.Execute(context.Background(), tc.params)
+
+			// Assert
+			if tc.expectedErr != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			repo.AssertExpectations(t)
+		})
+	}
+}
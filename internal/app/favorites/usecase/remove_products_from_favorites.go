@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/domainerror"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/logger"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/trace"
+)
+
+type removeProductsFromFavoritesUseCase struct {
+	repo favorite.Repository
+	opts BatchOptions
+}
+
+func NewRemoveProductsFromFavoritesUseCase(repo favorite.Repository, opts BatchOptions) favorites.RemoveProductsFromFavoritesUseCase {
+	return &removeProductsFromFavoritesUseCase{
+		repo: repo,
+		opts: opts,
+	}
+}
+
+func (u *removeProductsFromFavoritesUseCase) Execute(ctx context.Context, p dto.RemoveProductsFromFavoritesParams) (dto.FavoritesBatchResult, error) {
+	ctx, span := trace.NewSpan(ctx, "favorites.removeProductsFromFavorites")
+	defer span.End()
+
+	if err := p.Validate(); err != nil {
+		logger.ErrorF(ctx, "invalid params", logger.Fields{
+			"params": p,
+			"error":  err.Error(),
+		})
+
+		return dto.FavoritesBatchResult{}, err
+	}
+
+	if err := checkBatchSize(p.ProductIDs, u.opts); err != nil {
+		return dto.FavoritesBatchResult{}, err
+	}
+
+	ids, repeated := uniqueIDs(p.ProductIDs)
+
+	ff, err := u.repo.FindMultiple(ctx, p.ClientID, ids)
+	if err != nil {
+		logger.ErrorF(ctx, "error while trying to find favorites", logger.Fields{
+			"client_id":   p.ClientID,
+			"product_ids": ids,
+			"error":       err.Error(),
+		})
+
+		return dto.FavoritesBatchResult{}, domainerror.Wrap(err, domainerror.DependecyError, "erro ao buscar favoritos", map[string]any{
+			"client_id":   p.ClientID,
+			"product_ids": ids,
+			"error":       err.Error(),
+		})
+	}
+
+	items := make([]dto.BatchItemResult, 0, len(p.ProductIDs))
+	for _, id := range ids {
+		isFavorite := slices.ContainsFunc(ff, func(f favorite.Favorite) bool {
+			return f.ProductID == id
+		})
+		if !isFavorite {
+			items = append(items, dto.BatchItemResult{ProductID: id, Status: dto.BatchItemNotFound})
+			continue
+		}
+
+		items = append(items, dto.BatchItemResult{ProductID: id, Status: dto.BatchItemRemoved})
+	}
+
+	for _, id := range repeated {
+		items = append(items, dto.BatchItemResult{ProductID: id, Status: dto.BatchItemDuplicate})
+	}
+
+	if len(ff) > 0 {
+		if err := u.repo.RemoveMany(ctx, ff, favoriteEvents(ctx, favorite.EventRemoved, ff...)...); err != nil {
+			logger.ErrorF(ctx, "error while trying to remove favorites", logger.Fields{
+				"client_id": p.ClientID,
+				"error":     err.Error(),
+			})
+
+			return dto.FavoritesBatchResult{}, domainerror.Wrap(err, domainerror.DependecyError, "erro ao remover os produtos dos favoritos", map[string]any{
+				"client_id": p.ClientID,
+				"error":     err.Error(),
+			})
+		}
+	}
+
+	return dto.FavoritesBatchResult{
+		ClientID: p.ClientID,
+		Items:    items,
+	}, nil
+}
@@ -0,0 +1,134 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	fixtureFavorite "github.com/uesleicarvalhoo/aiqfome/favorite/fixture"
+	mocksFavorite "github.com/uesleicarvalhoo/aiqfome/favorite/mocks"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	fixtureDto "github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto/fixture"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/usecase"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/test"
+)
+
+func TestRemoveProductsFromFavoritesUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	clientID := uuid.NextID()
+
+	paramsBuilder := fixtureDto.AnyRemoveProductsFromFavoritesParams().
+		WithClientID(clientID).
+		WithProductIDs([]int{1, 2, 2})
+
+	favoriteBuilder := fixtureFavorite.AnyFavorite().WithClientID(clientID)
+
+	testCases := []struct {
+		about          string
+		params         dto.RemoveProductsFromFavoritesParams
+		setupRepo      func(m *mocksFavorite.Repository)
+		expectedErr    string
+		expectedResult dto.FavoritesBatchResult
+	}{
+		{
+			about:       "when params are invalid",
+			params:      dto.RemoveProductsFromFavoritesParams{},
+			expectedErr: "[AQF002] clientId: campo obrigatório; productIds: campo obrigatório",
+		},
+		{
+			about:       "when batch is too large",
+			params:      paramsBuilder.WithProductIDs([]int{1, 2, 3, 4, 5, 6}).Build(),
+			expectedErr: "[AQF002] é permitido no máximo 5 produtos por requisição",
+		},
+		{
+			about:  "when find favorites fails",
+			params: paramsBuilder.Build(),
+			setupRepo: func(m *mocksFavorite.Repository) {
+				m.On("FindMultiple", mock.Anything, clientID, []int{1, 2}).
+					Return([]favorite.Favorite{}, errors.New("db error"))
+			},
+			expectedErr: "[AQF004] erro ao buscar favoritos",
+		},
+		{
+			about:  "when remove many fails",
+			params: paramsBuilder.Build(),
+			setupRepo: func(m *mocksFavorite.Repository) {
+				m.On("FindMultiple", mock.Anything, clientID, []int{1, 2}).
+					Return([]favorite.Favorite{favoriteBuilder.WithProductID(1).Build()}, nil)
+				m.On("RemoveMany", mock.Anything, []favorite.Favorite{favoriteBuilder.WithProductID(1).Build()}, test.MatchEvent(favorite.EventRemoved)).
+					Return(errors.New("db error"))
+			},
+			expectedErr: "[AQF004] erro ao remover os produtos dos favoritos",
+		},
+		{
+			about:  "when none of the products is favorite",
+			params: paramsBuilder.Build(),
+			setupRepo: func(m *mocksFavorite.Repository) {
+				m.On("FindMultiple", mock.Anything, clientID, []int{1, 2}).
+					Return([]favorite.Favorite{}, nil)
+			},
+			expectedResult: dto.FavoritesBatchResult{
+				ClientID: clientID,
+				Items: []dto.BatchItemResult{
+					{ProductID: 1, Status: dto.BatchItemNotFound},
+					{ProductID: 2, Status: dto.BatchItemNotFound},
+					{ProductID: 2, Status: dto.BatchItemDuplicate},
+				},
+			},
+		},
+		{
+			about:  "when all is valid",
+			params: paramsBuilder.Build(),
+			setupRepo: func(m *mocksFavorite.Repository) {
+				m.On("FindMultiple", mock.Anything, clientID, []int{1, 2}).
+					Return([]favorite.Favorite{favoriteBuilder.WithProductID(1).Build()}, nil)
+				m.On("RemoveMany", mock.Anything, []favorite.Favorite{favoriteBuilder.WithProductID(1).Build()}, test.MatchEvent(favorite.EventRemoved)).
+					Return(nil)
+			},
+			expectedResult: dto.FavoritesBatchResult{
+				ClientID: clientID,
+				Items: []dto.BatchItemResult{
+					{ProductID: 1, Status: dto.BatchItemRemoved},
+					{ProductID: 2, Status: dto.BatchItemNotFound},
+					{ProductID: 2, Status: dto.BatchItemDuplicate},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			repo := mocksFavorite.NewRepository(t)
+			if tc.setupRepo != nil {
+				tc.setupRepo(repo)
+			}
+
+			uc := usecase.NewRemoveProductsFromFavoritesUseCase(repo, usecase.BatchOptions{MaxBatchSize: 5})
+
+			// Action
+			res, err := uc.Execute(context.Background(), tc.params)
+
+			// Assert
+			if tc.expectedErr != "" {
+				assert.Equal(t, dto.FavoritesBatchResult{}, res)
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedResult, res)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
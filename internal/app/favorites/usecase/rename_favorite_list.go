@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/domainerror"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/logger"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/trace"
+)
+
+type renameFavoriteListUseCase struct {
+	repo favorite.Repository
+}
+
+func NewRenameFavoriteListUseCase(repo favorite.Repository) favorites.RenameFavoriteListUseCase {
+	return &renameFavoriteListUseCase{
+		repo: repo,
+	}
+}
+
+func (u *renameFavoriteListUseCase) Execute(ctx context.Context, p dto.RenameFavoriteListParams) (dto.FavoriteList, error) {
+	ctx, span := trace.NewSpan(ctx, "favorites.renameFavoriteList")
+	defer span.End()
+
+	if err := p.Validate(); err != nil {
+		logger.ErrorF(ctx, "invalid params", logger.Fields{
+			"params": p,
+			"error":  err.Error(),
+		})
+
+		return dto.FavoriteList{}, err
+	}
+
+	l, err := findClientList(ctx, u.repo, p.ClientID, p.ListID)
+	if err != nil {
+		return dto.FavoriteList{}, err
+	}
+
+	if err := l.Rename(p.Name); err != nil {
+		logger.ErrorF(ctx, "validation failed after rename favorite list", logger.Fields{
+			"params": p,
+			"error":  err.Error(),
+		})
+
+		return dto.FavoriteList{}, err
+	}
+
+	if err := u.repo.UpdateList(ctx, l); err != nil {
+		logger.ErrorF(ctx, "error while trying to update favorite list", logger.Fields{
+			"list_id": p.ListID,
+			"error":   err.Error(),
+		})
+
+		return dto.FavoriteList{}, domainerror.Wrap(err, domainerror.DependecyError, "erro ao renomear lista", map[string]any{
+			"client_id": p.ClientID,
+			"list_id":   p.ListID,
+			"error":     err.Error(),
+		})
+	}
+
+	return dto.ListFromDomain(l), nil
+}
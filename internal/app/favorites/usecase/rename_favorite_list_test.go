@@ -0,0 +1,122 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	fixtureFavorite "github.com/uesleicarvalhoo/aiqfome/favorite/fixture"
+	mocksFavorite "github.com/uesleicarvalhoo/aiqfome/favorite/mocks"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	fixtureDto "github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto/fixture"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/usecase"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+func TestRenameFavoriteListUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	clientID := uuid.NextID()
+	listID := uuid.NextID()
+
+	paramsBuilder := fixtureDto.AnyRenameFavoriteListParams().
+		WithClientID(clientID).
+		WithListID(listID).
+		WithName("Jantar")
+
+	listBuilder := fixtureFavorite.AnyList().
+		WithID(listID).
+		WithClientID(clientID).
+		WithName("Almoço")
+
+	testCases := []struct {
+		about        string
+		params       dto.RenameFavoriteListParams
+		setupRepo    func(m *mocksFavorite.Repository)
+		expectedErr  string
+		expectedName string
+	}{
+		{
+			about:       "when params are invalid",
+			params:      dto.RenameFavoriteListParams{},
+			expectedErr: "[AQF002] clientId: campo obrigatório; listId: campo obrigatório; name: campo obrigatório",
+		},
+		{
+			about:  "when list not found",
+			params: paramsBuilder.Build(),
+			setupRepo: func(m *mocksFavorite.Repository) {
+				m.On("FindList", mock.Anything, clientID, listID).
+					Return(favorite.List{}, &favorite.ErrListNotFound{ClientID: clientID, ListID: listID})
+			},
+			expectedErr: "[AQF003] lista não encontrada",
+		},
+		{
+			about:  "when find list returns other error",
+			params: paramsBuilder.Build(),
+			setupRepo: func(m *mocksFavorite.Repository) {
+				m.On("FindList", mock.Anything, clientID, listID).
+					Return(favorite.List{}, errors.New("db error"))
+			},
+			expectedErr: "[AQF004] erro ao buscar lista",
+		},
+		{
+			about:  "when update fails",
+			params: paramsBuilder.Build(),
+			setupRepo: func(m *mocksFavorite.Repository) {
+				m.On("FindList", mock.Anything, clientID, listID).
+					Return(listBuilder.Build(), nil)
+				m.On("UpdateList", mock.Anything, mock.AnythingOfType("favorite.List")).
+					Return(errors.New("db error"))
+			},
+			expectedErr: "[AQF004] erro ao renomear lista",
+		},
+		{
+			about:  "when all is valid",
+			params: paramsBuilder.Build(),
+			setupRepo: func(m *mocksFavorite.Repository) {
+				m.On("FindList", mock.Anything, clientID, listID).
+					Return(listBuilder.Build(), nil)
+				m.On("UpdateList", mock.Anything, mock.MatchedBy(func(l favorite.List) bool {
+					return l.ID == listID && l.Name == "Jantar"
+				})).Return(nil)
+			},
+			expectedName: "Jantar",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			repo := mocksFavorite.NewRepository(t)
+			if tc.setupRepo != nil {
+				tc.setupRepo(repo)
+			}
+
+			uc := usecase.NewRenameFavoriteListUseCase(repo)
+
+			// Action
+			res, err := uc.Execute(context.Background(), tc.params)
+
+			// Assert
+			if tc.expectedErr != "" {
+				assert.Equal(t, dto.FavoriteList{}, res)
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, listID, res.ID)
+				assert.Equal(t, tc.expectedName, res.Name)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/domainerror"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/logger"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/trace"
+)
+
+type reorderFavoritesUseCase struct {
+	repo favorite.Repository
+}
+
+func NewReorderFavoritesUseCase(repo favorite.Repository) favorites.ReorderFavoritesUseCase {
+	return &reorderFavoritesUseCase{
+		repo: repo,
+	}
+}
+
+func (u *reorderFavoritesUseCase) Execute(ctx context.Context, p dto.ReorderFavoritesParams) error {
+	ctx, span := trace.NewSpan(ctx, "favorites.reorderFavorites")
+	defer span.End()
+
+	if err := p.Validate(); err != nil {
+		logger.ErrorF(ctx, "invalid params", logger.Fields{
+			"params": p,
+			"error":  err.Error(),
+		})
+
+		return err
+	}
+
+	ff, err := u.repo.AllByClientID(ctx, p.ClientID, favorite.Filter{Order: favorite.OrderByPosition})
+	if err != nil {
+		logger.ErrorF(ctx, "error while trying to get client favorites", logger.Fields{
+			"client_id": p.ClientID,
+			"error":     err.Error(),
+		})
+
+		return domainerror.Wrap(err, domainerror.DependecyError, "erro ao buscar favoritos", map[string]any{
+			"client_id": p.ClientID,
+			"error":     err.Error(),
+		})
+	}
+
+	ordering := favorite.NewOrdering(p.ClientID, ff)
+	if err := applyOrder(ordering, p); err != nil {
+		logger.WarnF(ctx, "error while trying to reorder favorites", logger.Fields{
+			"client_id": p.ClientID,
+			"error":     err.Error(),
+		})
+
+		if nfErr, ok := err.(*favorite.ErrFavoriteNotFound); ok {
+			return domainerror.New(domainerror.ResourceNotFound, "favorito não encontrado", map[string]any{
+				"client_id":  nfErr.ClientID,
+				"product_id": nfErr.ProductID,
+			})
+		}
+
+		return err
+	}
+
+	changed := ordering.Changed()
+	if len(changed) == 0 {
+		return nil
+	}
+
+	if err := u.repo.UpdatePositions(ctx, p.ClientID, changed); err != nil {
+		logger.ErrorF(ctx, "error while trying to update favorites positions", logger.Fields{
+			"client_id": p.ClientID,
+			"error":     err.Error(),
+		})
+
+		return domainerror.Wrap(err, domainerror.DependecyError, "erro ao reordenar favoritos", map[string]any{
+			"client_id": p.ClientID,
+			"error":     err.Error(),
+		})
+	}
+
+	return nil
+}
+
+func applyOrder(o *favorite.Ordering, p dto.ReorderFavoritesParams) error {
+	if len(p.ProductIDs) > 0 {
+		return o.Arrange(p.ProductIDs)
+	}
+
+	for _, m := range p.Moves {
+		var err error
+		if m.Before != nil {
+			err = o.Move(m.ProductID, *m.Before, false)
+		} else {
+			err = o.Move(m.ProductID, *m.After, true)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	fixtureFavorite "github.com/uesleicarvalhoo/aiqfome/favorite/fixture"
+	favMocks "github.com/uesleicarvalhoo/aiqfome/favorite/mocks"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	fixtureDto "github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto/fixture"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/usecase"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+func TestReorderFavoritesUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	clientID := uuid.NextID()
+	filter := favorite.Filter{Order: favorite.OrderByPosition}
+	builder := fixtureFavorite.AnyFavorite().WithClientID(clientID)
+
+	ff := []favorite.Favorite{
+		builder.WithProductID(1).WithPosition(1024).Build(),
+		builder.WithProductID(2).WithPosition(2048).Build(),
+		builder.WithProductID(3).WithPosition(3072).Build(),
+	}
+
+	paramsBuilder := fixtureDto.AnyReorderFavoritesParams().WithClientID(clientID)
+	one := 1
+
+	testCases := []struct {
+		about       string
+		params      dto.ReorderFavoritesParams
+		setupRepo   func(m *favMocks.Repository)
+		expectedErr string
+	}{
+		{
+			about:       "when params are invalid",
+			params:      dto.ReorderFavoritesParams{},
+			expectedErr: "clientId: campo obrigatório",
+		},
+		{
+			about:  "when get favorites fails",
+			params: paramsBuilder.Build(),
+			setupRepo: func(m *favMocks.Repository) {
+				m.On("AllByClientID", mock.Anything, clientID, filter).Return(nil, errors.New("db error"))
+			},
+			expectedErr: "[AQF004] erro ao buscar favoritos",
+		},
+		{
+			about:  "when a product is not a favorite",
+			params: paramsBuilder.WithProductIDs(2, 4).Build(),
+			setupRepo: func(m *favMocks.Repository) {
+				m.On("AllByClientID", mock.Anything, clientID, filter).Return(ff, nil)
+			},
+			expectedErr: "[AQF003] favorito não encontrado",
+		},
+		{
+			about:  "when the order does not change",
+			params: paramsBuilder.WithProductIDs(1, 2, 3).Build(),
+			setupRepo: func(m *favMocks.Repository) {
+				m.On("AllByClientID", mock.Anything, clientID, filter).Return(ff, nil)
+			},
+		},
+		{
+			about:  "when update positions fails",
+			params: paramsBuilder.WithProductIDs(2, 1).Build(),
+			setupRepo: func(m *favMocks.Repository) {
+				m.On("AllByClientID", mock.Anything, clientID, filter).Return(ff, nil)
+				m.On("UpdatePositions", mock.Anything, clientID, mock.Anything).Return(errors.New("db error"))
+			},
+			expectedErr: "[AQF004] erro ao reordenar favoritos",
+		},
+		{
+			about:  "when product ids are given",
+			params: paramsBuilder.WithProductIDs(2, 1).Build(),
+			setupRepo: func(m *favMocks.Repository) {
+				m.On("AllByClientID", mock.Anything, clientID, filter).Return(ff, nil)
+				m.On("UpdatePositions", mock.Anything, clientID, []favorite.Favorite{
+					builder.WithProductID(2).WithPosition(1024).Build(),
+					builder.WithProductID(1).WithPosition(2048).Build(),
+				}).Return(nil)
+			},
+		},
+		{
+			about:  "when moves are given only the moved favorite is written",
+			params: paramsBuilder.WithProductIDs().WithMoves(dto.FavoriteMove{ProductID: 3, Before: &one}).Build(),
+			setupRepo: func(m *favMocks.Repository) {
+				m.On("AllByClientID", mock.Anything, clientID, filter).Return(ff, nil)
+				m.On("UpdatePositions", mock.Anything, clientID, []favorite.Favorite{
+					builder.WithProductID(3).WithPosition(0).Build(),
+				}).Return(nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			repo := favMocks.NewRepository(t)
+			if tc.setupRepo != nil {
+				tc.setupRepo(repo)
+			}
+
+			uc := usecase.NewReorderFavoritesUseCase(repo)
+
+			// Action
+			err := uc.Execute(context.Background(), tc.params)
+
+			// Assert
+			if tc.expectedErr != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			repo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/domainerror"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/logger"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/trace"
+	"github.com/uesleicarvalhoo/aiqfome/user"
+)
+
+type restoreFavoriteUseCase struct {
+	repo  favorite.Repository
+	users user.Reader
+	opts  TrashOptions
+	quota QuotaOptions
+}
+
+func NewRestoreFavoriteUseCase(repo favorite.Repository, userReader user.Reader, opts TrashOptions, quota QuotaOptions) favorites.RestoreFavoriteUseCase {
+	return &restoreFavoriteUseCase{
+		repo:  repo,
+		users: userReader,
+		opts:  opts,
+		quota: quota,
+	}
+}
+
+func (u *restoreFavoriteUseCase) Execute(ctx context.Context, p dto.RestoreFavoriteParams) (dto.Favorite, error) {
+	ctx, span := trace.NewSpan(ctx, "favorites.restoreFavorite")
+	defer span.End()
+
+	if err := p.Validate(); err != nil {
+		logger.ErrorF(ctx, "invalid params", logger.Fields{
+			"params": p,
+			"error":  err.Error(),
+		})
+
+		return dto.Favorite{}, err
+	}
+
+	f, err := u.repo.FindTrashed(ctx, p.ClientID, p.ProductID, trashCutoff(u.opts))
+	if err != nil {
+		logger.ErrorF(ctx, "error while trying to find favorite on trash", logger.Fields{
+			"client_id":  p.ClientID,
+			"product_id": p.ProductID,
+			"error":      err.Error(),
+		})
+
+		if nfErr, ok := err.(*favorite.ErrFavoriteNotFound); ok {
+			return dto.Favorite{}, domainerror.New(domainerror.ResourceNotFound, "favorito não encontrado na lixeira", map[string]any{
+				"client_id":  nfErr.ClientID,
+				"product_id": nfErr.ProductID,
+			})
+		}
+
+		return dto.Favorite{}, domainerror.Wrap(err, domainerror.DependecyError, "erro ao buscar favorito na lixeira", map[string]any{
+			"client_id":  p.ClientID,
+			"product_id": p.ProductID,
+			"error":      err.Error(),
+		})
+	}
+
+	restored := f
+	restored.DeletedAt = nil
+
+	limit, _, err := favoritesLimit(ctx, u.repo, u.users, p.ClientID, u.quota)
+	if err != nil {
+		return dto.Favorite{}, err
+	}
+
+	if err := u.repo.RestoreWithinQuota(ctx, f, limit, favoriteEvents(ctx, favorite.EventRestored, restored)...); err != nil {
+		if qErr, ok := err.(*favorite.ErrQuotaExceeded); ok {
+			logger.WarnF(ctx, "favorites quota exceeded", logger.Fields{
+				"client_id": p.ClientID,
+				"limit":     qErr.Limit,
+			})
+
+			return dto.Favorite{}, quotaExceeded(qErr)
+		}
+
+		logger.ErrorF(ctx, "error while trying to restore favorite", logger.Fields{
+			"client_id":  p.ClientID,
+			"product_id": p.ProductID,
+			"error":      err.Error(),
+		})
+
+		return dto.Favorite{}, domainerror.Wrap(err, domainerror.DependecyError, "erro ao restaurar favorito", map[string]any{
+			"client_id":  p.ClientID,
+			"product_id": p.ProductID,
+			"error":      err.Error(),
+		})
+	}
+
+	return dto.FavoriteFromDomain(restored), nil
+}
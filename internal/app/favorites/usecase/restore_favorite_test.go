@@ -0,0 +1,154 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	fixtureFavorite "github.com/uesleicarvalhoo/aiqfome/favorite/fixture"
+	favMocks "github.com/uesleicarvalhoo/aiqfome/favorite/mocks"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	fixtureDto "github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto/fixture"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/usecase"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/role"
+	"github.com/uesleicarvalhoo/aiqfome/test"
+	fixtureUser "github.com/uesleicarvalhoo/aiqfome/user/fixture"
+	userMocks "github.com/uesleicarvalhoo/aiqfome/user/mocks"
+)
+
+func TestRestoreFavoriteUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	clientID := uuid.NextID()
+	productID := 1
+	deletedAt := time.Now().Add(-time.Hour)
+	quota := usecase.QuotaOptions{ByRole: map[role.Role]int{role.RoleClient: 5}}
+
+	paramsBuilder := fixtureDto.AnyRestoreFavoriteParams().
+		WithClientID(clientID).
+		WithProductID(productID)
+
+	trashed := fixtureFavorite.AnyFavorite().
+		WithClientID(clientID).
+		WithProductID(productID).
+		WithDeletedAt(&deletedAt).
+		Build()
+
+	testCases := []struct {
+		about          string
+		params         dto.RestoreFavoriteParams
+		setupRepo      func(m *favMocks.Repository)
+		setupUsers     func(m *userMocks.Reader)
+		expectedErr    string
+		expectedResult dto.Favorite
+	}{
+		{
+			about:       "when params are invalid",
+			params:      dto.RestoreFavoriteParams{},
+			expectedErr: "clientId: campo obrigatório; productId: campo obrigatório",
+		},
+		{
+			about:  "when favorite is not on trash",
+			params: paramsBuilder.Build(),
+			setupRepo: func(m *favMocks.Repository) {
+				m.On("FindTrashed", mock.Anything, clientID, productID, mock.AnythingOfType("time.Time")).
+					Return(favorite.Favorite{}, &favorite.ErrFavoriteNotFound{ClientID: clientID, ProductID: productID})
+			},
+			expectedErr: "[AQF003] favorito não encontrado na lixeira",
+		},
+		{
+			about:  "when find trashed fails",
+			params: paramsBuilder.Build(),
+			setupRepo: func(m *favMocks.Repository) {
+				m.On("FindTrashed", mock.Anything, clientID, productID, mock.AnythingOfType("time.Time")).
+					Return(favorite.Favorite{}, errors.New("db error"))
+			},
+			expectedErr: "[AQF004] erro ao buscar favorito na lixeira",
+		},
+		{
+			about:  "when the client is at the favorites limit",
+			params: paramsBuilder.Build(),
+			setupRepo: func(m *favMocks.Repository) {
+				m.On("FindTrashed", mock.Anything, clientID, productID, mock.AnythingOfType("time.Time")).
+					Return(trashed, nil)
+				m.On("FindQuota", mock.Anything, clientID).
+					Return(favorite.Quota{ClientID: clientID, MaxFavorites: 3}, nil)
+				m.On("RestoreWithinQuota", mock.Anything, trashed, 3, test.MatchEvent(favorite.EventRestored)).
+					Return(&favorite.ErrQuotaExceeded{ClientID: clientID, Limit: 3, Count: 3})
+			},
+			expectedErr: "[FAV003] limite de 3 favoritos atingido",
+		},
+		{
+			about:  "when restore fails",
+			params: paramsBuilder.Build(),
+			setupRepo: func(m *favMocks.Repository) {
+				m.On("FindTrashed", mock.Anything, clientID, productID, mock.AnythingOfType("time.Time")).
+					Return(trashed, nil)
+				m.On("FindQuota", mock.Anything, clientID).
+					Return(favorite.Quota{ClientID: clientID, MaxFavorites: 3}, nil)
+				m.On("RestoreWithinQuota", mock.Anything, trashed, 3, test.MatchEvent(favorite.EventRestored)).
+					Return(errors.New("db error"))
+			},
+			expectedErr: "[AQF004] erro ao restaurar favorito",
+		},
+		{
+			about:  "when all is valid",
+			params: paramsBuilder.Build(),
+			setupRepo: func(m *favMocks.Repository) {
+				m.On("FindTrashed", mock.Anything, clientID, productID, mock.AnythingOfType("time.Time")).
+					Return(trashed, nil)
+				m.On("FindQuota", mock.Anything, clientID).
+					Return(favorite.Quota{}, &favorite.ErrQuotaNotFound{ClientID: clientID})
+				m.On("RestoreWithinQuota", mock.Anything, trashed, 5, test.MatchEvent(favorite.EventRestored)).
+					Return(nil)
+			},
+			setupUsers: func(m *userMocks.Reader) {
+				m.On("Find", mock.Anything, clientID).
+					Return(fixtureUser.AnyUser().WithID(clientID).WithRole(role.RoleClient).Build(), nil)
+			},
+			expectedResult: dto.FavoriteFromDomain(trashed),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			repo := favMocks.NewRepository(t)
+			if tc.setupRepo != nil {
+				tc.setupRepo(repo)
+			}
+
+			users := userMocks.NewReader(t)
+			if tc.setupUsers != nil {
+				tc.setupUsers(users)
+			}
+
+			uc := usecase.NewRestoreFavoriteUseCase(repo, users, usecase.TrashOptions{Retention: 24 * time.Hour}, quota)
+
+			// Action
+			res, err := uc.Execute(context.Background(), tc.params)
+
+			// Assert
+			if tc.expectedErr != "" {
+				assert.Equal(t, dto.Favorite{}, res)
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, res)
+			repo.AssertExpectations(t)
+		})
+	}
+}
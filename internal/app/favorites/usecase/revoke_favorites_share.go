@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/domainerror"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/logger"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/trace"
+)
+
+type revokeFavoritesShareUseCase struct {
+	repo favorite.Repository
+}
+
+func NewRevokeFavoritesShareUseCase(repo favorite.Repository) favorites.RevokeFavoritesShareUseCase {
+	return &revokeFavoritesShareUseCase{
+		repo: repo,
+	}
+}
+
+func (u *revokeFavoritesShareUseCase) Execute(ctx context.Context, p dto.RevokeFavoritesShareParams) error {
+	ctx, span := trace.NewSpan(ctx, "favorites.revokeFavoritesShare")
+	defer span.End()
+
+	if err := p.Validate(); err != nil {
+		logger.ErrorF(ctx, "invalid params", logger.Fields{
+			"params": p,
+			"error":  err.Error(),
+		})
+
+		return err
+	}
+
+	s, err := u.repo.FindShare(ctx, p.Token)
+	if err != nil {
+		logger.ErrorF(ctx, "error while trying to find favorites share", logger.Fields{
+			"client_id": p.ClientID,
+			"error":     err.Error(),
+		})
+
+		if _, ok := err.(*favorite.ErrShareNotFound); ok {
+			return shareNotFound(p.Token)
+		}
+
+		return domainerror.Wrap(err, domainerror.DependecyError, "erro ao buscar link de compartilhamento", map[string]any{
+			"client_id": p.ClientID,
+			"error":     err.Error(),
+		})
+	}
+
+	// Shares of other clients are reported as not found, so the tokens of other clients can't be discovered
+	if s.ClientID != p.ClientID || s.RevokedAt != nil {
+		return shareNotFound(p.Token)
+	}
+
+	s.Revoke()
+
+	if err := u.repo.RevokeShare(ctx, s); err != nil {
+		logger.ErrorF(ctx, "error while trying to revoke favorites share", logger.Fields{
+			"client_id": p.ClientID,
+			"error":     err.Error(),
+		})
+
+		return domainerror.Wrap(err, domainerror.DependecyError, "erro ao revogar link de compartilhamento", map[string]any{
+			"client_id": p.ClientID,
+			"error":     err.Error(),
+		})
+	}
+
+	return nil
+}
+
+func shareNotFound(token string) error {
+	return domainerror.New(domainerror.ResourceNotFound, "link de compartilhamento não encontrado", map[string]any{
+		"token": token,
+	})
+}
@@ -0,0 +1,130 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	fixtureFavorite "github.com/uesleicarvalhoo/aiqfome/favorite/fixture"
+	mocksFavorite "github.com/uesleicarvalhoo/aiqfome/favorite/mocks"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	fixtureDto "github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto/fixture"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/usecase"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/test"
+)
+
+func TestRevokeFavoritesShareUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	clientID := uuid.NextID()
+	token := "share-token"
+
+	params := fixtureDto.AnyRevokeFavoritesShareParams().WithClientID(clientID).WithToken(token).Build()
+	shareBuilder := fixtureFavorite.AnyShare().WithClientID(clientID).WithToken(token)
+
+	testCases := []struct {
+		about       string
+		params      dto.RevokeFavoritesShareParams
+		setupRepo   func(m *mocksFavorite.Repository)
+		expectedErr string
+	}{
+		{
+			about:       "when params are invalid",
+			params:      dto.RevokeFavoritesShareParams{},
+			expectedErr: "[AQF002] clientId: campo obrigatório; token: campo obrigatório",
+		},
+		{
+			about:  "when share is not found",
+			params: params,
+			setupRepo: func(m *mocksFavorite.Repository) {
+				m.On("FindShare", mock.Anything, token).
+					Return(favorite.Share{}, &favorite.ErrShareNotFound{Token: token})
+			},
+			expectedErr: "[AQF003] link de compartilhamento não encontrado",
+		},
+		{
+			about:  "when find share fails",
+			params: params,
+			setupRepo: func(m *mocksFavorite.Repository) {
+				m.On("FindShare", mock.Anything, token).
+					Return(favorite.Share{}, errors.New("db error"))
+			},
+			expectedErr: "[AQF004] erro ao buscar link de compartilhamento",
+		},
+		{
+			about:  "when share belongs to another client",
+			params: params,
+			setupRepo: func(m *mocksFavorite.Repository) {
+				m.On("FindShare", mock.Anything, token).
+					Return(shareBuilder.WithClientID(uuid.NextID()).Build(), nil)
+			},
+			expectedErr: "[AQF003] link de compartilhamento não encontrado",
+		},
+		{
+			about:  "when share is already revoked",
+			params: params,
+			setupRepo: func(m *mocksFavorite.Repository) {
+				m.On("FindShare", mock.Anything, token).
+					Return(shareBuilder.WithRevokedAt(test.Ptr(time.Now())).Build(), nil)
+			},
+			expectedErr: "[AQF003] link de compartilhamento não encontrado",
+		},
+		{
+			about:  "when revoke fails",
+			params: params,
+			setupRepo: func(m *mocksFavorite.Repository) {
+				m.On("FindShare", mock.Anything, token).
+					Return(shareBuilder.Build(), nil)
+				m.On("RevokeShare", mock.Anything, mock.AnythingOfType("favorite.Share")).
+					Return(errors.New("db error"))
+			},
+			expectedErr: "[AQF004] erro ao revogar link de compartilhamento",
+		},
+		{
+			about:  "when all is valid",
+			params: params,
+			setupRepo: func(m *mocksFavorite.Repository) {
+				m.On("FindShare", mock.Anything, token).
+					Return(shareBuilder.Build(), nil)
+				m.On("RevokeShare", mock.Anything, mock.MatchedBy(func(s favorite.Share) bool {
+					return s.Token == token && s.RevokedAt != nil
+				})).Return(nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			repo := mocksFavorite.NewRepository(t)
+			if tc.setupRepo != nil {
+				tc.setupRepo(repo)
+			}
+
+			uc := usecase.NewRevokeFavoritesShareUseCase(repo)
+
+			// Action
+			err := uc.Execute(context.Background(), tc.params)
+
+			// Assert
+			if tc.expectedErr != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
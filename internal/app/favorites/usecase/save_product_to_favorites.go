@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/domainerror"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/logger"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/trace"
+	"github.com/uesleicarvalhoo/aiqfome/product"
+	"github.com/uesleicarvalhoo/aiqfome/user"
+)
+
+type saveProductToFavoritesUseCase struct {
+	products  product.Reader
+	favorites favorite.Repository
+	users     user.Reader
+	quota     QuotaOptions
+}
+
+func NewSaveProductToFavoritesUseCase(productReader product.Reader, favoriteRepo favorite.Repository, userReader user.Reader, quota QuotaOptions) favorites.SaveProductToFavoritesUseCase {
+	return &saveProductToFavoritesUseCase{
+		products:  productReader,
+		favorites: favoriteRepo,
+		users:     userReader,
+		quota:     quota,
+	}
+}
+
+func (u *saveProductToFavoritesUseCase) Execute(ctx context.Context, p dto.AddProductToFavoritesParams) (dto.ProductFavorite, bool, error) {
+	ctx, span := trace.NewSpan(ctx, "favorites.saveProductToFavorites")
+	defer span.End()
+
+	if err := p.Validate(); err != nil {
+		logger.ErrorF(ctx, "invalid params", logger.Fields{
+			"error":  err.Error(),
+			"params": p,
+		})
+
+		return dto.ProductFavorite{}, false, err
+	}
+
+	pd, err := u.products.Find(ctx, p.ProductID)
+	if err != nil {
+		logger.ErrorF(ctx, "error while trying to find product", logger.Fields{
+			"product_id": p.ProductID,
+			"error":      err.Error(),
+		})
+
+		if _, ok := err.(*product.ErrNotFound); ok {
+			return dto.ProductFavorite{}, false, domainerror.Wrap(err, domainerror.ResourceNotFound, "produto não encontrado", map[string]any{
+				"product_id": p.ProductID,
+			})
+		}
+
+		return dto.ProductFavorite{}, false, domainerror.Wrap(err, domainerror.DependecyError, "erro ao obter dados do produto", map[string]any{
+			"product_id": p.ProductID,
+			"error":      err.Error(),
+		})
+	}
+
+	f, err := favorite.New(p.ClientID, p.ProductID)
+	if err != nil {
+		logger.ErrorF(ctx, "invalid favorite params", logger.Fields{
+			"client_id":  p.ClientID,
+			"product_id": p.ProductID,
+			"error":      err.Error(),
+		})
+
+		return dto.ProductFavorite{}, false, err
+	}
+
+	f.Snapshot(pd.Title, pd.Price)
+
+	limit, _, err := favoritesLimit(ctx, u.favorites, u.users, p.ClientID, u.quota)
+	if err != nil {
+		return dto.ProductFavorite{}, false, err
+	}
+
+	created, err := u.favorites.UpsertWithinQuota(ctx, f, limit, favoriteEvents(ctx, favorite.EventAdded, f)...)
+	if err != nil {
+		if qErr, ok := err.(*favorite.ErrQuotaExceeded); ok {
+			logger.WarnF(ctx, "favorites quota exceeded", logger.Fields{
+				"client_id": p.ClientID,
+				"limit":     qErr.Limit,
+			})
+
+			return dto.ProductFavorite{}, false, quotaExceeded(qErr)
+		}
+
+		logger.ErrorF(ctx, "error while trying to save favorite", logger.Fields{
+			"client_id":  p.ClientID,
+			"product_id": p.ProductID,
+			"error":      err.Error(),
+		})
+
+		return dto.ProductFavorite{}, false, domainerror.Wrap(err, domainerror.DependecyError, "erro ao adicionar o produto aos favoritos", map[string]any{
+			"client_id":  p.ClientID,
+			"product_id": p.ProductID,
+			"error":      err.Error(),
+		})
+	}
+
+	return dto.ProductFavorite{
+		ClientID: f.ClientID,
+		Product:  pd,
+	}, created, nil
+}
@@ -0,0 +1,184 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	mocksFavorite "github.com/uesleicarvalhoo/aiqfome/favorite/mocks"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	fixtureFavorites "github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto/fixture"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/usecase"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/product"
+	fixtureProd "github.com/uesleicarvalhoo/aiqfome/product/fixture"
+	mocksProduct "github.com/uesleicarvalhoo/aiqfome/product/mocks"
+	"github.com/uesleicarvalhoo/aiqfome/role"
+	"github.com/uesleicarvalhoo/aiqfome/test"
+	fixtureUser "github.com/uesleicarvalhoo/aiqfome/user/fixture"
+	mocksUser "github.com/uesleicarvalhoo/aiqfome/user/mocks"
+)
+
+func TestSaveProductToFavoritesUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	clientID := uuid.NextID()
+	productID := 1
+	paramsBuilder := fixtureFavorites.AnyAddProductToFavoritesParams().
+		WithClientID(clientID)
+
+	productBuilder := fixtureProd.AnyProduct().WithID(productID)
+	quota := usecase.QuotaOptions{ByRole: map[role.Role]int{role.RoleClient: 5}}
+
+	testCases := []struct {
+		about           string
+		params          dto.AddProductToFavoritesParams
+		setupProducts   func(m *mocksProduct.Reader)
+		setupFavorites  func(m *mocksFavorite.Repository)
+		setupUsers      func(m *mocksUser.Reader)
+		expectedErr     string
+		expectedResult  dto.ProductFavorite
+		expectedCreated bool
+	}{
+		{
+			about:       "when params are invalid",
+			params:      dto.AddProductToFavoritesParams{},
+			expectedErr: "[AQF002] clientId: campo obrigatório; productId: campo obrigatório",
+		},
+		{
+			about:  "when product not found",
+			params: paramsBuilder.Build(),
+			setupProducts: func(m *mocksProduct.Reader) {
+				m.On("Find", mock.Anything, productID).
+					Return(product.Product{}, &product.ErrNotFound{ID: productID})
+			},
+			expectedErr: "[AQF003] produto não encontrado",
+		},
+		{
+			about:  "when product reader returns other error",
+			params: paramsBuilder.Build(),
+			setupProducts: func(m *mocksProduct.Reader) {
+				m.On("Find", mock.Anything, productID).
+					Return(product.Product{}, errors.New("service down"))
+			},
+			expectedErr: "[AQF004] erro ao obter dados do produto",
+		},
+		{
+			about:  "when upsert fails",
+			params: paramsBuilder.Build(),
+			setupProducts: func(m *mocksProduct.Reader) {
+				m.On("Find", mock.Anything, productID).
+					Return(productBuilder.Build(), nil)
+			},
+			setupFavorites: func(m *mocksFavorite.Repository) {
+				m.On("FindQuota", mock.Anything, clientID).
+					Return(favorite.Quota{ClientID: clientID, MaxFavorites: 10}, nil)
+				m.On("UpsertWithinQuota", mock.Anything, mock.AnythingOfType("favorite.Favorite"), 10, test.MatchEvent(favorite.EventAdded)).
+					Return(false, errors.New("db error"))
+			},
+			expectedErr: "[AQF004] erro ao adicionar o produto aos favoritos",
+		},
+		{
+			about:  "when quota is exceeded",
+			params: paramsBuilder.Build(),
+			setupProducts: func(m *mocksProduct.Reader) {
+				m.On("Find", mock.Anything, productID).
+					Return(productBuilder.Build(), nil)
+			},
+			setupFavorites: func(m *mocksFavorite.Repository) {
+				m.On("FindQuota", mock.Anything, clientID).
+					Return(favorite.Quota{}, &favorite.ErrQuotaNotFound{ClientID: clientID})
+				m.On("UpsertWithinQuota", mock.Anything, mock.AnythingOfType("favorite.Favorite"), 5, test.MatchEvent(favorite.EventAdded)).
+					Return(false, &favorite.ErrQuotaExceeded{ClientID: clientID, Limit: 5, Count: 5})
+			},
+			setupUsers: func(m *mocksUser.Reader) {
+				m.On("Find", mock.Anything, clientID).
+					Return(fixtureUser.AnyUser().WithID(clientID).WithRole(role.RoleClient).Build(), nil)
+			},
+			expectedErr: "[FAV003] limite de 5 favoritos atingido",
+		},
+		{
+			about:  "when the product is already a favorite",
+			params: paramsBuilder.Build(),
+			setupProducts: func(m *mocksProduct.Reader) {
+				m.On("Find", mock.Anything, productID).
+					Return(productBuilder.Build(), nil)
+			},
+			setupFavorites: func(m *mocksFavorite.Repository) {
+				m.On("FindQuota", mock.Anything, clientID).
+					Return(favorite.Quota{ClientID: clientID, MaxFavorites: 10}, nil)
+				m.On("UpsertWithinQuota", mock.Anything, mock.AnythingOfType("favorite.Favorite"), 10, test.MatchEvent(favorite.EventAdded)).
+					Return(false, nil)
+			},
+			expectedResult:  dto.ProductFavorite{ClientID: clientID, Product: productBuilder.Build()},
+			expectedCreated: false,
+		},
+		{
+			about:  "when the favorite is created",
+			params: paramsBuilder.Build(),
+			setupProducts: func(m *mocksProduct.Reader) {
+				m.On("Find", mock.Anything, productID).
+					Return(productBuilder.Build(), nil)
+			},
+			setupFavorites: func(m *mocksFavorite.Repository) {
+				m.On("FindQuota", mock.Anything, clientID).
+					Return(favorite.Quota{ClientID: clientID, MaxFavorites: 10}, nil)
+				m.On("UpsertWithinQuota", mock.Anything, mock.MatchedBy(func(f favorite.Favorite) bool {
+					pd := productBuilder.Build()
+					return f.ClientID == clientID && f.ProductID == productID &&
+						f.TitleWhenFavorited == pd.Title && f.PriceWhenFavorited != nil && *f.PriceWhenFavorited == pd.Price
+				}), 10, test.MatchEvent(favorite.EventAdded)).Return(true, nil)
+			},
+			expectedResult:  dto.ProductFavorite{ClientID: clientID, Product: productBuilder.Build()},
+			expectedCreated: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			prodReader := mocksProduct.NewReader(t)
+			if tc.setupProducts != nil {
+				tc.setupProducts(prodReader)
+			}
+
+			favRepo := mocksFavorite.NewRepository(t)
+			if tc.setupFavorites != nil {
+				tc.setupFavorites(favRepo)
+			}
+
+			userReader := mocksUser.NewReader(t)
+			if tc.setupUsers != nil {
+				tc.setupUsers(userReader)
+			}
+
+			uc := usecase.NewSaveProductToFavoritesUseCase(prodReader, favRepo, userReader, quota)
+
+			// Action
+			res, created, err := uc.Execute(context.Background(), tc.params)
+
+			// Assert
+			if tc.expectedErr != "" {
+				assert.Equal(t, dto.ProductFavorite{}, res)
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedResult, res)
+				assert.Equal(t, tc.expectedCreated, created)
+			}
+
+			prodReader.AssertExpectations(t)
+			favRepo.AssertExpectations(t)
+			userReader.AssertExpectations(t)
+		})
+	}
+}
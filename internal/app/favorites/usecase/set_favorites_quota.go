@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/domainerror"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/logger"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/trace"
+	"github.com/uesleicarvalhoo/aiqfome/user"
+)
+
+type setFavoritesQuotaUseCase struct {
+	favorites favorite.Repository
+	users     user.Reader
+	quota     QuotaOptions
+}
+
+func NewSetFavoritesQuotaUseCase(favoriteRepo favorite.Repository, userReader user.Reader, quota QuotaOptions) favorites.SetFavoritesQuotaUseCase {
+	return &setFavoritesQuotaUseCase{
+		favorites: favoriteRepo,
+		users:     userReader,
+		quota:     quota,
+	}
+}
+
+func (u *setFavoritesQuotaUseCase) Execute(ctx context.Context, p dto.SetFavoritesQuotaParams) (dto.FavoritesQuota, error) {
+	ctx, span := trace.NewSpan(ctx, "favorites.setFavoritesQuota")
+	defer span.End()
+
+	if err := p.Validate(); err != nil {
+		logger.ErrorF(ctx, "invalid params", logger.Fields{
+			"error":  err.Error(),
+			"params": p,
+		})
+
+		return dto.FavoritesQuota{}, err
+	}
+
+	if _, err := u.users.Find(ctx, p.ClientID); err != nil {
+		logger.ErrorF(ctx, "error while trying to find client", logger.Fields{
+			"client_id": p.ClientID,
+			"error":     err.Error(),
+		})
+
+		if errors.Is(err, user.ErrNotFound) {
+			return dto.FavoritesQuota{}, domainerror.Wrap(err, domainerror.ResourceNotFound, "cliente não encontrado", map[string]any{
+				"client_id": p.ClientID,
+			})
+		}
+
+		return dto.FavoritesQuota{}, domainerror.Wrap(err, domainerror.DependecyError, "erro ao buscar cliente", map[string]any{
+			"client_id": p.ClientID,
+			"error":     err.Error(),
+		})
+	}
+
+	q, err := favorite.NewQuota(p.ClientID, *p.MaxFavorites)
+	if err != nil {
+		logger.ErrorF(ctx, "invalid quota params", logger.Fields{
+			"client_id": p.ClientID,
+			"error":     err.Error(),
+		})
+
+		return dto.FavoritesQuota{}, err
+	}
+
+	if err := u.favorites.SaveQuota(ctx, q); err != nil {
+		logger.ErrorF(ctx, "error while trying to save favorites quota", logger.Fields{
+			"client_id": p.ClientID,
+			"error":     err.Error(),
+		})
+
+		return dto.FavoritesQuota{}, domainerror.Wrap(err, domainerror.DependecyError, "erro ao salvar limite de favoritos", map[string]any{
+			"client_id": p.ClientID,
+			"error":     err.Error(),
+		})
+	}
+
+	return favoritesQuota(ctx, u.favorites, u.users, p.ClientID, u.quota)
+}
@@ -0,0 +1,128 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	mocksFavorite "github.com/uesleicarvalhoo/aiqfome/favorite/mocks"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	fixtureDto "github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto/fixture"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/usecase"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/role"
+	"github.com/uesleicarvalhoo/aiqfome/test"
+	"github.com/uesleicarvalhoo/aiqfome/user"
+	fixtureUser "github.com/uesleicarvalhoo/aiqfome/user/fixture"
+	mocksUser "github.com/uesleicarvalhoo/aiqfome/user/mocks"
+)
+
+func TestSetFavoritesQuotaUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	clientID := uuid.NextID()
+	paramsBuilder := fixtureDto.AnySetFavoritesQuotaParams().WithClientID(clientID)
+	usr := fixtureUser.AnyUser().WithID(clientID).WithRole(role.RoleClient).Build()
+
+	testCases := []struct {
+		about          string
+		params         dto.SetFavoritesQuotaParams
+		setupFavorites func(m *mocksFavorite.Repository)
+		setupUsers     func(m *mocksUser.Reader)
+		expectedErr    string
+		expectedResult dto.FavoritesQuota
+	}{
+		{
+			about:       "when params are invalid",
+			params:      dto.SetFavoritesQuotaParams{},
+			expectedErr: "[AQF002] clientId: campo obrigatório; maxFavorites: campo obrigatório",
+		},
+		{
+			about:  "when client is not found",
+			params: paramsBuilder.Build(),
+			setupUsers: func(m *mocksUser.Reader) {
+				m.On("Find", mock.Anything, clientID).
+					Return(user.User{}, user.ErrNotFound)
+			},
+			expectedErr: "[AQF003] cliente não encontrado",
+		},
+		{
+			about:  "when max favorites is negative",
+			params: paramsBuilder.WithMaxFavorites(test.Ptr(-1)).Build(),
+			setupUsers: func(m *mocksUser.Reader) {
+				m.On("Find", mock.Anything, clientID).Return(usr, nil)
+			},
+			expectedErr: "[AQF002] maxFavorites: não pode ser negativo",
+		},
+		{
+			about:  "when save fails",
+			params: paramsBuilder.Build(),
+			setupFavorites: func(m *mocksFavorite.Repository) {
+				m.On("SaveQuota", mock.Anything, mock.AnythingOfType("favorite.Quota")).
+					Return(errors.New("db error"))
+			},
+			setupUsers: func(m *mocksUser.Reader) {
+				m.On("Find", mock.Anything, clientID).Return(usr, nil)
+			},
+			expectedErr: "[AQF004] erro ao salvar limite de favoritos",
+		},
+		{
+			about:  "when all is valid",
+			params: paramsBuilder.WithMaxFavorites(test.Ptr(50)).Build(),
+			setupFavorites: func(m *mocksFavorite.Repository) {
+				m.On("SaveQuota", mock.Anything, mock.MatchedBy(func(q favorite.Quota) bool {
+					return q.ClientID == clientID && q.MaxFavorites == 50
+				})).Return(nil)
+				m.On("FindQuota", mock.Anything, clientID).
+					Return(favorite.Quota{ClientID: clientID, MaxFavorites: 50}, nil)
+				m.On("CountByClientID", mock.Anything, clientID).
+					Return(8, nil)
+			},
+			setupUsers: func(m *mocksUser.Reader) {
+				m.On("Find", mock.Anything, clientID).Return(usr, nil)
+			},
+			expectedResult: dto.FavoritesQuota{ClientID: clientID, Limit: test.Ptr(50), Used: 8, Remaining: test.Ptr(42), Overridden: true},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			favRepo := mocksFavorite.NewRepository(t)
+			if tc.setupFavorites != nil {
+				tc.setupFavorites(favRepo)
+			}
+
+			userReader := mocksUser.NewReader(t)
+			if tc.setupUsers != nil {
+				tc.setupUsers(userReader)
+			}
+
+			uc := usecase.NewSetFavoritesQuotaUseCase(favRepo, userReader, usecase.QuotaOptions{})
+
+			// Action
+			res, err := uc.Execute(context.Background(), tc.params)
+
+			// Assert
+			if tc.expectedErr != "" {
+				assert.Equal(t, dto.FavoritesQuota{}, res)
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedResult, res)
+			}
+
+			favRepo.AssertExpectations(t)
+			userReader.AssertExpectations(t)
+		})
+	}
+}
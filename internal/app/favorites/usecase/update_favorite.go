@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/domainerror"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/logger"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/trace"
+)
+
+type updateFavoriteUseCase struct {
+	repo favorite.Repository
+}
+
+func NewUpdateFavoriteUseCase(repo favorite.Repository) favorites.UpdateFavoriteUseCase {
+	return &updateFavoriteUseCase{
+		repo: repo,
+	}
+}
+
+func (u *updateFavoriteUseCase) Execute(ctx context.Context, p dto.UpdateFavoriteParams) (dto.Favorite, error) {
+	ctx, span := trace.NewSpan(ctx, "favorites.updateFavorite")
+	defer span.End()
+
+	if err := p.Validate(); err != nil {
+		logger.ErrorF(ctx, "invalid params", logger.Fields{
+			"params": p,
+			"error":  err.Error(),
+		})
+
+		return dto.Favorite{}, err
+	}
+
+	f, err := u.repo.Find(ctx, p.ClientID, p.ProductID)
+	if err != nil {
+		logger.ErrorF(ctx, "error while trying to find favorite", logger.Fields{
+			"client_id":  p.ClientID,
+			"product_id": p.ProductID,
+			"error":      err.Error(),
+		})
+
+		if nfErr, ok := err.(*favorite.ErrFavoriteNotFound); ok {
+			return dto.Favorite{}, domainerror.New(domainerror.ResourceNotFound, "favorito não encontrado", map[string]any{
+				"client_id":  nfErr.ClientID,
+				"product_id": nfErr.ProductID,
+			})
+		}
+
+		return dto.Favorite{}, domainerror.Wrap(err, domainerror.DependecyError, "erro ao buscar favorito", map[string]any{
+			"client_id":  p.ClientID,
+			"product_id": p.ProductID,
+			"error":      err.Error(),
+		})
+	}
+
+	note, tags := f.Note, f.Tags
+	if p.Note != nil {
+		note = *p.Note
+	}
+
+	if p.Tags != nil {
+		tags = *p.Tags
+	}
+
+	if err := f.Annotate(note, tags); err != nil {
+		logger.ErrorF(ctx, "validation failed after update favorite", logger.Fields{
+			"params": p,
+			"error":  err.Error(),
+		})
+
+		return dto.Favorite{}, err
+	}
+
+	if err := u.repo.Update(ctx, f); err != nil {
+		logger.ErrorF(ctx, "error while trying to update favorite", logger.Fields{
+			"client_id":  p.ClientID,
+			"product_id": p.ProductID,
+			"error":      err.Error(),
+		})
+
+		return dto.Favorite{}, domainerror.Wrap(err, domainerror.DependecyError, "erro ao atualizar favorito", map[string]any{
+			"client_id":  p.ClientID,
+			"product_id": p.ProductID,
+			"error":      err.Error(),
+		})
+	}
+
+	return dto.FavoriteFromDomain(f), nil
+}
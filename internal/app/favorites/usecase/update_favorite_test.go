@@ -0,0 +1,146 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/uesleicarvalhoo/aiqfome/favorite"
+	fixtureFavorite "github.com/uesleicarvalhoo/aiqfome/favorite/fixture"
+	mocksFavorite "github.com/uesleicarvalhoo/aiqfome/favorite/mocks"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	fixtureDto "github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto/fixture"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/usecase"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+func TestUpdateFavoriteUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	clientID := uuid.NextID()
+	productID := 1
+
+	note := "presente de aniversário"
+	tags := []string{"Presentes", "presentes", " Mãe "}
+	longNote := strings.Repeat("a", favorite.NoteMaxLength+1)
+
+	paramsBuilder := fixtureDto.AnyUpdateFavoriteParams().
+		WithClientID(clientID).
+		WithProductID(productID).
+		WithNote(&note).
+		WithTags(&tags)
+
+	favoriteBuilder := fixtureFavorite.AnyFavorite().
+		WithClientID(clientID).
+		WithProductID(productID).
+		WithNote("nota antiga").
+		WithTags([]string{"antiga"})
+
+	testCases := []struct {
+		about          string
+		params         dto.UpdateFavoriteParams
+		setupRepo      func(m *mocksFavorite.Repository)
+		expectedErr    string
+		expectedResult dto.Favorite
+	}{
+		{
+			about:       "when params are invalid",
+			params:      dto.UpdateFavoriteParams{},
+			expectedErr: "[AQF002] clientId: campo obrigatório; productId: campo obrigatório; note: informe note ou tags",
+		},
+		{
+			about:  "when favorite not found",
+			params: paramsBuilder.Build(),
+			setupRepo: func(m *mocksFavorite.Repository) {
+				m.On("Find", mock.Anything, clientID, productID).
+					Return(favorite.Favorite{}, &favorite.ErrFavoriteNotFound{ClientID: clientID, ProductID: productID})
+			},
+			expectedErr: "[AQF003] favorito não encontrado",
+		},
+		{
+			about:  "when find favorite returns other error",
+			params: paramsBuilder.Build(),
+			setupRepo: func(m *mocksFavorite.Repository) {
+				m.On("Find", mock.Anything, clientID, productID).
+					Return(favorite.Favorite{}, errors.New("db error"))
+			},
+			expectedErr: "[AQF004] erro ao buscar favorito",
+		},
+		{
+			about:  "when note is too long",
+			params: paramsBuilder.WithNote(&longNote).Build(),
+			setupRepo: func(m *mocksFavorite.Repository) {
+				m.On("Find", mock.Anything, clientID, productID).
+					Return(favoriteBuilder.Build(), nil)
+			},
+			expectedErr: "[AQF002] note: deve ter no máximo 500 caracteres",
+		},
+		{
+			about:  "when update fails",
+			params: paramsBuilder.Build(),
+			setupRepo: func(m *mocksFavorite.Repository) {
+				m.On("Find", mock.Anything, clientID, productID).
+					Return(favoriteBuilder.Build(), nil)
+				m.On("Update", mock.Anything, mock.AnythingOfType("favorite.Favorite")).
+					Return(errors.New("db error"))
+			},
+			expectedErr: "[AQF004] erro ao atualizar favorito",
+		},
+		{
+			about:  "when only tags are informed",
+			params: paramsBuilder.WithNote(nil).Build(),
+			setupRepo: func(m *mocksFavorite.Repository) {
+				m.On("Find", mock.Anything, clientID, productID).
+					Return(favoriteBuilder.Build(), nil)
+				m.On("Update", mock.Anything, favoriteBuilder.WithTags([]string{"presentes", "mãe"}).Build()).
+					Return(nil)
+			},
+			expectedResult: dto.FavoriteFromDomain(favoriteBuilder.WithTags([]string{"presentes", "mãe"}).Build()),
+		},
+		{
+			about:  "when all is valid",
+			params: paramsBuilder.Build(),
+			setupRepo: func(m *mocksFavorite.Repository) {
+				m.On("Find", mock.Anything, clientID, productID).
+					Return(favoriteBuilder.Build(), nil)
+				m.On("Update", mock.Anything, favoriteBuilder.WithNote(note).WithTags([]string{"presentes", "mãe"}).Build()).
+					Return(nil)
+			},
+			expectedResult: dto.FavoriteFromDomain(favoriteBuilder.WithNote(note).WithTags([]string{"presentes", "mãe"}).Build()),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			repo := mocksFavorite.NewRepository(t)
+			if tc.setupRepo != nil {
+				tc.setupRepo(repo)
+			}
+
+			uc := usecase.NewUpdateFavoriteUseCase(repo)
+
+			// Action
+			res, err := uc.Execute(context.Background(), tc.params)
+
+			// Assert
+			if tc.expectedErr != "" {
+				assert.Equal(t, dto.Favorite{}, res)
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedResult, res)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
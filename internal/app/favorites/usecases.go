@@ -2,8 +2,10 @@ package favorites
 
 import (
 	"context"
+	"io"
 
 	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
 )
 
 type GetClientFavoritesUseCase interface {
@@ -14,6 +16,144 @@ type AddProductToFavoritesUseCase interface {
 	Execute(ctx context.Context, p dto.AddProductToFavoritesParams) (dto.ProductFavorite, error)
 }
 
+// SaveProductToFavoritesUseCase adds the product to the client favorites when it isn't there yet, so it can be repeated safely.
+// It returns whether the favorite was created
+type SaveProductToFavoritesUseCase interface {
+	Execute(ctx context.Context, p dto.AddProductToFavoritesParams) (dto.ProductFavorite, bool, error)
+}
+
 type RemoveProductFromFavoritesUseCase interface {
 	Execute(ctx context.Context, p dto.RemoveProductFromFavoritesParams) error
 }
+
+type AddProductsToFavoritesUseCase interface {
+	Execute(ctx context.Context, p dto.AddProductsToFavoritesParams) (dto.FavoritesBatchResult, error)
+}
+
+type RemoveProductsFromFavoritesUseCase interface {
+	Execute(ctx context.Context, p dto.RemoveProductsFromFavoritesParams) (dto.FavoritesBatchResult, error)
+}
+
+type UpdateFavoriteUseCase interface {
+	Execute(ctx context.Context, p dto.UpdateFavoriteParams) (dto.Favorite, error)
+}
+
+type GetFavoritesTrashUseCase interface {
+	Execute(ctx context.Context, p dto.GetFavoritesTrashParams) (dto.FavoritesTrash, error)
+}
+
+type RestoreFavoriteUseCase interface {
+	Execute(ctx context.Context, p dto.RestoreFavoriteParams) (dto.Favorite, error)
+}
+
+// ReorderFavoritesUseCase sets the manual ordering of the client favorites, only the moved ones are written
+type ReorderFavoritesUseCase interface {
+	Execute(ctx context.Context, p dto.ReorderFavoritesParams) error
+}
+
+// GetFavoritesChangesUseCase returns the changes of the client favorites since a sync token, for clients that keep a copy of them
+type GetFavoritesChangesUseCase interface {
+	Execute(ctx context.Context, p dto.GetFavoritesChangesParams) (dto.FavoritesChanges, error)
+}
+
+// GetFavoritesHistoryUseCase returns the adds, removals and restores of the client favorites, most recent first
+type GetFavoritesHistoryUseCase interface {
+	Execute(ctx context.Context, p dto.GetFavoritesHistoryParams) (dto.FavoritesHistory, error)
+}
+
+type PurgeFavoritesTrashUseCase interface {
+	Execute(ctx context.Context) (int, error)
+}
+
+// ArchiveUnavailableFavoritesUseCase archives the favorites of products removed upstream
+type ArchiveUnavailableFavoritesUseCase interface {
+	Execute(ctx context.Context) (int, error)
+}
+
+type GetFavoritesStatsUseCase interface {
+	Execute(ctx context.Context, p dto.GetFavoritesStatsParams) (dto.FavoritesStats, error)
+}
+
+// ExportClientFavoritesUseCase writes all favorites of the client to w as soon as they are read
+type ExportClientFavoritesUseCase interface {
+	Execute(ctx context.Context, p dto.ExportFavoritesParams, w io.Writer) error
+}
+
+// ImportFavoritesUseCase imports small files right away and bigger ones in background, the job reports each row
+type ImportFavoritesUseCase interface {
+	Execute(ctx context.Context, p dto.ImportFavoritesParams) (dto.ImportJob, error)
+}
+
+type GetImportJobUseCase interface {
+	Execute(ctx context.Context, p dto.GetImportJobParams) (dto.ImportJob, error)
+}
+
+type CreateFavoritesShareUseCase interface {
+	Execute(ctx context.Context, p dto.CreateFavoritesShareParams) (dto.FavoritesShare, error)
+}
+
+type GetFavoritesSharesUseCase interface {
+	Execute(ctx context.Context, clientID uuid.ID) (dto.ClientFavoritesShares, error)
+}
+
+type RevokeFavoritesShareUseCase interface {
+	Execute(ctx context.Context, p dto.RevokeFavoritesShareParams) error
+}
+
+// GetSharedFavoritesUseCase returns the favorites behind a share token, it is used by visitors without an account
+type GetSharedFavoritesUseCase interface {
+	Execute(ctx context.Context, p dto.GetSharedFavoritesParams) (dto.SharedFavorites, error)
+}
+
+// GetFavoritesQuotaUseCase returns how many favorites the client has against its limit
+type GetFavoritesQuotaUseCase interface {
+	Execute(ctx context.Context, clientID uuid.ID) (dto.FavoritesQuota, error)
+}
+
+// SetFavoritesQuotaUseCase overrides the limit of the client role, 0 means unlimited
+type SetFavoritesQuotaUseCase interface {
+	Execute(ctx context.Context, p dto.SetFavoritesQuotaParams) (dto.FavoritesQuota, error)
+}
+
+// RemoveFavoritesQuotaUseCase removes the override, so the client goes back to the limit of its role
+type RemoveFavoritesQuotaUseCase interface {
+	Execute(ctx context.Context, clientID uuid.ID) error
+}
+
+// GetRecommendationsUseCase returns products favorited by the clients who favorited the same products as the client
+type GetRecommendationsUseCase interface {
+	Execute(ctx context.Context, p dto.GetRecommendationsParams) (dto.Recommendations, error)
+}
+
+// RefreshRecommendationsUseCase rebuilds the similarity between products used by the recommendations
+type RefreshRecommendationsUseCase interface {
+	Execute(ctx context.Context) (int, error)
+}
+
+type CreateFavoriteListUseCase interface {
+	Execute(ctx context.Context, p dto.CreateFavoriteListParams) (dto.FavoriteList, error)
+}
+
+type GetClientFavoriteListsUseCase interface {
+	Execute(ctx context.Context, clientID uuid.ID) (dto.ClientFavoriteLists, error)
+}
+
+type GetFavoriteListUseCase interface {
+	Execute(ctx context.Context, p dto.GetFavoriteListParams) (dto.FavoriteListProducts, error)
+}
+
+type RenameFavoriteListUseCase interface {
+	Execute(ctx context.Context, p dto.RenameFavoriteListParams) (dto.FavoriteList, error)
+}
+
+type DeleteFavoriteListUseCase interface {
+	Execute(ctx context.Context, p dto.DeleteFavoriteListParams) error
+}
+
+type AddProductToListUseCase interface {
+	Execute(ctx context.Context, p dto.AddProductToListParams) (dto.ListProduct, error)
+}
+
+type RemoveProductFromListUseCase interface {
+	Execute(ctx context.Context, p dto.RemoveProductFromListParams) error
+}
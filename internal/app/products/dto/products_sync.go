@@ -0,0 +1,33 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/uesleicarvalhoo/aiqfome/product"
+)
+
+type ProductsSync struct {
+	StartedAt  time.Time `json:"startedAt"`
+	FinishedAt time.Time `json:"finishedAt"`
+	Upserted   int       `json:"upserted"`
+	Removed    int       `json:"removed"`
+	Failed     bool      `json:"failed"`
+	Error      string    `json:"error,omitempty"`
+}
+
+func ProductsSyncFromDomain(s product.Sync) ProductsSync {
+	return ProductsSync{
+		StartedAt:  s.StartedAt,
+		FinishedAt: s.FinishedAt,
+		Upserted:   s.Upserted,
+		Removed:    s.Removed,
+		Failed:     s.Failed(),
+		Error:      s.Error,
+	}
+}
+
+// ProductsSyncStatus tells whether the products are read from upstream or from the mirror, LastSync is null when the mirror was never synced
+type ProductsSyncStatus struct {
+	Source   string        `json:"source" enums:"upstream,mirror"`
+	LastSync *ProductsSync `json:"lastSync"`
+}
@@ -0,0 +1,57 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	dto "github.com/uesleicarvalhoo/aiqfome/internal/app/products/dto"
+)
+
+// GetProductsSyncStatusUseCase is an autogenerated mock type for the GetProductsSyncStatusUseCase type
+type GetProductsSyncStatusUseCase struct {
+	mock.Mock
+}
+
+// Execute provides a mock function with given fields: ctx
+func (_m *GetProductsSyncStatusUseCase) Execute(ctx context.Context) (dto.ProductsSyncStatus, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 dto.ProductsSyncStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (dto.ProductsSyncStatus, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) dto.ProductsSyncStatus); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(dto.ProductsSyncStatus)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewGetProductsSyncStatusUseCase creates a new instance of GetProductsSyncStatusUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewGetProductsSyncStatusUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *GetProductsSyncStatusUseCase {
+	mock := &GetProductsSyncStatusUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,57 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	dto "github.com/uesleicarvalhoo/aiqfome/internal/app/products/dto"
+)
+
+// SyncProductsUseCase is an autogenerated mock type for the SyncProductsUseCase type
+type SyncProductsUseCase struct {
+	mock.Mock
+}
+
+// Execute provides a mock function with given fields: ctx
+func (_m *SyncProductsUseCase) Execute(ctx context.Context) (dto.ProductsSync, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 dto.ProductsSync
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (dto.ProductsSync, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) dto.ProductsSync); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(dto.ProductsSync)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewSyncProductsUseCase creates a new instance of SyncProductsUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSyncProductsUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SyncProductsUseCase {
+	mock := &SyncProductsUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/products"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/products/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/domainerror"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/logger"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/trace"
+	"github.com/uesleicarvalhoo/aiqfome/product"
+)
+
+type getProductsSyncStatusUseCase struct {
+	mirror product.Mirror
+	source string
+}
+
+// NewGetProductsSyncStatusUseCase returns the status of the mirror, source is where the products are read from
+func NewGetProductsSyncStatusUseCase(mirror product.Mirror, source string) products.GetProductsSyncStatusUseCase {
+	return &getProductsSyncStatusUseCase{
+		mirror: mirror,
+		source: source,
+	}
+}
+
+func (u *getProductsSyncStatusUseCase) Execute(ctx context.Context) (dto.ProductsSyncStatus, error) {
+	ctx, span := trace.NewSpan(ctx, "products.getProductsSyncStatus")
+	defer span.End()
+
+	st := dto.ProductsSyncStatus{Source: u.source}
+
+	s, err := u.mirror.LastSync(ctx)
+	if err != nil {
+		if errors.Is(err, product.ErrSyncNotFound) {
+			return st, nil
+		}
+
+		logger.ErrorF(ctx, "error while trying to get last products sync", logger.Fields{
+			"error": err.Error(),
+		})
+
+		return dto.ProductsSyncStatus{}, domainerror.Wrap(err, domainerror.DependecyError, "erro ao buscar sincronização de produtos", map[string]any{
+			"error": err.Error(),
+		})
+	}
+
+	last := dto.ProductsSyncFromDomain(s)
+	st.LastSync = &last
+
+	return st, nil
+}
@@ -0,0 +1,90 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/products/dto"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/products/usecase"
+	"github.com/uesleicarvalhoo/aiqfome/product"
+	prodMocks "github.com/uesleicarvalhoo/aiqfome/product/mocks"
+)
+
+func TestGetProductsSyncStatusUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	startedAt := time.Now().Add(-time.Minute)
+	last := product.Sync{StartedAt: startedAt, FinishedAt: startedAt.Add(time.Second), Error: "service down"}
+
+	testCases := []struct {
+		about          string
+		setupMirror    func(m *prodMocks.Mirror)
+		expectedErr    string
+		expectedResult dto.ProductsSyncStatus
+	}{
+		{
+			about: "when last sync fails",
+			setupMirror: func(m *prodMocks.Mirror) {
+				m.On("LastSync", mock.Anything).Return(product.Sync{}, errors.New("db error"))
+			},
+			expectedErr: "[AQF004] erro ao buscar sincronização de produtos",
+		},
+		{
+			about: "when the mirror was never synced",
+			setupMirror: func(m *prodMocks.Mirror) {
+				m.On("LastSync", mock.Anything).Return(product.Sync{}, product.ErrSyncNotFound)
+			},
+			expectedResult: dto.ProductsSyncStatus{Source: "mirror"},
+		},
+		{
+			about: "when the mirror was synced",
+			setupMirror: func(m *prodMocks.Mirror) {
+				m.On("LastSync", mock.Anything).Return(last, nil)
+			},
+			expectedResult: dto.ProductsSyncStatus{
+				Source: "mirror",
+				LastSync: &dto.ProductsSync{
+					StartedAt:  last.StartedAt,
+					FinishedAt: last.FinishedAt,
+					Failed:     true,
+					Error:      "service down",
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			mirror := prodMocks.NewMirror(t)
+			if tc.setupMirror != nil {
+				tc.setupMirror(mirror)
+			}
+
+			uc := usecase.NewGetProductsSyncStatusUseCase(mirror, "mirror")
+
+			// Action
+			res, err := uc.Execute(context.Background())
+
+			// Assert
+			if tc.expectedErr != "" {
+				assert.Equal(t, dto.ProductsSyncStatus{}, res)
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, res)
+		})
+	}
+}
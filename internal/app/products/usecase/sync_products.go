@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/products"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/products/dto"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/domainerror"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/logger"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/trace"
+	"github.com/uesleicarvalhoo/aiqfome/product"
+)
+
+type syncProductsUseCase struct {
+	upstream product.Reader
+	mirror   product.Mirror
+}
+
+func NewSyncProductsUseCase(upstream product.Reader, mirror product.Mirror) products.SyncProductsUseCase {
+	return &syncProductsUseCase{
+		upstream: upstream,
+		mirror:   mirror,
+	}
+}
+
+func (u *syncProductsUseCase) Execute(ctx context.Context) (dto.ProductsSync, error) {
+	ctx, span := trace.NewSpan(ctx, "products.syncProducts")
+	defer span.End()
+
+	s := product.Sync{StartedAt: time.Now()}
+
+	catalog, err := u.upstream.All(ctx)
+	if err == nil && len(catalog) == 0 {
+		// An empty catalog is most likely an upstream failure, syncing it would mark every product as removed
+		err = product.ErrEmptyCatalog
+	}
+	if err != nil {
+		logger.ErrorF(ctx, "error while trying to pull products catalog", logger.Fields{
+			"error": err.Error(),
+		})
+
+		return u.failed(ctx, s, err, "erro ao buscar catálogo de produtos")
+	}
+
+	s.Upserted, s.Removed, err = u.mirror.Sync(ctx, catalog, s.StartedAt)
+	if err != nil {
+		logger.ErrorF(ctx, "error while trying to sync products mirror", logger.Fields{
+			"products": len(catalog),
+			"error":    err.Error(),
+		})
+
+		return u.failed(ctx, s, err, "erro ao sincronizar catálogo de produtos")
+	}
+
+	s.FinishedAt = time.Now()
+	if err := u.save(ctx, s); err != nil {
+		return dto.ProductsSync{}, err
+	}
+
+	logger.InfoF(ctx, "products mirror synced", logger.Fields{
+		"products": len(catalog),
+		"upserted": s.Upserted,
+		"removed":  s.Removed,
+	})
+
+	return dto.ProductsSyncFromDomain(s), nil
+}
+
+func (u *syncProductsUseCase) failed(ctx context.Context, s product.Sync, err error, msg string) (dto.ProductsSync, error) {
+	s.Upserted, s.Removed = 0, 0
+	s.FinishedAt = time.Now()
+	s.Error = err.Error()
+
+	if saveErr := u.save(ctx, s); saveErr != nil {
+		return dto.ProductsSync{}, saveErr
+	}
+
+	return dto.ProductsSync{}, domainerror.Wrap(err, domainerror.DependecyError, msg, map[string]any{
+		"error": err.Error(),
+	})
+}
+
+func (u *syncProductsUseCase) save(ctx context.Context, s product.Sync) error {
+	if err := u.mirror.SaveSync(ctx, s); err != nil {
+		logger.ErrorF(ctx, "error while trying to save products sync", logger.Fields{
+			"started_at": s.StartedAt,
+			"error":      err.Error(),
+		})
+
+		return domainerror.Wrap(err, domainerror.DependecyError, "erro ao salvar sincronização de produtos", map[string]any{
+			"error": err.Error(),
+		})
+	}
+
+	return nil
+}
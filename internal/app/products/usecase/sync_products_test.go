@@ -0,0 +1,133 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/products/dto"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/products/usecase"
+	"github.com/uesleicarvalhoo/aiqfome/product"
+	"github.com/uesleicarvalhoo/aiqfome/product/fixture"
+	prodMocks "github.com/uesleicarvalhoo/aiqfome/product/mocks"
+)
+
+func TestSyncProductsUseCase_Execute(t *testing.T) {
+	t.Parallel()
+
+	catalog := []product.Product{
+		fixture.AnyProduct().WithID(1).Build(),
+		fixture.AnyProduct().WithID(2).Build(),
+	}
+
+	failedWith := func(msg string) any {
+		return mock.MatchedBy(func(s product.Sync) bool {
+			return s.Error == msg && s.Upserted == 0 && s.Removed == 0 && !s.FinishedAt.Before(s.StartedAt)
+		})
+	}
+
+	testCases := []struct {
+		about          string
+		setupUpstream  func(m *prodMocks.Reader)
+		setupMirror    func(m *prodMocks.Mirror)
+		expectedErr    string
+		expectedResult dto.ProductsSync
+	}{
+		{
+			about: "when upstream fails",
+			setupUpstream: func(m *prodMocks.Reader) {
+				m.On("All", mock.Anything).Return(nil, errors.New("service down"))
+			},
+			setupMirror: func(m *prodMocks.Mirror) {
+				m.On("SaveSync", mock.Anything, failedWith("service down")).Return(nil)
+			},
+			expectedErr: "[AQF004] erro ao buscar catálogo de produtos",
+		},
+		{
+			about: "when upstream returns an empty catalog nothing is removed",
+			setupUpstream: func(m *prodMocks.Reader) {
+				m.On("All", mock.Anything).Return([]product.Product{}, nil)
+			},
+			setupMirror: func(m *prodMocks.Mirror) {
+				m.On("SaveSync", mock.Anything, failedWith("empty catalog")).Return(nil)
+			},
+			expectedErr: "[AQF004] erro ao buscar catálogo de produtos",
+		},
+		{
+			about: "when mirror sync fails",
+			setupUpstream: func(m *prodMocks.Reader) {
+				m.On("All", mock.Anything).Return(catalog, nil)
+			},
+			setupMirror: func(m *prodMocks.Mirror) {
+				m.On("Sync", mock.Anything, catalog, mock.AnythingOfType("time.Time")).Return(0, 0, errors.New("db error"))
+				m.On("SaveSync", mock.Anything, failedWith("db error")).Return(nil)
+			},
+			expectedErr: "[AQF004] erro ao sincronizar catálogo de produtos",
+		},
+		{
+			about: "when saving the sync fails",
+			setupUpstream: func(m *prodMocks.Reader) {
+				m.On("All", mock.Anything).Return(catalog, nil)
+			},
+			setupMirror: func(m *prodMocks.Mirror) {
+				m.On("Sync", mock.Anything, catalog, mock.AnythingOfType("time.Time")).Return(2, 1, nil)
+				m.On("SaveSync", mock.Anything, mock.AnythingOfType("product.Sync")).Return(errors.New("db error"))
+			},
+			expectedErr: "[AQF004] erro ao salvar sincronização de produtos",
+		},
+		{
+			about: "when all is valid",
+			setupUpstream: func(m *prodMocks.Reader) {
+				m.On("All", mock.Anything).Return(catalog, nil)
+			},
+			setupMirror: func(m *prodMocks.Mirror) {
+				m.On("Sync", mock.Anything, catalog, mock.AnythingOfType("time.Time")).Return(2, 1, nil)
+				m.On("SaveSync", mock.Anything, mock.MatchedBy(func(s product.Sync) bool {
+					return s.Upserted == 2 && s.Removed == 1 && !s.Failed()
+				})).Return(nil)
+			},
+			expectedResult: dto.ProductsSync{Upserted: 2, Removed: 1},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			upstream := prodMocks.NewReader(t)
+			if tc.setupUpstream != nil {
+				tc.setupUpstream(upstream)
+			}
+
+			mirror := prodMocks.NewMirror(t)
+			if tc.setupMirror != nil {
+				tc.setupMirror(mirror)
+			}
+
+			uc := usecase.NewSyncProductsUseCase(upstream, mirror)
+
+			// Action
+			res, err := uc.Execute(context.Background())
+
+			// Assert
+			if tc.expectedErr != "" {
+				assert.Equal(t, dto.ProductsSync{}, res)
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedErr)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedResult.Upserted, res.Upserted)
+			assert.Equal(t, tc.expectedResult.Removed, res.Removed)
+			assert.False(t, res.Failed)
+			assert.False(t, res.StartedAt.IsZero())
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package products
+
+import (
+	"context"
+
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/products/dto"
+)
+
+// SyncProductsUseCase pulls the catalog from upstream into the local mirror, every run is recorded even when it fails
+type SyncProductsUseCase interface {
+	Execute(ctx context.Context) (dto.ProductsSync, error)
+}
+
+// GetProductsSyncStatusUseCase returns where the products are read from and the last run of the mirror sync
+type GetProductsSyncStatusUseCase interface {
+	Execute(ctx context.Context) (dto.ProductsSyncStatus, error)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/uesleicarvalhoo/aiqfome/internal/app/auth"
 	"github.com/uesleicarvalhoo/aiqfome/internal/app/client"
 	"github.com/uesleicarvalhoo/aiqfome/internal/app/client/dto"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites"
 	"github.com/uesleicarvalhoo/aiqfome/internal/http/middleware"
 	"github.com/uesleicarvalhoo/aiqfome/internal/http/utils"
 	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
@@ -19,11 +20,27 @@ func Clients(r fiber.Router,
 	listClientsUc client.ListClientsUseCase,
 	updateClientUc client.UpdateClientUseCase,
 	deleteClientUc client.DeleteClientUseCase,
+	exportClientFavoritesUc favorites.ExportClientFavoritesUseCase,
+	getFavoritesQuotaUc favorites.GetFavoritesQuotaUseCase,
+	setFavoritesQuotaUc favorites.SetFavoritesQuotaUseCase,
+	removeFavoritesQuotaUc favorites.RemoveFavoritesQuotaUseCase,
+	getFavoritesHistoryUc favorites.GetFavoritesHistoryUseCase,
+	getClientFavoritesUc favorites.GetClientFavoritesUseCase,
+	addProductToFavoritesUc favorites.AddProductToFavoritesUseCase,
+	removeProductFromFavoritesUc favorites.RemoveProductFromFavoritesUseCase,
 ) {
 	r.Get("/:id", middleware.Authorize(authorizeUc, role.ResourceClient, role.ActionRead), findClient(findClientUc))
 	r.Get("/", middleware.Authorize(authorizeUc, role.ResourceClient, role.ActionRead), listClients(listClientsUc))
 	r.Patch("/:id", middleware.Authorize(authorizeUc, role.ResourceClient, role.ActionWrite), updateClient(updateClientUc))
 	r.Delete("/:id", middleware.Authorize(authorizeUc, role.ResourceClient, role.ActionDelete), deleteClient(deleteClientUc))
+	r.Get("/:id/favorites", middleware.Authorize(authorizeUc, role.ResourceFavorites, role.ActionRead), listClientFavorites(getClientFavoritesUc))
+	r.Post("/:id/favorites", middleware.Authorize(authorizeUc, role.ResourceFavorites, role.ActionWrite), addProductToClientFavorites(addProductToFavoritesUc))
+	r.Delete("/:id/favorites/product/:productId", middleware.Authorize(authorizeUc, role.ResourceFavorites, role.ActionDelete), removeProductFromClientFavorites(removeProductFromFavoritesUc))
+	r.Get("/:id/favorites/export", middleware.Authorize(authorizeUc, role.ResourceFavorites, role.ActionRead), exportClientFavorites(exportClientFavoritesUc))
+	r.Get("/:id/favorites/quota", middleware.Authorize(authorizeUc, role.ResourceFavorites, role.ActionRead), getClientFavoritesQuota(getFavoritesQuotaUc))
+	r.Put("/:id/favorites/quota", middleware.Authorize(authorizeUc, role.ResourceFavorites, role.ActionWrite), setClientFavoritesQuota(setFavoritesQuotaUc))
+	r.Delete("/:id/favorites/quota", middleware.Authorize(authorizeUc, role.ResourceFavorites, role.ActionDelete), removeClientFavoritesQuota(removeFavoritesQuotaUc))
+	r.Get("/:id/favorites/history", middleware.Authorize(authorizeUc, role.ResourceFavorites, role.ActionRead), getClientFavoritesHistory(getFavoritesHistoryUc))
 }
 
 // @Summary      Get client
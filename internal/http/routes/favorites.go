@@ -0,0 +1,342 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/auth"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/internal/http/middleware"
+	"github.com/uesleicarvalhoo/aiqfome/internal/http/utils"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/domainerror"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/role"
+)
+
+func Favorites(r fiber.Router,
+	authorizeUc auth.AuthorizeUseCase,
+	getFavoritesStatsUc favorites.GetFavoritesStatsUseCase,
+) {
+	r.Get("/stats", middleware.Authorize(authorizeUc, role.ResourceFavorites, role.ActionRead), getFavoritesStats(getFavoritesStatsUc))
+}
+
+// @Summary      Get favorites stats
+// @Description  Return the most favorited products, the favorites added and removed per day and the favorites per category
+// @Tags         Favorites
+// @Accept       json
+// @Produce      json
+// @Param        days  query     int  false  "Days of activity, default 30"
+// @Param        top   query     int  false  "Amount of most favorited products, default 10"
+// @Success      200   {object}  dto.FavoritesStats
+// @Failure      401   {object}  utils.APIError
+// @Failure      403   {object}  utils.APIError
+// @Failure      422   {object}  utils.APIError "Invalid params"
+// @Failure      500   {object}  utils.APIError
+// @Security     BearerAuth
+// @Router       /favorites/stats [get]
+func getFavoritesStats(uc favorites.GetFavoritesStatsUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var params dto.GetFavoritesStatsParams
+
+		if err := c.QueryParser(&params); err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		st, err := uc.Execute(c.UserContext(), params)
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		return c.Status(http.StatusOK).JSON(st)
+	}
+}
+
+// @Summary      List client favorites
+// @Description  Retrieve paginated list of favorite products of the client by the given ID, with the same filters of the client's own listing
+// @Tags         Clients
+// @Accept       json
+// @Produce      json
+// @Param        id        path      string  true   "Client ID (UUID)"
+// @Param        page      query     int     false  "Page number, starts from 0"
+// @Param        pageSize  query     int     false  "Items per page, default 10"
+// @Param        tag       query     string  false  "Only favorites with the given tag"
+// @Param        mode      query     string  false  "Pagination mode, page (default) or cursor"  Enums(page, cursor)
+// @Param        cursor    query     string  false  "Cursor returned as nextCursor or prevCursor, implies the cursor mode"
+// @Param        sort      query     string  false  "Sort field, default by product id"  Enums(registeredAt, price, title, rating, manual)
+// @Param        order     query     string  false  "Sort order, default asc"  Enums(asc, desc)
+// @Param        category  query     string  false  "Only products of the given category"
+// @Param        minPrice  query     number  false  "Only products with price greater or equal"
+// @Param        maxPrice  query     number  false  "Only products with price lower or equal"
+// @Param        minRating query     number  false  "Only products with rating greater or equal"
+// @Param        priceDropped query  bool    false  "Only products cheaper than when they were favorited"
+// @Success      200       {object}  dto.ClientFavorites
+// @Failure      400       {object}  utils.APIError
+// @Failure      401       {object}  utils.APIError
+// @Failure      403       {object}  utils.APIError
+// @Failure      422       {object}  utils.APIError "Invalid params"
+// @Failure      500       {object}  utils.APIError
+// @Security     BearerAuth
+// @Router       /clients/{id}/favorites [get]
+func listClientFavorites(uc favorites.GetClientFavoritesUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		cId, err := uuid.Parse(c.Params("id"))
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		var params dto.GetClientFavoritesParams
+		if err := c.QueryParser(&params); err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		params.ClientID = cId
+		fv, err := uc.Execute(c.UserContext(), params)
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		return c.Status(http.StatusOK).JSON(fv)
+	}
+}
+
+// @Summary      Add product to client favorites
+// @Description  Add a product to the favorites of the client by the given ID, recorded in the history as made on behalf of the client
+// @Tags         Clients
+// @Accept       json
+// @Produce      json
+// @Param        id        path      string                           true  "Client ID (UUID)"
+// @Param        favorite  body      dto.AddProductToFavoritesParams  true  "Product to add"
+// @Success      200       {object}  dto.ProductFavorite             "Added favorite"
+// @Failure      400       {object}  utils.APIError
+// @Failure      401       {object}  utils.APIError
+// @Failure      403       {object}  utils.APIError
+// @Failure      404       {object}  utils.APIError
+// @Failure      409       {object}  utils.APIError "Already a favorite or favorites quota exceeded"
+// @Failure      422       {object}  utils.APIError "Invalid params"
+// @Failure      500       {object}  utils.APIError
+// @Security     BearerAuth
+// @Router       /clients/{id}/favorites [post]
+func addProductToClientFavorites(uc favorites.AddProductToFavoritesUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		cId, err := uuid.Parse(c.Params("id"))
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		var params dto.AddProductToFavoritesParams
+		if err := c.BodyParser(&params); err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		params.ClientID = cId
+		p, err := uc.Execute(c.UserContext(), params)
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		return c.Status(http.StatusOK).JSON(p)
+	}
+}
+
+// @Summary      Remove product from client favorites
+// @Description  Remove a product from the favorites of the client by the given ID, recorded in the history as made on behalf of the client
+// @Tags         Clients
+// @Param        id         path  string  true  "Client ID (UUID)"
+// @Param        productId  path  int     true  "Product ID"
+// @Success      200
+// @Failure      400  {object}  utils.APIError
+// @Failure      401  {object}  utils.APIError
+// @Failure      403  {object}  utils.APIError
+// @Failure      404  {object}  utils.APIError
+// @Failure      422  {object}  utils.APIError "Invalid params"
+// @Failure      500  {object}  utils.APIError
+// @Security     BearerAuth
+// @Router       /clients/{id}/favorites/product/{productId} [delete]
+func removeProductFromClientFavorites(uc favorites.RemoveProductFromFavoritesUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		cId, err := uuid.Parse(c.Params("id"))
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		pID, err := strconv.Atoi(c.Params("productId"))
+		if err != nil {
+			return utils.WriteError(c, domainerror.Wrap(err, domainerror.InvalidParams, "id do produto inválido", map[string]any{
+				"product_id": c.Params("productId"),
+			}))
+		}
+
+		if err := uc.Execute(c.UserContext(), dto.RemoveProductFromFavoritesParams{
+			ClientID:  cId,
+			ProductID: pID,
+		}); err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		return c.SendStatus(http.StatusOK)
+	}
+}
+
+// @Summary      Export client favorites
+// @Description  Download all favorites of the client by the given ID with the product details, as csv or json
+// @Tags         Clients
+// @Produce      text/csv
+// @Produce      json
+// @Param        id      path      string  true   "Client ID (UUID)"
+// @Param        format  query     string  false  "Export format, csv or json, default csv"
+// @Success      200     {array}   dto.ExportedFavorite
+// @Failure      400     {object}  utils.APIError
+// @Failure      401     {object}  utils.APIError
+// @Failure      403     {object}  utils.APIError
+// @Failure      422     {object}  utils.APIError "Invalid params"
+// @Failure      500     {object}  utils.APIError
+// @Security     BearerAuth
+// @Router       /clients/{id}/favorites/export [get]
+func exportClientFavorites(uc favorites.ExportClientFavoritesUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		cId, err := uuid.Parse(c.Params("id"))
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		return streamFavoritesExport(c, uc, dto.ExportFavoritesParams{
+			ClientID: cId,
+			Format:   dto.ExportFormat(c.Query("format", string(dto.ExportCSV))),
+		})
+	}
+}
+
+// @Summary      Get client favorites quota
+// @Description  Return how many favorites the client by the given ID has against its quota
+// @Tags         Clients
+// @Accept       json
+// @Produce      json
+// @Param        id   path      string  true  "Client ID (UUID)"
+// @Success      200  {object}  dto.FavoritesQuota
+// @Failure      400  {object}  utils.APIError
+// @Failure      401  {object}  utils.APIError
+// @Failure      403  {object}  utils.APIError
+// @Failure      404  {object}  utils.APIError
+// @Failure      500  {object}  utils.APIError
+// @Security     BearerAuth
+// @Router       /clients/{id}/favorites/quota [get]
+func getClientFavoritesQuota(uc favorites.GetFavoritesQuotaUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		cId, err := uuid.Parse(c.Params("id"))
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		q, err := uc.Execute(c.UserContext(), cId)
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		return c.Status(http.StatusOK).JSON(q)
+	}
+}
+
+// @Summary      Set client favorites quota
+// @Description  Override the max favorites of the client by the given ID, 0 means unlimited
+// @Tags         Clients
+// @Accept       json
+// @Produce      json
+// @Param        id     path      string                       true  "Client ID (UUID)"
+// @Param        quota  body      dto.SetFavoritesQuotaParams  true  "Max favorites"
+// @Success      200    {object}  dto.FavoritesQuota
+// @Failure      400    {object}  utils.APIError
+// @Failure      401    {object}  utils.APIError
+// @Failure      403    {object}  utils.APIError
+// @Failure      404    {object}  utils.APIError
+// @Failure      422    {object}  utils.APIError "Invalid params"
+// @Failure      500    {object}  utils.APIError
+// @Security     BearerAuth
+// @Router       /clients/{id}/favorites/quota [put]
+func setClientFavoritesQuota(uc favorites.SetFavoritesQuotaUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		cId, err := uuid.Parse(c.Params("id"))
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		var params dto.SetFavoritesQuotaParams
+		if err := c.BodyParser(&params); err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		params.ClientID = cId
+		q, err := uc.Execute(c.UserContext(), params)
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		return c.Status(http.StatusOK).JSON(q)
+	}
+}
+
+// @Summary      Remove client favorites quota
+// @Description  Remove the override of the client by the given ID, the client goes back to the quota of its role
+// @Tags         Clients
+// @Param        id  path  string  true  "Client ID (UUID)"
+// @Success      204
+// @Failure      400  {object}  utils.APIError
+// @Failure      401  {object}  utils.APIError
+// @Failure      403  {object}  utils.APIError
+// @Failure      404  {object}  utils.APIError
+// @Failure      500  {object}  utils.APIError
+// @Security     BearerAuth
+// @Router       /clients/{id}/favorites/quota [delete]
+func removeClientFavoritesQuota(uc favorites.RemoveFavoritesQuotaUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		cId, err := uuid.Parse(c.Params("id"))
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		if err := uc.Execute(c.UserContext(), cId); err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		return c.SendStatus(http.StatusNoContent)
+	}
+}
+
+// @Summary      Get client favorites history
+// @Description  Retrieve the adds, removals and restores of the favorites of the client by the given ID, most recent first
+// @Tags         Clients
+// @Accept       json
+// @Produce      json
+// @Param        id        path      string  true   "Client ID (UUID)"
+// @Param        page      query     int     false  "Page number, starts from 0"
+// @Param        pageSize  query     int     false  "Items per page, default 10"
+// @Success      200       {object}  dto.FavoritesHistory
+// @Failure      400       {object}  utils.APIError
+// @Failure      401       {object}  utils.APIError
+// @Failure      403       {object}  utils.APIError
+// @Failure      422       {object}  utils.APIError "Invalid params"
+// @Failure      500       {object}  utils.APIError
+// @Security     BearerAuth
+// @Router       /clients/{id}/favorites/history [get]
+func getClientFavoritesHistory(uc favorites.GetFavoritesHistoryUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		cId, err := uuid.Parse(c.Params("id"))
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		var params dto.GetFavoritesHistoryParams
+		if err := c.QueryParser(&params); err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		params.ClientID = cId
+		res, err := uc.Execute(c.UserContext(), params)
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		return c.Status(http.StatusOK).JSON(res)
+	}
+}
@@ -0,0 +1,561 @@
+package routes
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	favoritesMocks "github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/mocks"
+	"github.com/uesleicarvalhoo/aiqfome/internal/http/utils"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/domainerror"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/product"
+)
+
+func Test_getFavoritesStats(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		about           string
+		query           string
+		setupUC         func(uc *favoritesMocks.GetFavoritesStatsUseCase)
+		expectedStatus  int
+		expectedBody    *dto.FavoritesStats
+		expectedErrCode string
+	}{
+		{
+			about: "when ok",
+			query: "?days=7&top=5",
+			setupUC: func(uc *favoritesMocks.GetFavoritesStatsUseCase) {
+				uc.
+					On("Execute", mock.Anything, dto.GetFavoritesStatsParams{Days: 7, Top: 5}).
+					Return(dto.FavoritesStats{
+						Categories: []dto.CategoryStats{{Category: "electronics", Favorites: 3}},
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: &dto.FavoritesStats{
+				Categories: []dto.CategoryStats{{Category: "electronics", Favorites: 3}},
+			},
+		},
+		{
+			about: "when params are invalid",
+			query: "?days=1000",
+			setupUC: func(uc *favoritesMocks.GetFavoritesStatsUseCase) {
+				err := domainerror.New(domainerror.InvalidParams, "days: deve estar entre 1 e 365", nil)
+				uc.
+					On("Execute", mock.Anything, dto.GetFavoritesStatsParams{Days: 1000}).
+					Return(dto.FavoritesStats{}, err)
+			},
+			expectedStatus:  http.StatusUnprocessableEntity,
+			expectedErrCode: string(domainerror.InvalidParams),
+		},
+		{
+			about: "when usecase returns dependency error",
+			setupUC: func(uc *favoritesMocks.GetFavoritesStatsUseCase) {
+				err := domainerror.Wrap(errors.New("db error"), domainerror.DependecyError, "erro ao buscar estatísticas de favoritos", nil)
+				uc.
+					On("Execute", mock.Anything, dto.GetFavoritesStatsParams{}).
+					Return(dto.FavoritesStats{}, err)
+			},
+			expectedStatus:  http.StatusInternalServerError,
+			expectedErrCode: string(domainerror.DependecyError),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			uc := favoritesMocks.NewGetFavoritesStatsUseCase(t)
+			if tc.setupUC != nil {
+				tc.setupUC(uc)
+			}
+
+			app := fiber.New()
+			app.Get("/stats", getFavoritesStats(uc))
+
+			// Action
+			req := httptest.NewRequest(http.MethodGet, "/stats"+tc.query, nil)
+			resp, err := app.Test(req)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, resp.StatusCode)
+
+			if tc.expectedBody != nil {
+				var got dto.FavoritesStats
+				assert.NoError(t, json.NewDecoder(resp.Body).Decode(&got))
+				assert.Equal(t, tc.expectedBody.Categories, got.Categories)
+			}
+			if tc.expectedErrCode != "" {
+				var apiErr utils.APIError
+				assert.NoError(t, json.NewDecoder(resp.Body).Decode(&apiErr))
+				assert.Equal(t, tc.expectedErrCode, apiErr.Code)
+			}
+		})
+	}
+}
+
+func Test_exportClientFavorites(t *testing.T) {
+	t.Parallel()
+
+	clientID := uuid.NextID()
+
+	testCases := []struct {
+		about               string
+		path                string
+		setupUC             func(uc *favoritesMocks.ExportClientFavoritesUseCase)
+		expectedStatus      int
+		expectedContentType string
+		expectedBody        string
+		expectedErrCode     string
+	}{
+		{
+			about:           "when id is invalid uuid",
+			path:            "/invalid/favorites/export",
+			expectedStatus:  http.StatusUnprocessableEntity,
+			expectedErrCode: string(domainerror.InvalidParams),
+		},
+		{
+			about:           "when format is invalid",
+			path:            "/" + clientID.String() + "/favorites/export?format=xml",
+			expectedStatus:  http.StatusUnprocessableEntity,
+			expectedErrCode: string(domainerror.InvalidParams),
+		},
+		{
+			about: "when format is not informed",
+			path:  "/" + clientID.String() + "/favorites/export",
+			setupUC: func(uc *favoritesMocks.ExportClientFavoritesUseCase) {
+				uc.
+					On("Execute", mock.Anything, dto.ExportFavoritesParams{ClientID: clientID, Format: dto.ExportCSV}, mock.Anything).
+					Run(func(args mock.Arguments) {
+						args.Get(2).(io.Writer).Write([]byte("product_id\n1\n"))
+					}).
+					Return(nil)
+			},
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "text/csv; charset=utf-8",
+			expectedBody:        "product_id\n1\n",
+		},
+		{
+			about: "when format is json",
+			path:  "/" + clientID.String() + "/favorites/export?format=json",
+			setupUC: func(uc *favoritesMocks.ExportClientFavoritesUseCase) {
+				uc.
+					On("Execute", mock.Anything, dto.ExportFavoritesParams{ClientID: clientID, Format: dto.ExportJSON}, mock.Anything).
+					Run(func(args mock.Arguments) {
+						args.Get(2).(io.Writer).Write([]byte(`[{"productId":1}]`))
+					}).
+					Return(nil)
+			},
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "application/json",
+			expectedBody:        `[{"productId":1}]`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			uc := favoritesMocks.NewExportClientFavoritesUseCase(t)
+			if tc.setupUC != nil {
+				tc.setupUC(uc)
+			}
+
+			app := fiber.New()
+			app.Get("/:id/favorites/export", exportClientFavorites(uc))
+
+			// Action
+			req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, tc.expectedStatus, resp.StatusCode)
+
+			if tc.expectedErrCode != "" {
+				var apiErr utils.APIError
+				assert.NoError(t, json.NewDecoder(resp.Body).Decode(&apiErr))
+				assert.Equal(t, tc.expectedErrCode, apiErr.Code)
+			} else {
+				body, err := io.ReadAll(resp.Body)
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedContentType, resp.Header.Get(fiber.HeaderContentType))
+				assert.Equal(t, tc.expectedBody, string(body))
+			}
+
+			uc.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_setClientFavoritesQuota(t *testing.T) {
+	t.Parallel()
+
+	clientID := uuid.NextID()
+	quota := dto.NewFavoritesQuota(clientID, 50, 10, true)
+
+	testCases := []struct {
+		about           string
+		id              string
+		body            any
+		setupUC         func(uc *favoritesMocks.SetFavoritesQuotaUseCase)
+		expectedStatus  int
+		expectedQuota   *dto.FavoritesQuota
+		expectedErrCode string
+	}{
+		{
+			about:           "when id is invalid uuid",
+			id:              "not-a-uuid",
+			body:            map[string]any{"maxFavorites": 50},
+			expectedStatus:  http.StatusUnprocessableEntity,
+			expectedErrCode: string(domainerror.InvalidParams),
+		},
+		{
+			about: "when usecase returns not found",
+			id:    clientID.String(),
+			body:  map[string]any{"maxFavorites": 50},
+			setupUC: func(uc *favoritesMocks.SetFavoritesQuotaUseCase) {
+				uc.
+					On("Execute", mock.Anything, mock.MatchedBy(func(p dto.SetFavoritesQuotaParams) bool {
+						return p.ClientID == clientID && p.MaxFavorites != nil && *p.MaxFavorites == 50
+					})).
+					Return(dto.FavoritesQuota{}, domainerror.New(domainerror.ResourceNotFound, "cliente não encontrado", nil))
+			},
+			expectedStatus:  http.StatusNotFound,
+			expectedErrCode: string(domainerror.ResourceNotFound),
+		},
+		{
+			about: "when ok",
+			id:    clientID.String(),
+			body:  map[string]any{"maxFavorites": 50},
+			setupUC: func(uc *favoritesMocks.SetFavoritesQuotaUseCase) {
+				uc.
+					On("Execute", mock.Anything, mock.MatchedBy(func(p dto.SetFavoritesQuotaParams) bool {
+						return p.ClientID == clientID && p.MaxFavorites != nil && *p.MaxFavorites == 50
+					})).
+					Return(quota, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedQuota:  &quota,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			uc := favoritesMocks.NewSetFavoritesQuotaUseCase(t)
+			if tc.setupUC != nil {
+				tc.setupUC(uc)
+			}
+
+			app := fiber.New()
+			app.Put("/:id/favorites/quota", setClientFavoritesQuota(uc))
+
+			body, _ := json.Marshal(tc.body)
+
+			// Action
+			req := httptest.NewRequest(http.MethodPut, "/"+tc.id+"/favorites/quota", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, tc.expectedStatus, resp.StatusCode)
+
+			if tc.expectedQuota != nil {
+				var q dto.FavoritesQuota
+				require.NoError(t, json.NewDecoder(resp.Body).Decode(&q))
+				assert.Equal(t, *tc.expectedQuota, q)
+			}
+
+			if tc.expectedErrCode != "" {
+				var apiErr utils.APIError
+				assert.NoError(t, json.NewDecoder(resp.Body).Decode(&apiErr))
+				assert.Equal(t, tc.expectedErrCode, apiErr.Code)
+			}
+
+			uc.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_getClientFavoritesHistory(t *testing.T) {
+	t.Parallel()
+
+	clientID := uuid.NextID()
+	history := dto.FavoritesHistory{
+		ClientID: clientID,
+		Entries: []dto.HistoryEntry{
+			{ProductID: 1, ProductTitle: "Mochila", Action: "removed", ActorID: uuid.NextID(), OnBehalf: true},
+		},
+		Total: 1,
+		Pages: 1,
+	}
+
+	testCases := []struct {
+		about           string
+		id              string
+		query           string
+		setupUC         func(uc *favoritesMocks.GetFavoritesHistoryUseCase)
+		expectedStatus  int
+		expectedHistory *dto.FavoritesHistory
+		expectedErrCode string
+	}{
+		{
+			about:           "when id is invalid uuid",
+			id:              "not-a-uuid",
+			expectedStatus:  http.StatusUnprocessableEntity,
+			expectedErrCode: string(domainerror.InvalidParams),
+		},
+		{
+			about: "when usecase fails",
+			id:    clientID.String(),
+			setupUC: func(uc *favoritesMocks.GetFavoritesHistoryUseCase) {
+				uc.On("Execute", mock.Anything, dto.GetFavoritesHistoryParams{ClientID: clientID}).
+					Return(dto.FavoritesHistory{}, domainerror.New(domainerror.DependecyError, "erro ao paginar histórico de favoritos", nil))
+			},
+			expectedStatus:  http.StatusInternalServerError,
+			expectedErrCode: string(domainerror.DependecyError),
+		},
+		{
+			about: "when ok",
+			id:    clientID.String(),
+			query: "?page=1&pageSize=5",
+			setupUC: func(uc *favoritesMocks.GetFavoritesHistoryUseCase) {
+				uc.On("Execute", mock.Anything, dto.GetFavoritesHistoryParams{ClientID: clientID, Page: 1, PageSize: 5}).
+					Return(history, nil)
+			},
+			expectedStatus:  http.StatusOK,
+			expectedHistory: &history,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			uc := favoritesMocks.NewGetFavoritesHistoryUseCase(t)
+			if tc.setupUC != nil {
+				tc.setupUC(uc)
+			}
+
+			app := fiber.New()
+			app.Get("/:id/favorites/history", getClientFavoritesHistory(uc))
+
+			// Action
+			req := httptest.NewRequest(http.MethodGet, "/"+tc.id+"/favorites/history"+tc.query, nil)
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, tc.expectedStatus, resp.StatusCode)
+
+			if tc.expectedHistory != nil {
+				var h dto.FavoritesHistory
+				require.NoError(t, json.NewDecoder(resp.Body).Decode(&h))
+				assert.Equal(t, *tc.expectedHistory, h)
+			}
+
+			if tc.expectedErrCode != "" {
+				var apiErr utils.APIError
+				assert.NoError(t, json.NewDecoder(resp.Body).Decode(&apiErr))
+				assert.Equal(t, tc.expectedErrCode, apiErr.Code)
+			}
+		})
+	}
+}
+
+func Test_addProductToClientFavorites(t *testing.T) {
+	t.Parallel()
+
+	clientID := uuid.NextID()
+	added := dto.ProductFavorite{
+		ClientID: clientID,
+		Product:  product.Product{ID: 1, Title: "Mochila"},
+	}
+
+	testCases := []struct {
+		about           string
+		id              string
+		body            string
+		setupUC         func(uc *favoritesMocks.AddProductToFavoritesUseCase)
+		expectedStatus  int
+		expectedBody    *dto.ProductFavorite
+		expectedErrCode string
+	}{
+		{
+			about:           "when id is invalid uuid",
+			id:              "not-a-uuid",
+			body:            `{"productId":1}`,
+			expectedStatus:  http.StatusUnprocessableEntity,
+			expectedErrCode: string(domainerror.InvalidParams),
+		},
+		{
+			about: "when client is not found",
+			id:    clientID.String(),
+			body:  `{"productId":1}`,
+			setupUC: func(uc *favoritesMocks.AddProductToFavoritesUseCase) {
+				uc.On("Execute", mock.Anything, dto.AddProductToFavoritesParams{ClientID: clientID, ProductID: 1}).
+					Return(dto.ProductFavorite{}, domainerror.New(domainerror.ResourceNotFound, "cliente não encontrado", nil))
+			},
+			expectedStatus:  http.StatusNotFound,
+			expectedErrCode: string(domainerror.ResourceNotFound),
+		},
+		{
+			about: "when ok the product is added to the client of the path",
+			id:    clientID.String(),
+			body:  `{"productId":1}`,
+			setupUC: func(uc *favoritesMocks.AddProductToFavoritesUseCase) {
+				uc.On("Execute", mock.Anything, dto.AddProductToFavoritesParams{ClientID: clientID, ProductID: 1}).
+					Return(added, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   &added,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			uc := favoritesMocks.NewAddProductToFavoritesUseCase(t)
+			if tc.setupUC != nil {
+				tc.setupUC(uc)
+			}
+
+			app := fiber.New()
+			app.Post("/:id/favorites", addProductToClientFavorites(uc))
+
+			// Action
+			req := httptest.NewRequest(http.MethodPost, "/"+tc.id+"/favorites", bytes.NewBufferString(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, tc.expectedStatus, resp.StatusCode)
+
+			if tc.expectedBody != nil {
+				var pf dto.ProductFavorite
+				require.NoError(t, json.NewDecoder(resp.Body).Decode(&pf))
+				assert.Equal(t, *tc.expectedBody, pf)
+			}
+
+			if tc.expectedErrCode != "" {
+				var apiErr utils.APIError
+				assert.NoError(t, json.NewDecoder(resp.Body).Decode(&apiErr))
+				assert.Equal(t, tc.expectedErrCode, apiErr.Code)
+			}
+		})
+	}
+}
+
+func Test_removeProductFromClientFavorites(t *testing.T) {
+	t.Parallel()
+
+	clientID := uuid.NextID()
+
+	testCases := []struct {
+		about           string
+		path            string
+		setupUC         func(uc *favoritesMocks.RemoveProductFromFavoritesUseCase)
+		expectedStatus  int
+		expectedErrCode string
+	}{
+		{
+			about:           "when id is invalid uuid",
+			path:            "/not-a-uuid/favorites/product/1",
+			expectedStatus:  http.StatusUnprocessableEntity,
+			expectedErrCode: string(domainerror.InvalidParams),
+		},
+		{
+			about:           "when product id is invalid",
+			path:            "/" + clientID.String() + "/favorites/product/abc",
+			expectedStatus:  http.StatusUnprocessableEntity,
+			expectedErrCode: string(domainerror.InvalidParams),
+		},
+		{
+			about: "when favorite is not found",
+			path:  "/" + clientID.String() + "/favorites/product/1",
+			setupUC: func(uc *favoritesMocks.RemoveProductFromFavoritesUseCase) {
+				uc.On("Execute", mock.Anything, dto.RemoveProductFromFavoritesParams{ClientID: clientID, ProductID: 1}).
+					Return(domainerror.New(domainerror.ResourceNotFound, "favorito não encontrado", nil))
+			},
+			expectedStatus:  http.StatusNotFound,
+			expectedErrCode: string(domainerror.ResourceNotFound),
+		},
+		{
+			about: "when ok the product is removed from the client of the path",
+			path:  "/" + clientID.String() + "/favorites/product/1",
+			setupUC: func(uc *favoritesMocks.RemoveProductFromFavoritesUseCase) {
+				uc.On("Execute", mock.Anything, dto.RemoveProductFromFavoritesParams{ClientID: clientID, ProductID: 1}).
+					Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			uc := favoritesMocks.NewRemoveProductFromFavoritesUseCase(t)
+			if tc.setupUC != nil {
+				tc.setupUC(uc)
+			}
+
+			app := fiber.New()
+			app.Delete("/:id/favorites/product/:productId", removeProductFromClientFavorites(uc))
+
+			// Action
+			req := httptest.NewRequest(http.MethodDelete, tc.path, nil)
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, tc.expectedStatus, resp.StatusCode)
+
+			if tc.expectedErrCode != "" {
+				var apiErr utils.APIError
+				assert.NoError(t, json.NewDecoder(resp.Body).Decode(&apiErr))
+				assert.Equal(t, tc.expectedErrCode, apiErr.Code)
+			}
+		})
+	}
+}
@@ -1,6 +1,10 @@
 package routes
 
 import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
 	"net/http"
 	"strconv"
 
@@ -10,26 +14,72 @@ import (
 	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
 	"github.com/uesleicarvalhoo/aiqfome/internal/http/utils"
 	"github.com/uesleicarvalhoo/aiqfome/pkg/domainerror"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/logger"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+	"github.com/uesleicarvalhoo/aiqfome/user"
 )
 
 func Me(r fiber.Router,
 	getClientFavoritesUc favorites.GetClientFavoritesUseCase,
 	addProductToFavoritesUc favorites.AddProductToFavoritesUseCase,
 	removeProductFromFavoritesUc favorites.RemoveProductFromFavoritesUseCase,
+	updateFavoriteUc favorites.UpdateFavoriteUseCase,
+	addProductsToFavoritesUc favorites.AddProductsToFavoritesUseCase,
+	removeProductsFromFavoritesUc favorites.RemoveProductsFromFavoritesUseCase,
+	getFavoritesTrashUc favorites.GetFavoritesTrashUseCase,
+	restoreFavoriteUc favorites.RestoreFavoriteUseCase,
+	exportClientFavoritesUc favorites.ExportClientFavoritesUseCase,
+	importFavoritesUc favorites.ImportFavoritesUseCase,
+	getImportJobUc favorites.GetImportJobUseCase,
+	createFavoritesShareUc favorites.CreateFavoritesShareUseCase,
+	getFavoritesSharesUc favorites.GetFavoritesSharesUseCase,
+	revokeFavoritesShareUc favorites.RevokeFavoritesShareUseCase,
+	getFavoritesQuotaUc favorites.GetFavoritesQuotaUseCase,
+	getFavoritesHistoryUc favorites.GetFavoritesHistoryUseCase,
+	getRecommendationsUc favorites.GetRecommendationsUseCase,
+	getFavoritesChangesUc favorites.GetFavoritesChangesUseCase,
+	reorderFavoritesUc favorites.ReorderFavoritesUseCase,
+	saveProductToFavoritesUc favorites.SaveProductToFavoritesUseCase,
 ) {
-	r.Get("/", getMe())
+	r.Get("/", getMe(getFavoritesQuotaUc))
 	r.Get("/favorites", getClientFavorites(getClientFavoritesUc))
 	r.Post("/favorites", addProductToFavorites(addProductToFavoritesUc))
+	r.Post("/favorites/batch", addProductsToFavorites(addProductsToFavoritesUc))
+	r.Delete("/favorites/batch", removeProductsFromFavorites(removeProductsFromFavoritesUc))
+	r.Put("/favorites/product/:id", saveProductToFavorites(saveProductToFavoritesUc))
+	r.Patch("/favorites/product/:id", updateFavorite(updateFavoriteUc))
+	r.Put("/favorites/order", reorderFavorites(reorderFavoritesUc))
 	r.Delete("/favorites/product/:id", removeProductFromFavorites(removeProductFromFavoritesUc))
+	r.Get("/favorites/trash", getFavoritesTrash(getFavoritesTrashUc))
+	r.Post("/favorites/trash/:productId/restore", restoreFavorite(restoreFavoriteUc))
+	r.Get("/favorites/history", getFavoritesHistory(getFavoritesHistoryUc))
+	r.Get("/favorites/changes", getFavoritesChanges(getFavoritesChangesUc))
+	r.Get("/favorites/export", exportMyFavorites(exportClientFavoritesUc))
+	r.Post("/favorites/import", importFavorites(importFavoritesUc))
+	r.Get("/favorites/import/:jobId", getImportJob(getImportJobUc))
+	r.Post("/favorites/share", createFavoritesShare(createFavoritesShareUc))
+	r.Get("/favorites/shares", getFavoritesShares(getFavoritesSharesUc))
+	r.Delete("/favorites/shares/:token", revokeFavoritesShare(revokeFavoritesShareUc))
+	r.Get("/recommendations", getRecommendations(getRecommendationsUc))
 }
 
 // @Summary      Get client favorites
-// @Description  Retrieve paginated list of favorite products for the authenticated client
+// @Description  Retrieve paginated list of favorite products for the authenticated client, by page or by cursor
 // @Tags         Me/Favorites
 // @Accept       json
 // @Produce      json
-// @Param        page      query     int  false  "Page number, starts from 0"
-// @Param        pageSize  query     int  false  "Items per page, default 10"
+// @Param        page      query     int     false  "Page number, starts from 0"
+// @Param        pageSize  query     int     false  "Items per page, default 10"
+// @Param        tag       query     string  false  "Only favorites with the given tag"
+// @Param        mode      query     string  false  "Pagination mode, page (default) or cursor"  Enums(page, cursor)
+// @Param        cursor    query     string  false  "Cursor returned as nextCursor or prevCursor, implies the cursor mode"
+// @Param        sort      query     string  false  "Sort field, default by product id"  Enums(registeredAt, price, title, rating, manual)
+// @Param        order     query     string  false  "Sort order, default asc"  Enums(asc, desc)
+// @Param        category  query     string  false  "Only products of the given category"
+// @Param        minPrice  query     number  false  "Only products with price greater or equal"
+// @Param        maxPrice  query     number  false  "Only products with price lower or equal"
+// @Param        minRating query     number  false  "Only products with rating greater or equal"
+// @Param        priceDropped query  bool    false  "Only products cheaper than when they were favorited"
 // @Success      200       {object}  dto.ClientFavorites
 // @Failure      422       {object}  utils.APIError "Invalid params"
 // @Failure      401       {object}  utils.APIError
@@ -71,6 +121,7 @@ func getClientFavorites(uc favorites.GetClientFavoritesUseCase) fiber.Handler {
 // @Success      200       {object}  dto.ProductFavorite             "Added favorite"
 // @Failure      401       {object}  utils.APIError
 // @Failure      404       {object}  utils.APIError
+// @Failure      409       {object}  utils.APIError "Already a favorite or favorites quota exceeded"
 // @Failure      422       {object}  utils.APIError "Invalid params"
 // @Failure      500       {object}  utils.APIError
 // @Security     BearerAuth
@@ -98,6 +149,204 @@ func addProductToFavorites(uc favorites.AddProductToFavoritesUseCase) fiber.Hand
 	}
 }
 
+// @Summary      Save product to favorites
+// @Description  Add a product to the authenticated client's favorites list when it isn't there yet, repeating the request is safe
+// @Tags         Me/Favorites
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int                  true  "Product ID"
+// @Success      200  {object}  dto.ProductFavorite  "Already a favorite"
+// @Success      201  {object}  dto.ProductFavorite  "Added favorite"
+// @Failure      401  {object}  utils.APIError
+// @Failure      404  {object}  utils.APIError
+// @Failure      409  {object}  utils.APIError "Favorites quota exceeded"
+// @Failure      422  {object}  utils.APIError "Invalid params"
+// @Failure      500  {object}  utils.APIError
+// @Security     BearerAuth
+// @Router       /me/favorites/product/{id} [put]
+func saveProductToFavorites(uc favorites.SaveProductToFavoritesUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		pID, err := strconv.Atoi(c.Params("id"))
+		if err != nil {
+			return utils.WriteError(c, domainerror.Wrap(err, domainerror.InvalidParams, "id do produto inválido", map[string]any{
+				"product_id": c.Params("id"),
+			}))
+		}
+
+		cl, err := context.GetClient(c.UserContext())
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		p, created, err := uc.Execute(c.UserContext(), dto.AddProductToFavoritesParams{
+			ClientID:  cl.ID,
+			ProductID: pID,
+		})
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		if created {
+			return c.Status(http.StatusCreated).JSON(p)
+		}
+
+		return c.Status(http.StatusOK).JSON(p)
+	}
+}
+
+// @Summary      Add products to favorites
+// @Description  Add many products to the authenticated client's favorites list, reporting the result of each product
+// @Tags         Me/Favorites
+// @Accept       json
+// @Produce      json
+// @Param        favorites  body      dto.AddProductsToFavoritesParams  true  "Products to add"
+// @Success      200        {object}  dto.FavoritesBatchResult
+// @Failure      401        {object}  utils.APIError
+// @Failure      409        {object}  utils.APIError "Favorites quota exceeded"
+// @Failure      422        {object}  utils.APIError "Invalid params"
+// @Failure      500        {object}  utils.APIError
+// @Security     BearerAuth
+// @Router       /me/favorites/batch [post]
+func addProductsToFavorites(uc favorites.AddProductsToFavoritesUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var params dto.AddProductsToFavoritesParams
+
+		if err := c.BodyParser(&params); err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		cl, err := context.GetClient(c.UserContext())
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		params.ClientID = cl.ID
+		res, err := uc.Execute(c.UserContext(), params)
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		return c.Status(http.StatusOK).JSON(res)
+	}
+}
+
+// @Summary      Remove products from favorites
+// @Description  Remove many products from the authenticated client's favorites list, reporting the result of each product
+// @Tags         Me/Favorites
+// @Accept       json
+// @Produce      json
+// @Param        favorites  body      dto.RemoveProductsFromFavoritesParams  true  "Products to remove"
+// @Success      200        {object}  dto.FavoritesBatchResult
+// @Failure      401        {object}  utils.APIError
+// @Failure      422        {object}  utils.APIError "Invalid params"
+// @Failure      500        {object}  utils.APIError
+// @Security     BearerAuth
+// @Router       /me/favorites/batch [delete]
+func removeProductsFromFavorites(uc favorites.RemoveProductsFromFavoritesUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var params dto.RemoveProductsFromFavoritesParams
+
+		if err := c.BodyParser(&params); err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		cl, err := context.GetClient(c.UserContext())
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		params.ClientID = cl.ID
+		res, err := uc.Execute(c.UserContext(), params)
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		return c.Status(http.StatusOK).JSON(res)
+	}
+}
+
+// @Summary      Update favorite
+// @Description  Update note and tags of a product on the authenticated client's favorites list
+// @Tags         Me/Favorites
+// @Accept       json
+// @Produce      json
+// @Param        id        path      int                       true  "Product ID"
+// @Param        favorite  body      dto.UpdateFavoriteParams  true  "Note and tags, omitted fields are kept"
+// @Success      200       {object}  dto.Favorite
+// @Failure      401       {object}  utils.APIError
+// @Failure      404       {object}  utils.APIError
+// @Failure      422       {object}  utils.APIError "Invalid params"
+// @Failure      500       {object}  utils.APIError
+// @Security     BearerAuth
+// @Router       /me/favorites/product/{id} [patch]
+func updateFavorite(uc favorites.UpdateFavoriteUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var params dto.UpdateFavoriteParams
+
+		if err := c.BodyParser(&params); err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		pID, err := strconv.Atoi(c.Params("id"))
+		if err != nil {
+			return utils.WriteError(c, domainerror.Wrap(err, domainerror.InvalidParams, "id do produto inválido", map[string]any{
+				"product_id": c.Params("id"),
+			}))
+		}
+
+		cl, err := context.GetClient(c.UserContext())
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		params.ClientID = cl.ID
+		params.ProductID = pID
+
+		f, err := uc.Execute(c.UserContext(), params)
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		return c.Status(http.StatusOK).JSON(f)
+	}
+}
+
+// @Summary      Reorder favorites
+// @Description  Set the manual ordering of the authenticated client's favorites, with the product ids in the wanted order or with moves before/after another favorite. List with sort=manual to get them in this order
+// @Tags         Me/Favorites
+// @Accept       json
+// @Produce      json
+// @Param        order  body      dto.ReorderFavoritesParams  true  "Product ids in the wanted order or moves, only one of them"
+// @Success      204    {object}  nil "Success"
+// @Failure      401    {object}  utils.APIError
+// @Failure      404    {object}  utils.APIError
+// @Failure      422    {object}  utils.APIError "Invalid params"
+// @Failure      500    {object}  utils.APIError
+// @Security     BearerAuth
+// @Router       /me/favorites/order [put]
+func reorderFavorites(uc favorites.ReorderFavoritesUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var params dto.ReorderFavoritesParams
+
+		if err := c.BodyParser(&params); err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		cl, err := context.GetClient(c.UserContext())
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		params.ClientID = cl.ID
+
+		if err := uc.Execute(c.UserContext(), params); err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		return c.SendStatus(http.StatusNoContent)
+	}
+}
+
 // @Summary      Remove product from favorites
 // @Description  Remove a product from the authenticated client's favorites list
 // @Tags         Me/Favorites
@@ -138,23 +387,477 @@ func removeProductFromFavorites(uc favorites.RemoveProductFromFavoritesUseCase)
 	}
 }
 
+// @Summary      Get client favorites trash
+// @Description  Retrieve paginated list of favorites removed by the authenticated client that can still be restored
+// @Tags         Me/Favorites
+// @Accept       json
+// @Produce      json
+// @Param        page      query     int  false  "Page number, starts from 0"
+// @Param        pageSize  query     int  false  "Items per page, default 10"
+// @Success      200       {object}  dto.FavoritesTrash
+// @Failure      401       {object}  utils.APIError
+// @Failure      404       {object}  utils.APIError
+// @Failure      422       {object}  utils.APIError "Invalid params"
+// @Failure      500       {object}  utils.APIError
+// @Security     BearerAuth
+// @Router       /me/favorites/trash [get]
+func getFavoritesTrash(uc favorites.GetFavoritesTrashUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var params dto.GetFavoritesTrashParams
+
+		if err := c.QueryParser(&params); err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		cl, err := context.GetClient(c.UserContext())
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		params.ClientID = cl.ID
+
+		res, err := uc.Execute(c.UserContext(), params)
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		return c.Status(http.StatusOK).JSON(res)
+	}
+}
+
+// @Summary      Get client favorites history
+// @Description  Retrieve the adds, removals and restores of the authenticated client favorites, most recent first
+// @Tags         Me/Favorites
+// @Accept       json
+// @Produce      json
+// @Param        page      query     int  false  "Page number, starts from 0"
+// @Param        pageSize  query     int  false  "Items per page, default 10"
+// @Success      200       {object}  dto.FavoritesHistory
+// @Failure      401       {object}  utils.APIError
+// @Failure      422       {object}  utils.APIError "Invalid params"
+// @Failure      500       {object}  utils.APIError
+// @Security     BearerAuth
+// @Router       /me/favorites/history [get]
+func getFavoritesHistory(uc favorites.GetFavoritesHistoryUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var params dto.GetFavoritesHistoryParams
+
+		if err := c.QueryParser(&params); err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		cl, err := context.GetClient(c.UserContext())
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		params.ClientID = cl.ID
+
+		res, err := uc.Execute(c.UserContext(), params)
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		return c.Status(http.StatusOK).JSON(res)
+	}
+}
+
+// @Summary      Get favorites changes
+// @Description  Retrieve the favorites upserted and removed since the sync token of a previous response, oldest first.
+// @Description  Without token all favorites are returned. When fullResync is set the token is too old, drop the local copy and sync again without token
+// @Tags         Me/Favorites
+// @Accept       json
+// @Produce      json
+// @Param        since  query     string  false  "syncToken of the previous response"
+// @Param        limit  query     int     false  "Max changes, default 100"
+// @Success      200    {object}  dto.FavoritesChanges
+// @Failure      401    {object}  utils.APIError
+// @Failure      422    {object}  utils.APIError "Invalid params"
+// @Failure      500    {object}  utils.APIError
+// @Security     BearerAuth
+// @Router       /me/favorites/changes [get]
+func getFavoritesChanges(uc favorites.GetFavoritesChangesUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var params dto.GetFavoritesChangesParams
+
+		if err := c.QueryParser(&params); err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		cl, err := context.GetClient(c.UserContext())
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		params.ClientID = cl.ID
+
+		res, err := uc.Execute(c.UserContext(), params)
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		return c.Status(http.StatusOK).JSON(res)
+	}
+}
+
+// @Summary      Get recommendations
+// @Description  Recommend products favorited by the clients who favorited the same products as the authenticated client
+// @Tags         Me/Favorites
+// @Accept       json
+// @Produce      json
+// @Param        limit  query     int  false  "Amount of products, default 10"
+// @Success      200    {object}  dto.Recommendations
+// @Failure      401    {object}  utils.APIError
+// @Failure      422    {object}  utils.APIError "Invalid params"
+// @Failure      500    {object}  utils.APIError
+// @Security     BearerAuth
+// @Router       /me/recommendations [get]
+func getRecommendations(uc favorites.GetRecommendationsUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var params dto.GetRecommendationsParams
+
+		if err := c.QueryParser(&params); err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		cl, err := context.GetClient(c.UserContext())
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		params.ClientID = cl.ID
+
+		res, err := uc.Execute(c.UserContext(), params)
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		return c.Status(http.StatusOK).JSON(res)
+	}
+}
+
+// @Summary      Restore favorite
+// @Description  Restore a removed product to the authenticated client's favorites list, keeping its original registration date
+// @Tags         Me/Favorites
+// @Accept       json
+// @Produce      json
+// @Param        productId  path      int  true  "Product ID"
+// @Success      200        {object}  dto.Favorite
+// @Failure      401        {object}  utils.APIError
+// @Failure      404        {object}  utils.APIError
+// @Failure      422        {object}  utils.APIError "Invalid params"
+// @Failure      500        {object}  utils.APIError
+// @Security     BearerAuth
+// @Router       /me/favorites/trash/{productId}/restore [post]
+func restoreFavorite(uc favorites.RestoreFavoriteUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		pID, err := strconv.Atoi(c.Params("productId"))
+		if err != nil {
+			return utils.WriteError(c, domainerror.Wrap(err, domainerror.InvalidParams, "id do produto inválido", map[string]any{
+				"product_id": c.Params("productId"),
+			}))
+		}
+
+		cl, err := context.GetClient(c.UserContext())
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		params := dto.RestoreFavoriteParams{
+			ClientID:  cl.ID,
+			ProductID: pID,
+		}
+
+		f, err := uc.Execute(c.UserContext(), params)
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		return c.Status(http.StatusOK).JSON(f)
+	}
+}
+
+// meResponse is the client data with the usage of its favorites quota
+type meResponse struct {
+	user.User
+	FavoritesQuota dto.FavoritesQuota `json:"favoritesQuota"`
+}
+
 // @Summary      Get current client data
-// @Description  Get current client data
+// @Description  Get current client data and how many favorites the client has against its quota
 // @Tags         Me
 // @Accept       json
 // @Produce      json
-// @Success      200  {object}  nil "Success"
+// @Success      200  {object}  meResponse
 // @Failure      401  {object}  utils.APIError
 // @Failure      404  {object}  utils.APIError
+// @Failure      500  {object}  utils.APIError
 // @Security     BearerAuth
 // @Router       /me [get]
-func getMe() fiber.Handler {
+func getMe(uc favorites.GetFavoritesQuotaUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		cl, err := context.GetClient(c.UserContext())
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		q, err := uc.Execute(c.UserContext(), cl.ID)
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		return c.Status(http.StatusOK).JSON(meResponse{
+			User:           cl,
+			FavoritesQuota: q,
+		})
+	}
+}
+
+// @Summary      Export client favorites
+// @Description  Download all favorites of the authenticated client with the product details, as csv or json
+// @Tags         Me/Favorites
+// @Produce      text/csv
+// @Produce      json
+// @Param        format  query     string  false  "Export format, csv or json, default csv"
+// @Success      200     {array}   dto.ExportedFavorite
+// @Failure      401     {object}  utils.APIError
+// @Failure      422     {object}  utils.APIError "Invalid params"
+// @Failure      500     {object}  utils.APIError
+// @Security     BearerAuth
+// @Router       /me/favorites/export [get]
+func exportMyFavorites(uc favorites.ExportClientFavoritesUseCase) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		cl, err := context.GetClient(c.UserContext())
 		if err != nil {
 			return utils.WriteError(c, err)
 		}
 
-		return c.Status(http.StatusOK).JSON(cl)
+		return streamFavoritesExport(c, uc, dto.ExportFavoritesParams{
+			ClientID: cl.ID,
+			Format:   dto.ExportFormat(c.Query("format", string(dto.ExportCSV))),
+		})
+	}
+}
+
+func streamFavoritesExport(c *fiber.Ctx, uc favorites.ExportClientFavoritesUseCase, params dto.ExportFavoritesParams) error {
+	if err := params.Validate(); err != nil {
+		return utils.WriteError(c, err)
+	}
+
+	ctx := c.UserContext()
+
+	c.Set(fiber.HeaderContentType, params.Format.ContentType())
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf("attachment; filename=favorites.%s", params.Format))
+
+	// The body is written while the favorites are read, so the status and headers are already sent when an error happens
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		if err := uc.Execute(ctx, params, w); err != nil {
+			logger.ErrorF(ctx, "error while streaming favorites export", logger.Fields{
+				"client_id": params.ClientID,
+				"error":     err.Error(),
+			})
+		}
+
+		w.Flush()
+	})
+
+	return nil
+}
+
+// @Summary      Import favorites
+// @Description  Import favorites from a file with the same layout of the export. Small files are imported right away, bigger ones are imported in background and the job must be followed by its id
+// @Tags         Me/Favorites
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        format  query     string  false  "File format, csv or json, default csv"
+// @Param        file    formData  file    true   "Exported favorites file"
+// @Success      200     {object}  dto.ImportJob  "Imported"
+// @Success      202     {object}  dto.ImportJob  "Importing in background"
+// @Failure      401     {object}  utils.APIError
+// @Failure      422     {object}  utils.APIError "Invalid params"
+// @Failure      500     {object}  utils.APIError
+// @Security     BearerAuth
+// @Router       /me/favorites/import [post]
+func importFavorites(uc favorites.ImportFavoritesUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		cl, err := context.GetClient(c.UserContext())
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		file, err := importFile(c)
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+		defer file.Close()
+
+		rows, err := dto.ParseImportRows(dto.ExportFormat(c.Query("format", string(dto.ExportCSV))), file)
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		job, err := uc.Execute(c.UserContext(), dto.ImportFavoritesParams{
+			ClientID: cl.ID,
+			Rows:     rows,
+		})
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		if !job.IsDone() {
+			return c.Status(http.StatusAccepted).JSON(job)
+		}
+
+		return c.Status(http.StatusOK).JSON(job)
+	}
+}
+
+// importFile returns the uploaded file, when the request isn't a form the body is the file
+func importFile(c *fiber.Ctx) (io.ReadCloser, error) {
+	fh, err := c.FormFile("file")
+	if err != nil {
+		return io.NopCloser(bytes.NewReader(c.Body())), nil
+	}
+
+	f, err := fh.Open()
+	if err != nil {
+		return nil, domainerror.Wrap(err, domainerror.InvalidParams, "arquivo inválido", map[string]any{
+			"error": err.Error(),
+		})
+	}
+
+	return f, nil
+}
+
+// @Summary      Get favorites import
+// @Description  Return the status of a favorites import of the authenticated client and the report of each row when it is done
+// @Tags         Me/Favorites
+// @Accept       json
+// @Produce      json
+// @Param        jobId  path      string  true  "Import job ID (UUID)"
+// @Success      200    {object}  dto.ImportJob
+// @Failure      401    {object}  utils.APIError
+// @Failure      404    {object}  utils.APIError
+// @Failure      422    {object}  utils.APIError "Invalid params"
+// @Failure      500    {object}  utils.APIError
+// @Security     BearerAuth
+// @Router       /me/favorites/import/{jobId} [get]
+func getImportJob(uc favorites.GetImportJobUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		jID, err := uuid.Parse(c.Params("jobId"))
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		cl, err := context.GetClient(c.UserContext())
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		job, err := uc.Execute(c.UserContext(), dto.GetImportJobParams{
+			ClientID: cl.ID,
+			JobID:    jID,
+		})
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		return c.Status(http.StatusOK).JSON(job)
+	}
+}
+
+// @Summary      Share favorites
+// @Description  Create a public link to the favorites of the authenticated client, it can be revoked at any time and optionally expires
+// @Tags         Me/Favorites
+// @Accept       json
+// @Produce      json
+// @Param        share  body      dto.CreateFavoritesShareParams  false  "Share data"
+// @Success      201    {object}  dto.FavoritesShare
+// @Failure      401    {object}  utils.APIError
+// @Failure      422    {object}  utils.APIError "Invalid params"
+// @Failure      500    {object}  utils.APIError
+// @Security     BearerAuth
+// @Router       /me/favorites/share [post]
+func createFavoritesShare(uc favorites.CreateFavoritesShareUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var params dto.CreateFavoritesShareParams
+
+		if len(c.Body()) > 0 {
+			if err := c.BodyParser(&params); err != nil {
+				return utils.WriteError(c, err)
+			}
+		}
+
+		cl, err := context.GetClient(c.UserContext())
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		params.ClientID = cl.ID
+
+		s, err := uc.Execute(c.UserContext(), params)
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		return c.Status(http.StatusCreated).JSON(s)
+	}
+}
+
+// @Summary      Get favorites shares
+// @Description  Retrieve the share links of the authenticated client that weren't revoked
+// @Tags         Me/Favorites
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  dto.ClientFavoritesShares
+// @Failure      401  {object}  utils.APIError
+// @Failure      500  {object}  utils.APIError
+// @Security     BearerAuth
+// @Router       /me/favorites/shares [get]
+func getFavoritesShares(uc favorites.GetFavoritesSharesUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		cl, err := context.GetClient(c.UserContext())
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		ss, err := uc.Execute(c.UserContext(), cl.ID)
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		return c.Status(http.StatusOK).JSON(ss)
+	}
+}
+
+// @Summary      Revoke favorites share
+// @Description  Revoke a share link of the authenticated client, the link stops working right away
+// @Tags         Me/Favorites
+// @Accept       json
+// @Produce      json
+// @Param        token  path      string  true  "Share token"
+// @Success      204    {object}  nil     "No Content"
+// @Failure      401    {object}  utils.APIError
+// @Failure      404    {object}  utils.APIError
+// @Failure      500    {object}  utils.APIError
+// @Security     BearerAuth
+// @Router       /me/favorites/shares/{token} [delete]
+func revokeFavoritesShare(uc favorites.RevokeFavoritesShareUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		cl, err := context.GetClient(c.UserContext())
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		params := dto.RevokeFavoritesShareParams{
+			ClientID: cl.ID,
+			Token:    c.Params("token"),
+		}
+
+		if err := uc.Execute(c.UserContext(), params); err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		return c.SendStatus(http.StatusNoContent)
 	}
 }
@@ -0,0 +1,312 @@
+package routes
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/context"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/internal/http/utils"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/domainerror"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
+)
+
+func MeLists(r fiber.Router,
+	createFavoriteListUc favorites.CreateFavoriteListUseCase,
+	getClientFavoriteListsUc favorites.GetClientFavoriteListsUseCase,
+	getFavoriteListUc favorites.GetFavoriteListUseCase,
+	renameFavoriteListUc favorites.RenameFavoriteListUseCase,
+	deleteFavoriteListUc favorites.DeleteFavoriteListUseCase,
+	addProductToListUc favorites.AddProductToListUseCase,
+	removeProductFromListUc favorites.RemoveProductFromListUseCase,
+) {
+	r.Get("/", getClientFavoriteLists(getClientFavoriteListsUc))
+	r.Post("/", createFavoriteList(createFavoriteListUc))
+	r.Get("/:listId", getFavoriteList(getFavoriteListUc))
+	r.Patch("/:listId", renameFavoriteList(renameFavoriteListUc))
+	r.Delete("/:listId", deleteFavoriteList(deleteFavoriteListUc))
+	r.Post("/:listId/products", addProductToList(addProductToListUc))
+	r.Delete("/:listId/products/:productId", removeProductFromList(removeProductFromListUc))
+}
+
+// @Summary      Get client favorite lists
+// @Description  Retrieve all named favorite lists of the authenticated client
+// @Tags         Me/Lists
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  dto.ClientFavoriteLists
+// @Failure      401  {object}  utils.APIError
+// @Failure      500  {object}  utils.APIError
+// @Security     BearerAuth
+// @Router       /me/lists [get]
+func getClientFavoriteLists(uc favorites.GetClientFavoriteListsUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		cl, err := context.GetClient(c.UserContext())
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		ll, err := uc.Execute(c.UserContext(), cl.ID)
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		return c.Status(http.StatusOK).JSON(ll)
+	}
+}
+
+// @Summary      Create favorite list
+// @Description  Create a new named favorite list for the authenticated client
+// @Tags         Me/Lists
+// @Accept       json
+// @Produce      json
+// @Param        list  body      dto.CreateFavoriteListParams  true  "List data"
+// @Success      201   {object}  dto.FavoriteList
+// @Failure      401   {object}  utils.APIError
+// @Failure      422   {object}  utils.APIError "Invalid params"
+// @Failure      500   {object}  utils.APIError
+// @Security     BearerAuth
+// @Router       /me/lists [post]
+func createFavoriteList(uc favorites.CreateFavoriteListUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var params dto.CreateFavoriteListParams
+
+		if err := c.BodyParser(&params); err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		cl, err := context.GetClient(c.UserContext())
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		params.ClientID = cl.ID
+		l, err := uc.Execute(c.UserContext(), params)
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		return c.Status(http.StatusCreated).JSON(l)
+	}
+}
+
+// @Summary      Get favorite list
+// @Description  Retrieve a favorite list with its paginated products
+// @Tags         Me/Lists
+// @Accept       json
+// @Produce      json
+// @Param        listId    path      string  true   "List ID (UUID)"
+// @Param        page      query     int     false  "Page number, starts from 0"
+// @Param        pageSize  query     int     false  "Items per page, default 10"
+// @Success      200       {object}  dto.FavoriteListProducts
+// @Failure      401       {object}  utils.APIError
+// @Failure      404       {object}  utils.APIError
+// @Failure      422       {object}  utils.APIError "Invalid params"
+// @Failure      500       {object}  utils.APIError
+// @Security     BearerAuth
+// @Router       /me/lists/{listId} [get]
+func getFavoriteList(uc favorites.GetFavoriteListUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var params dto.GetFavoriteListParams
+
+		if err := c.QueryParser(&params); err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		lID, err := uuid.Parse(c.Params("listId"))
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		cl, err := context.GetClient(c.UserContext())
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		params.ClientID = cl.ID
+		params.ListID = lID
+
+		l, err := uc.Execute(c.UserContext(), params)
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		return c.Status(http.StatusOK).JSON(l)
+	}
+}
+
+// @Summary      Rename favorite list
+// @Description  Rename a favorite list of the authenticated client
+// @Tags         Me/Lists
+// @Accept       json
+// @Produce      json
+// @Param        listId  path      string                        true  "List ID (UUID)"
+// @Param        list    body      dto.RenameFavoriteListParams  true  "New list name"
+// @Success      200     {object}  dto.FavoriteList
+// @Failure      401     {object}  utils.APIError
+// @Failure      404     {object}  utils.APIError
+// @Failure      422     {object}  utils.APIError "Invalid params"
+// @Failure      500     {object}  utils.APIError
+// @Security     BearerAuth
+// @Router       /me/lists/{listId} [patch]
+func renameFavoriteList(uc favorites.RenameFavoriteListUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var params dto.RenameFavoriteListParams
+
+		if err := c.BodyParser(&params); err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		lID, err := uuid.Parse(c.Params("listId"))
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		cl, err := context.GetClient(c.UserContext())
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		params.ClientID = cl.ID
+		params.ListID = lID
+
+		l, err := uc.Execute(c.UserContext(), params)
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		return c.Status(http.StatusOK).JSON(l)
+	}
+}
+
+// @Summary      Delete favorite list
+// @Description  Delete a favorite list and all of its products
+// @Tags         Me/Lists
+// @Accept       json
+// @Produce      json
+// @Param        listId  path      string  true  "List ID (UUID)"
+// @Success      200     {object}  nil "Success"
+// @Failure      401     {object}  utils.APIError
+// @Failure      404     {object}  utils.APIError
+// @Failure      422     {object}  utils.APIError "Invalid params"
+// @Failure      500     {object}  utils.APIError
+// @Security     BearerAuth
+// @Router       /me/lists/{listId} [delete]
+func deleteFavoriteList(uc favorites.DeleteFavoriteListUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		lID, err := uuid.Parse(c.Params("listId"))
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		cl, err := context.GetClient(c.UserContext())
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		params := dto.DeleteFavoriteListParams{
+			ClientID: cl.ID,
+			ListID:   lID,
+		}
+
+		if err := uc.Execute(c.UserContext(), params); err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		return c.SendStatus(http.StatusOK)
+	}
+}
+
+// @Summary      Add product to favorite list
+// @Description  Add a product to a favorite list of the authenticated client
+// @Tags         Me/Lists
+// @Accept       json
+// @Produce      json
+// @Param        listId   path      string                      true  "List ID (UUID)"
+// @Param        product  body      dto.AddProductToListParams  true  "Product to add"
+// @Success      200      {object}  dto.ListProduct             "Added product"
+// @Failure      401      {object}  utils.APIError
+// @Failure      404      {object}  utils.APIError
+// @Failure      409      {object}  utils.APIError
+// @Failure      422      {object}  utils.APIError "Invalid params"
+// @Failure      500      {object}  utils.APIError
+// @Security     BearerAuth
+// @Router       /me/lists/{listId}/products [post]
+func addProductToList(uc favorites.AddProductToListUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var params dto.AddProductToListParams
+
+		if err := c.BodyParser(&params); err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		lID, err := uuid.Parse(c.Params("listId"))
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		cl, err := context.GetClient(c.UserContext())
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		params.ClientID = cl.ID
+		params.ListID = lID
+
+		p, err := uc.Execute(c.UserContext(), params)
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		return c.Status(http.StatusOK).JSON(p)
+	}
+}
+
+// @Summary      Remove product from favorite list
+// @Description  Remove a product from a favorite list of the authenticated client
+// @Tags         Me/Lists
+// @Accept       json
+// @Produce      json
+// @Param        listId     path      string  true  "List ID (UUID)"
+// @Param        productId  path      int     true  "Product ID"
+// @Success      200        {object}  nil "Success"
+// @Failure      401        {object}  utils.APIError
+// @Failure      404        {object}  utils.APIError
+// @Failure      422        {object}  utils.APIError "Invalid params"
+// @Failure      500        {object}  utils.APIError
+// @Security     BearerAuth
+// @Router       /me/lists/{listId}/products/{productId} [delete]
+func removeProductFromList(uc favorites.RemoveProductFromListUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		lID, err := uuid.Parse(c.Params("listId"))
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		pID, err := strconv.Atoi(c.Params("productId"))
+		if err != nil {
+			return utils.WriteError(c, domainerror.Wrap(err, domainerror.InvalidParams, "id do produto inválido", map[string]any{
+				"product_id": c.Params("productId"),
+			}))
+		}
+
+		cl, err := context.GetClient(c.UserContext())
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		params := dto.RemoveProductFromListParams{
+			ClientID:  cl.ID,
+			ListID:    lID,
+			ProductID: pID,
+		}
+
+		if err := uc.Execute(c.UserContext(), params); err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		return c.SendStatus(http.StatusOK)
+	}
+}
@@ -0,0 +1,41 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/auth"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/products"
+	"github.com/uesleicarvalhoo/aiqfome/internal/http/middleware"
+	"github.com/uesleicarvalhoo/aiqfome/internal/http/utils"
+	"github.com/uesleicarvalhoo/aiqfome/role"
+)
+
+func Products(r fiber.Router,
+	authorizeUc auth.AuthorizeUseCase,
+	getProductsSyncStatusUc products.GetProductsSyncStatusUseCase,
+) {
+	r.Get("/sync", middleware.Authorize(authorizeUc, role.ResourceProducts, role.ActionRead), getProductsSyncStatus(getProductsSyncStatusUc))
+}
+
+// @Summary      Get products sync status
+// @Description  Return where the products are read from and the last sync of the local catalog mirror
+// @Tags         Products
+// @Accept       json
+// @Produce      json
+// @Success      200  {object}  dto.ProductsSyncStatus
+// @Failure      401  {object}  utils.APIError
+// @Failure      403  {object}  utils.APIError
+// @Failure      500  {object}  utils.APIError
+// @Security     BearerAuth
+// @Router       /products/sync [get]
+func getProductsSyncStatus(uc products.GetProductsSyncStatusUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		st, err := uc.Execute(c.UserContext())
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		return c.Status(http.StatusOK).JSON(st)
+	}
+}
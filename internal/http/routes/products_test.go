@@ -0,0 +1,95 @@
+package routes
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/products/dto"
+	productsMocks "github.com/uesleicarvalhoo/aiqfome/internal/app/products/mocks"
+	"github.com/uesleicarvalhoo/aiqfome/internal/http/utils"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/domainerror"
+)
+
+func Test_getProductsSyncStatus(t *testing.T) {
+	t.Parallel()
+
+	startedAt := time.Date(2026, 10, 18, 10, 0, 0, 0, time.UTC)
+	status := dto.ProductsSyncStatus{
+		Source: "mirror",
+		LastSync: &dto.ProductsSync{
+			StartedAt:  startedAt,
+			FinishedAt: startedAt.Add(time.Second),
+			Upserted:   20,
+		},
+	}
+
+	testCases := []struct {
+		about           string
+		setupUC         func(uc *productsMocks.GetProductsSyncStatusUseCase)
+		expectedStatus  int
+		expectedBody    *dto.ProductsSyncStatus
+		expectedErrCode string
+	}{
+		{
+			about: "when ok",
+			setupUC: func(uc *productsMocks.GetProductsSyncStatusUseCase) {
+				uc.On("Execute", mock.Anything).Return(status, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   &status,
+		},
+		{
+			about: "when usecase returns dependency error",
+			setupUC: func(uc *productsMocks.GetProductsSyncStatusUseCase) {
+				err := domainerror.Wrap(errors.New("db error"), domainerror.DependecyError, "erro ao buscar sincronização de produtos", nil)
+				uc.On("Execute", mock.Anything).Return(dto.ProductsSyncStatus{}, err)
+			},
+			expectedStatus:  http.StatusInternalServerError,
+			expectedErrCode: string(domainerror.DependecyError),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			uc := productsMocks.NewGetProductsSyncStatusUseCase(t)
+			if tc.setupUC != nil {
+				tc.setupUC(uc)
+			}
+
+			app := fiber.New()
+			app.Get("/sync", getProductsSyncStatus(uc))
+
+			// Action
+			req := httptest.NewRequest(http.MethodGet, "/sync", nil)
+			resp, err := app.Test(req)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, resp.StatusCode)
+
+			if tc.expectedBody != nil {
+				var got dto.ProductsSyncStatus
+				assert.NoError(t, json.NewDecoder(resp.Body).Decode(&got))
+				assert.Equal(t, *tc.expectedBody, got)
+			}
+			if tc.expectedErrCode != "" {
+				var apiErr utils.APIError
+				assert.NoError(t, json.NewDecoder(resp.Body).Decode(&apiErr))
+				assert.Equal(t, tc.expectedErrCode, apiErr.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	"github.com/uesleicarvalhoo/aiqfome/internal/http/utils"
+)
+
+// Shared routes are public, the share token is the only credential
+func Shared(r fiber.Router, getSharedFavoritesUc favorites.GetSharedFavoritesUseCase) {
+	r.Get("/:token", getSharedFavorites(getSharedFavoritesUc))
+}
+
+// @Summary      Get shared favorites
+// @Description  Retrieve the paginated favorite products behind a share link, no account is needed
+// @Tags         Shared
+// @Accept       json
+// @Produce      json
+// @Param        token     path      string  true   "Share token"
+// @Param        page      query     int     false  "Page number, starts from 0"
+// @Param        pageSize  query     int     false  "Items per page, default 10"
+// @Success      200       {object}  dto.SharedFavorites
+// @Failure      404       {object}  utils.APIError
+// @Failure      422       {object}  utils.APIError "Invalid params"
+// @Failure      500       {object}  utils.APIError
+// @Router       /shared/{token} [get]
+func getSharedFavorites(uc favorites.GetSharedFavoritesUseCase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		var params dto.GetSharedFavoritesParams
+
+		if err := c.QueryParser(&params); err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		params.Token = c.Params("token")
+
+		res, err := uc.Execute(c.UserContext(), params)
+		if err != nil {
+			return utils.WriteError(c, err)
+		}
+
+		return c.Status(http.StatusOK).JSON(res)
+	}
+}
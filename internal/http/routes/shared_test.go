@@ -0,0 +1,93 @@
+package routes
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/dto"
+	favoritesMocks "github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/mocks"
+	"github.com/uesleicarvalhoo/aiqfome/internal/http/utils"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/domainerror"
+	"github.com/uesleicarvalhoo/aiqfome/product"
+)
+
+func Test_getSharedFavorites(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		about           string
+		path            string
+		setupUC         func(uc *favoritesMocks.GetSharedFavoritesUseCase)
+		expectedStatus  int
+		expectedBody    *dto.SharedFavorites
+		expectedErrCode string
+	}{
+		{
+			about: "when ok",
+			path:  "/abc?page=1&pageSize=5",
+			setupUC: func(uc *favoritesMocks.GetSharedFavoritesUseCase) {
+				uc.
+					On("Execute", mock.Anything, dto.GetSharedFavoritesParams{Token: "abc", Page: 1, PageSize: 5}).
+					Return(dto.SharedFavorites{Products: []dto.SharedFavorite{{Product: product.Product{ID: 1}}}, Total: 6, Pages: 2}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   &dto.SharedFavorites{Products: []dto.SharedFavorite{{Product: product.Product{ID: 1}}}, Total: 6, Pages: 2},
+		},
+		{
+			about: "when share is not found",
+			path:  "/abc",
+			setupUC: func(uc *favoritesMocks.GetSharedFavoritesUseCase) {
+				err := domainerror.New(domainerror.ResourceNotFound, "link de compartilhamento não encontrado", nil)
+				uc.
+					On("Execute", mock.Anything, dto.GetSharedFavoritesParams{Token: "abc"}).
+					Return(dto.SharedFavorites{}, err)
+			},
+			expectedStatus:  http.StatusNotFound,
+			expectedErrCode: string(domainerror.ResourceNotFound),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			uc := favoritesMocks.NewGetSharedFavoritesUseCase(t)
+			if tc.setupUC != nil {
+				tc.setupUC(uc)
+			}
+
+			app := fiber.New()
+			app.Get("/:token", getSharedFavorites(uc))
+
+			// Action
+			req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+
+			resp, err := app.Test(req)
+			require.NoError(t, err)
+
+			// Assert
+			assert.Equal(t, tc.expectedStatus, resp.StatusCode)
+
+			if tc.expectedErrCode != "" {
+				var apiErr utils.APIError
+				assert.NoError(t, json.NewDecoder(resp.Body).Decode(&apiErr))
+				assert.Equal(t, tc.expectedErrCode, apiErr.Code)
+			} else {
+				var body dto.SharedFavorites
+				assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+				assert.Equal(t, *tc.expectedBody, body)
+			}
+
+			uc.AssertExpectations(t)
+		})
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/uesleicarvalhoo/aiqfome/internal/app/auth"
 	"github.com/uesleicarvalhoo/aiqfome/internal/app/client"
 	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/products"
 	"github.com/uesleicarvalhoo/aiqfome/internal/http/middleware"
 	"github.com/uesleicarvalhoo/aiqfome/internal/http/routes"
 	"github.com/uesleicarvalhoo/aiqfome/pkg/logger"
@@ -36,10 +37,39 @@ func StartHttpServer(opts Options,
 	getClientFavoritesUc favorites.GetClientFavoritesUseCase,
 	addProductToFavoritesUc favorites.AddProductToFavoritesUseCase,
 	removeProductFromFavoritesUc favorites.RemoveProductFromFavoritesUseCase,
+	updateFavoriteUc favorites.UpdateFavoriteUseCase,
+	addProductsToFavoritesUc favorites.AddProductsToFavoritesUseCase,
+	removeProductsFromFavoritesUc favorites.RemoveProductsFromFavoritesUseCase,
+	getFavoritesTrashUc favorites.GetFavoritesTrashUseCase,
+	restoreFavoriteUc favorites.RestoreFavoriteUseCase,
+	getFavoritesStatsUc favorites.GetFavoritesStatsUseCase,
+	exportClientFavoritesUc favorites.ExportClientFavoritesUseCase,
+	importFavoritesUc favorites.ImportFavoritesUseCase,
+	getImportJobUc favorites.GetImportJobUseCase,
+	createFavoritesShareUc favorites.CreateFavoritesShareUseCase,
+	getFavoritesSharesUc favorites.GetFavoritesSharesUseCase,
+	revokeFavoritesShareUc favorites.RevokeFavoritesShareUseCase,
+	getSharedFavoritesUc favorites.GetSharedFavoritesUseCase,
+	getFavoritesQuotaUc favorites.GetFavoritesQuotaUseCase,
+	setFavoritesQuotaUc favorites.SetFavoritesQuotaUseCase,
+	removeFavoritesQuotaUc favorites.RemoveFavoritesQuotaUseCase,
+	getFavoritesHistoryUc favorites.GetFavoritesHistoryUseCase,
+	getRecommendationsUc favorites.GetRecommendationsUseCase,
+	getFavoritesChangesUc favorites.GetFavoritesChangesUseCase,
+	reorderFavoritesUc favorites.ReorderFavoritesUseCase,
+	saveProductToFavoritesUc favorites.SaveProductToFavoritesUseCase,
+	createFavoriteListUc favorites.CreateFavoriteListUseCase,
+	getClientFavoriteListsUc favorites.GetClientFavoriteListsUseCase,
+	getFavoriteListUc favorites.GetFavoriteListUseCase,
+	renameFavoriteListUc favorites.RenameFavoriteListUseCase,
+	deleteFavoriteListUc favorites.DeleteFavoriteListUseCase,
+	addProductToListUc favorites.AddProductToListUseCase,
+	removeProductFromListUc favorites.RemoveProductFromListUseCase,
 	findClientUc client.FindClientUseCase,
 	listClientsUc client.ListClientsUseCase,
 	updateClientUc client.UpdateClientUseCase,
 	deleteClientUc client.DeleteClientUseCase,
+	getProductsSyncStatusUc products.GetProductsSyncStatusUseCase,
 ) error {
 	app := fiber.New(fiber.Config{
 		AppName:               opts.ServiceName,
@@ -57,12 +87,42 @@ func StartHttpServer(opts Options,
 
 	routes.Swagger(app)
 	routes.Auth(app.Group("/auth"), signInUc, signUpUc, refreshTokenUc)
+	routes.Shared(app.Group("/shared"), getSharedFavoritesUc)
 
 	protected := app.Group("/", middleware.Authentication(authenticateUc))
 
 	routes.Me(
 		protected.Group("/me"),
-		getClientFavoritesUc, addProductToFavoritesUc, removeProductFromFavoritesUc,
+		getClientFavoritesUc, addProductToFavoritesUc, removeProductFromFavoritesUc, updateFavoriteUc,
+		addProductsToFavoritesUc, removeProductsFromFavoritesUc,
+		getFavoritesTrashUc, restoreFavoriteUc, exportClientFavoritesUc,
+		importFavoritesUc, getImportJobUc,
+		createFavoritesShareUc, getFavoritesSharesUc, revokeFavoritesShareUc,
+		getFavoritesQuotaUc, getFavoritesHistoryUc, getRecommendationsUc, getFavoritesChangesUc,
+		reorderFavoritesUc, saveProductToFavoritesUc,
+	)
+
+	routes.MeLists(
+		protected.Group("/me/lists"),
+		createFavoriteListUc,
+		getClientFavoriteListsUc,
+		getFavoriteListUc,
+		renameFavoriteListUc,
+		deleteFavoriteListUc,
+		addProductToListUc,
+		removeProductFromListUc,
+	)
+
+	routes.Favorites(
+		protected.Group("/favorites"),
+		authorizeUc,
+		getFavoritesStatsUc,
+	)
+
+	routes.Products(
+		protected.Group("/products"),
+		authorizeUc,
+		getProductsSyncStatusUc,
 	)
 
 	routes.Clients(
@@ -72,6 +132,14 @@ func StartHttpServer(opts Options,
 		listClientsUc,
 		updateClientUc,
 		deleteClientUc,
+		exportClientFavoritesUc,
+		getFavoritesQuotaUc,
+		setFavoritesQuotaUc,
+		removeFavoritesQuotaUc,
+		getFavoritesHistoryUc,
+		getClientFavoritesUc,
+		addProductToFavoritesUc,
+		removeProductFromFavoritesUc,
 	)
 
 	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
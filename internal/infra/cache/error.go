@@ -1,5 +1,5 @@
 package cache
 
-import "errors"
+import "github.com/uesleicarvalhoo/aiqfome/pkg/cache"
 
-var ErrNotFound = errors.New("data not found")
+var ErrNotFound = cache.ErrNotFound
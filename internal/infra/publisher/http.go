@@ -0,0 +1,55 @@
+package publisher
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/uesleicarvalhoo/aiqfome/event"
+)
+
+// HTTP posts each event as json to the url, any status other than 2xx is a failure and the event is sent again later
+type HTTP struct {
+	client *http.Client
+	url    string
+}
+
+func NewHTTP(client *http.Client, url string) *HTTP {
+	return &HTTP{
+		client: client,
+		url:    url,
+	}
+}
+
+func (p *HTTP) Publish(ctx context.Context, e event.Event) error {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	rq, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+
+	rq.Header.Set("Content-Type", "application/json")
+	rq.Header.Set("X-Event-ID", e.ID.String())
+	rq.Header.Set("X-Event-Type", string(e.Type))
+
+	res, err := p.client.Do(rq)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	_, _ = io.Copy(io.Discard, res.Body)
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("event %s rejected with status %d", e.ID, res.StatusCode)
+	}
+
+	return nil
+}
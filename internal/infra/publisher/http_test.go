@@ -0,0 +1,81 @@
+package publisher_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uesleicarvalhoo/aiqfome/event/fixture"
+	"github.com/uesleicarvalhoo/aiqfome/internal/infra/publisher"
+)
+
+func TestHTTP_Publish(t *testing.T) {
+	t.Parallel()
+
+	e := fixture.AnyEvent().Build()
+
+	testCases := []struct {
+		about       string
+		status      int
+		expectedErr string
+	}{
+		{
+			about:  "when the stub accepts the event",
+			status: http.StatusAccepted,
+		},
+		{
+			about:       "when the stub rejects the event",
+			status:      http.StatusServiceUnavailable,
+			expectedErr: fmt.Sprintf("event %s rejected with status 503", e.ID),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			var received *http.Request
+			var body struct {
+				ID          string          `json:"id"`
+				AggregateID string          `json:"aggregateId"`
+				Payload     json.RawMessage `json:"payload"`
+			}
+
+			stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				received = r
+				_ = json.NewDecoder(r.Body).Decode(&body)
+				w.WriteHeader(tc.status)
+			}))
+			defer stub.Close()
+
+			p := publisher.NewHTTP(stub.Client(), stub.URL+"/events")
+
+			// Action
+			err := p.Publish(context.Background(), e)
+
+			// Assert
+			require.NotNil(t, received)
+			assert.Equal(t, http.MethodPost, received.Method)
+			assert.Equal(t, "/events", received.URL.Path)
+			assert.Equal(t, e.ID.String(), received.Header.Get("X-Event-ID"))
+			assert.Equal(t, string(e.Type), received.Header.Get("X-Event-Type"))
+			assert.Equal(t, e.ID.String(), body.ID)
+			assert.Equal(t, e.AggregateID, body.AggregateID)
+			assert.JSONEq(t, `{"productId":1}`, string(body.Payload))
+
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
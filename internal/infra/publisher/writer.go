@@ -0,0 +1,36 @@
+package publisher
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+
+	"github.com/uesleicarvalhoo/aiqfome/event"
+)
+
+// Writer publishes each event as a json line, it is used to log the events on stdout or on a file
+type Writer struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{
+		w: w,
+	}
+}
+
+func (p *Writer) Publish(ctx context.Context, e event.Event) error {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	_, err = p.w.Write(append(b, '\n'))
+
+	return err
+}
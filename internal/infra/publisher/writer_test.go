@@ -0,0 +1,40 @@
+package publisher_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uesleicarvalhoo/aiqfome/event/fixture"
+	"github.com/uesleicarvalhoo/aiqfome/internal/infra/publisher"
+)
+
+func TestWriter_Publish(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	first := fixture.AnyEvent().Build()
+	second := fixture.AnyEvent().WithType("ClientDeleted").Build()
+
+	var buf bytes.Buffer
+	p := publisher.NewWriter(&buf)
+
+	// Action
+	require.NoError(t, p.Publish(context.Background(), first))
+	require.NoError(t, p.Publish(context.Background(), second))
+
+	// Assert
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	require.Len(t, lines, 2)
+
+	var got struct {
+		ID   string `json:"id"`
+		Type string `json:"type"`
+	}
+	require.NoError(t, json.Unmarshal(lines[1], &got))
+	assert.Equal(t, second.ID.String(), got.ID)
+	assert.Equal(t, "ClientDeleted", got.Type)
+}
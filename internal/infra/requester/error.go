@@ -0,0 +1,22 @@
+package requester
+
+import "fmt"
+
+// ErrCircuitOpen is returned without calling the host while its circuit is open
+type ErrCircuitOpen struct {
+	Host string
+}
+
+func (e *ErrCircuitOpen) Error() string {
+	return fmt.Sprintf("circuit open for host %s", e.Host)
+}
+
+// ErrUnexpectedStatus is returned with the body when the host doesn't answer with a 2xx status, after the retries for the retryable ones
+type ErrUnexpectedStatus struct {
+	URL        string
+	StatusCode int
+}
+
+func (e *ErrUnexpectedStatus) Error() string {
+	return fmt.Sprintf("unexpected status %d from %s", e.StatusCode, e.URL)
+}
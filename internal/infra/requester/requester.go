@@ -3,23 +3,49 @@ package requester
 import (
 	"context"
 	"io"
+	"math/rand/v2"
 	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/uesleicarvalhoo/aiqfome/pkg/logger"
 )
 
 type Requester interface {
 	Get(ctx context.Context, url string, params map[string]string) ([]byte, int, error)
 }
 
+type Options struct {
+	// MaxRetries is how many times an idempotent request is sent again after failing, 0 disables the retries
+	MaxRetries int
+	// BaseBackoff is the wait before the first retry, it doubles on each retry and a random jitter is applied
+	BaseBackoff time.Duration
+	// MaxBackoff caps the wait between the attempts, a longer Retry-After stops the retries
+	MaxBackoff time.Duration
+	// FailureThreshold is how many consecutive failures open the circuit of a host, 0 disables the breaker
+	FailureThreshold int
+	// OpenDuration is how long the circuit stays open before a request is let through again
+	OpenDuration time.Duration
+}
+
 type requester struct {
 	client *http.Client
+	opts   Options
+
+	mu       sync.Mutex
+	breakers map[string]*breaker
 }
 
-func New(client *http.Client) Requester {
+func New(client *http.Client, opts Options) Requester {
 	return &requester{
-		client: client,
+		client:   client,
+		opts:     opts,
+		breakers: make(map[string]*breaker),
 	}
 }
 
+// Get is idempotent, so the failed attempts are retried
 func (r *requester) Get(ctx context.Context, url string, params map[string]string) ([]byte, int, error) {
 	rq, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
@@ -35,16 +61,139 @@ func (r *requester) Get(ctx context.Context, url string, params map[string]strin
 		rq.URL.RawQuery = q.Encode()
 	}
 
+	return r.do(ctx, rq)
+}
+
+func (r *requester) do(ctx context.Context, rq *http.Request) ([]byte, int, error) {
+	cb := r.breaker(rq.URL.Host)
+
+	for attempt := 0; ; attempt++ {
+		if cb != nil && !cb.allow(time.Now()) {
+			return nil, 0, &ErrCircuitOpen{Host: rq.URL.Host}
+		}
+
+		b, status, retryAfter, err := r.send(rq)
+
+		if ctx.Err() != nil {
+			if cb != nil {
+				cb.release()
+			}
+
+			return nil, 0, ctx.Err()
+		}
+
+		// A host answering with a client error is up, only the retryable failures open its circuit
+		failed := err != nil || retryable(status)
+		if cb != nil {
+			if failed {
+				cb.failure(time.Now())
+			} else {
+				cb.success()
+			}
+		}
+
+		if err == nil && (status < 200 || status >= 300) {
+			err = &ErrUnexpectedStatus{URL: rq.URL.String(), StatusCode: status}
+		}
+
+		if !failed || attempt >= r.opts.MaxRetries {
+			return b, status, err
+		}
+
+		wait, ok := r.backoff(attempt, retryAfter)
+		if !ok {
+			logger.WarnF(ctx, "retry after is longer than the max backoff, giving up", logger.Fields{
+				"url":        rq.URL.String(),
+				"retryAfter": retryAfter.String(),
+				"error":      err.Error(),
+			})
+
+			return b, status, err
+		}
+
+		logger.WarnF(ctx, "request failed, retrying", logger.Fields{
+			"url":     rq.URL.String(),
+			"attempt": attempt + 1,
+			"wait":    wait.String(),
+			"error":   err.Error(),
+		})
+
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return nil, 0, ctx.Err()
+		}
+	}
+}
+
+func (r *requester) send(rq *http.Request) ([]byte, int, time.Duration, error) {
 	res, err := r.client.Do(rq)
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, 0, err
 	}
 	defer res.Body.Close()
 
 	b, err := io.ReadAll(res.Body)
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, 0, err
+	}
+
+	return b, res.StatusCode, parseRetryAfter(res.Header.Get("Retry-After")), nil
+}
+
+func (r *requester) breaker(host string) *breaker {
+	if r.opts.FailureThreshold <= 0 {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cb, ok := r.breakers[host]
+	if !ok {
+		cb = &breaker{threshold: r.opts.FailureThreshold, openDuration: r.opts.OpenDuration}
+		r.breakers[host] = cb
+	}
+
+	return cb
+}
+
+// backoff is the Retry-After asked by the host or an exponential wait with full jitter,
+// it is false when the host asks to wait longer than MaxBackoff, as retrying earlier would ignore it
+func (r *requester) backoff(attempt int, retryAfter time.Duration) (time.Duration, bool) {
+	if retryAfter > 0 {
+		return retryAfter, r.opts.MaxBackoff <= 0 || retryAfter <= r.opts.MaxBackoff
+	}
+
+	if r.opts.BaseBackoff <= 0 {
+		return 0, true
+	}
+
+	exp := r.opts.BaseBackoff << min(attempt, 30)
+	if r.opts.MaxBackoff > 0 && (exp <= 0 || exp > r.opts.MaxBackoff) {
+		exp = r.opts.MaxBackoff
+	}
+
+	return time.Duration(rand.Int64N(int64(exp)) + 1), true
+}
+
+func retryable(status int) bool {
+	return status == http.StatusTooManyRequests || status >= http.StatusInternalServerError
+}
+
+// parseRetryAfter reads the header both as seconds and as a http date
+func parseRetryAfter(v string) time.Duration {
+	if v == "" {
+		return 0
+	}
+
+	if s, err := strconv.Atoi(v); err == nil {
+		return time.Duration(s) * time.Second
+	}
+
+	if at, err := http.ParseTime(v); err == nil {
+		return time.Until(at)
 	}
 
-	return b, res.StatusCode, nil
+	return 0
 }
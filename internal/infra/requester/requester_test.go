@@ -0,0 +1,225 @@
+package requester_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uesleicarvalhoo/aiqfome/internal/infra/requester"
+)
+
+// stub answers each request with the next status of the list, repeating the last one
+func stub(t *testing.T, statuses []int, header http.Header) (*httptest.Server, *atomic.Int64) {
+	var calls atomic.Int64
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(calls.Add(1))
+		status := statuses[min(n, len(statuses))-1]
+
+		for k, v := range header {
+			w.Header()[k] = v
+		}
+
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(r.URL.RawQuery))
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv, &calls
+}
+
+func TestRequester_Get(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		about          string
+		statuses       []int
+		opts           requester.Options
+		expectedErr    string
+		expectedStatus int
+		expectedBody   string
+		expectedCalls  int64
+	}{
+		{
+			about:          "when the request succeeds",
+			statuses:       []int{http.StatusOK},
+			opts:           requester.Options{MaxRetries: 2},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "q=1",
+			expectedCalls:  1,
+		},
+		{
+			about:          "when the host fails and then recovers it is retried",
+			statuses:       []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK},
+			opts:           requester.Options{MaxRetries: 2, BaseBackoff: time.Millisecond},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "q=1",
+			expectedCalls:  3,
+		},
+		{
+			about:          "when the host keeps failing the retries are exhausted",
+			statuses:       []int{http.StatusBadGateway},
+			opts:           requester.Options{MaxRetries: 2, BaseBackoff: time.Millisecond},
+			expectedErr:    "unexpected status 502 from ",
+			expectedStatus: http.StatusBadGateway,
+			expectedBody:   "q=1",
+			expectedCalls:  3,
+		},
+		{
+			about:          "when the status isn't retryable it fails without retrying",
+			statuses:       []int{http.StatusNotFound},
+			opts:           requester.Options{MaxRetries: 2, BaseBackoff: time.Millisecond},
+			expectedErr:    "unexpected status 404 from ",
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   "q=1",
+			expectedCalls:  1,
+		},
+		{
+			about:          "when the retries are disabled",
+			statuses:       []int{http.StatusInternalServerError, http.StatusOK},
+			expectedErr:    "unexpected status 500 from ",
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   "q=1",
+			expectedCalls:  1,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			srv, calls := stub(t, tc.statuses, nil)
+			rq := requester.New(srv.Client(), tc.opts)
+
+			// Action
+			b, status, err := rq.Get(context.Background(), srv.URL+"/products", map[string]string{"q": "1"})
+
+			// Assert
+			if tc.expectedErr != "" {
+				assert.ErrorContains(t, err, tc.expectedErr)
+				assert.IsType(t, &requester.ErrUnexpectedStatus{}, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tc.expectedStatus, status)
+			assert.Equal(t, tc.expectedBody, string(b))
+			assert.Equal(t, tc.expectedCalls, calls.Load())
+		})
+	}
+}
+
+func TestRequester_Get_RetryAfter(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	srv, calls := stub(t, []int{http.StatusTooManyRequests, http.StatusOK}, http.Header{"Retry-After": {"1"}})
+	rq := requester.New(srv.Client(), requester.Options{MaxRetries: 1, BaseBackoff: time.Millisecond, MaxBackoff: 5 * time.Second})
+
+	// Action
+	start := time.Now()
+	_, status, err := rq.Get(context.Background(), srv.URL, nil)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, int64(2), calls.Load())
+	assert.GreaterOrEqual(t, time.Since(start), time.Second)
+}
+
+func TestRequester_Get_RetryAfterLongerThanMaxBackoff(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	srv, calls := stub(t, []int{http.StatusTooManyRequests, http.StatusOK}, http.Header{"Retry-After": {"60"}})
+	rq := requester.New(srv.Client(), requester.Options{MaxRetries: 2, BaseBackoff: time.Millisecond, MaxBackoff: 5 * time.Second})
+
+	// Action
+	start := time.Now()
+	_, status, err := rq.Get(context.Background(), srv.URL, nil)
+
+	// Assert
+	assert.IsType(t, &requester.ErrUnexpectedStatus{}, err)
+	assert.Equal(t, http.StatusTooManyRequests, status)
+	assert.Equal(t, int64(1), calls.Load(), "the host isn't called before the time it asked")
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestRequester_Get_Canceled(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	srv, calls := stub(t, []int{http.StatusServiceUnavailable}, http.Header{"Retry-After": {"10"}})
+	rq := requester.New(srv.Client(), requester.Options{MaxRetries: 3, MaxBackoff: time.Minute})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	// Action
+	_, _, err := rq.Get(ctx, srv.URL, nil)
+
+	// Assert
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, int64(1), calls.Load())
+}
+
+func TestRequester_Get_CircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	srv, calls := stub(t, []int{http.StatusInternalServerError, http.StatusInternalServerError, http.StatusOK}, nil)
+	rq := requester.New(srv.Client(), requester.Options{FailureThreshold: 2, OpenDuration: 100 * time.Millisecond})
+
+	host, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+
+	// Action & Assert
+	for i := 0; i < 2; i++ {
+		_, _, err := rq.Get(context.Background(), srv.URL, nil)
+		assert.IsType(t, &requester.ErrUnexpectedStatus{}, err)
+	}
+
+	_, _, err = rq.Get(context.Background(), srv.URL, nil)
+	assert.Equal(t, &requester.ErrCircuitOpen{Host: host.Host}, err)
+	assert.Equal(t, int64(2), calls.Load(), "the host isn't called while the circuit is open")
+
+	time.Sleep(150 * time.Millisecond)
+
+	_, status, err := rq.Get(context.Background(), srv.URL, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, status)
+
+	_, _, err = rq.Get(context.Background(), srv.URL, nil)
+	assert.NoError(t, err, "the circuit is closed after the host recovers")
+	assert.Equal(t, int64(4), calls.Load())
+}
+
+func TestRequester_Get_CircuitBreakerIsPerHost(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	failing, _ := stub(t, []int{http.StatusInternalServerError}, nil)
+	healthy, calls := stub(t, []int{http.StatusOK}, nil)
+	rq := requester.New(http.DefaultClient, requester.Options{FailureThreshold: 1, OpenDuration: time.Minute})
+
+	_, _, _ = rq.Get(context.Background(), failing.URL, nil)
+
+	// Action
+	_, failingStatus, failingErr := rq.Get(context.Background(), failing.URL, nil)
+	_, healthyStatus, healthyErr := rq.Get(context.Background(), healthy.URL, nil)
+
+	// Assert
+	assert.IsType(t, &requester.ErrCircuitOpen{}, failingErr)
+	assert.Equal(t, 0, failingStatus)
+	assert.NoError(t, healthyErr)
+	assert.Equal(t, http.StatusOK, healthyStatus)
+	assert.Equal(t, int64(1), calls.Load())
+}
@@ -0,0 +1,59 @@
+package ioc
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/uesleicarvalhoo/aiqfome/config"
+	"github.com/uesleicarvalhoo/aiqfome/event"
+	"github.com/uesleicarvalhoo/aiqfome/event/postgres"
+	"github.com/uesleicarvalhoo/aiqfome/internal/infra/publisher"
+)
+
+var (
+	eventRepo     event.Repository
+	eventRepoOnce sync.Once
+)
+
+func EventRepository() event.Repository {
+	eventRepoOnce.Do(func() {
+		eventRepo = postgres.NewRepository(Database())
+	})
+
+	return eventRepo
+}
+
+var (
+	eventPublisher     event.Publisher
+	eventPublisherOnce sync.Once
+)
+
+func EventPublisher() event.Publisher {
+	eventPublisherOnce.Do(func() {
+		switch kind := config.GetString("EVENTS_PUBLISHER"); kind {
+		case "http":
+			eventPublisher = publisher.NewHTTP(&http.Client{
+				Timeout: config.GetDuration("EVENTS_HTTP_TIMEOUT"),
+			}, config.GetString("EVENTS_HTTP_URL"))
+		case "log":
+			var w io.Writer = os.Stdout
+			if path := config.GetString("EVENTS_LOG_FILE"); path != "" {
+				f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+				if err != nil {
+					panic(fmt.Sprintf("failed to open events log file: %s", err))
+				}
+
+				w = f
+			}
+
+			eventPublisher = publisher.NewWriter(w)
+		default:
+			panic(fmt.Sprintf("unknown events publisher: %s", kind))
+		}
+	})
+
+	return eventPublisher
+}
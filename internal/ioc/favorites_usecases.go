@@ -3,8 +3,10 @@ package ioc
 import (
 	"sync"
 
+	"github.com/uesleicarvalhoo/aiqfome/config"
 	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites"
 	"github.com/uesleicarvalhoo/aiqfome/internal/app/favorites/usecase"
+	"github.com/uesleicarvalhoo/aiqfome/role"
 )
 
 var (
@@ -14,12 +16,25 @@ var (
 
 func AddProductToFavoritesUseCase() favorites.AddProductToFavoritesUseCase {
 	addProductToFavoritesOnce.Do(func() {
-		addProductToFavoritesUc = usecase.NewAddProductToFavoritesUseCase(ProductRepository(), FavoriteRepository())
+		addProductToFavoritesUc = usecase.NewAddProductToFavoritesUseCase(ProductRepository(), FavoriteRepository(), UserRepository(), quotaOptions())
 	})
 
 	return addProductToFavoritesUc
 }
 
+var (
+	saveProductToFavoritesUc   favorites.SaveProductToFavoritesUseCase
+	saveProductToFavoritesOnce sync.Once
+)
+
+func SaveProductToFavoritesUseCase() favorites.SaveProductToFavoritesUseCase {
+	saveProductToFavoritesOnce.Do(func() {
+		saveProductToFavoritesUc = usecase.NewSaveProductToFavoritesUseCase(ProductRepository(), FavoriteRepository(), UserRepository(), quotaOptions())
+	})
+
+	return saveProductToFavoritesUc
+}
+
 var (
 	getClientFavoritesUc   favorites.GetClientFavoritesUseCase
 	getClientFavoritesOnce sync.Once
@@ -45,3 +60,454 @@ func RemoveProductFromFavoritesUseCase() favorites.RemoveProductFromFavoritesUse
 
 	return removeProductFromFavoritesUc
 }
+
+var (
+	addProductsToFavoritesUc   favorites.AddProductsToFavoritesUseCase
+	addProductsToFavoritesOnce sync.Once
+)
+
+func AddProductsToFavoritesUseCase() favorites.AddProductsToFavoritesUseCase {
+	addProductsToFavoritesOnce.Do(func() {
+		addProductsToFavoritesUc = usecase.NewAddProductsToFavoritesUseCase(
+			ProductRepository(),
+			FavoriteRepository(),
+			UserRepository(),
+			usecase.BatchOptions{
+				MaxBatchSize: config.GetInt("FAVORITES_MAX_BATCH_SIZE"),
+			},
+			quotaOptions())
+	})
+
+	return addProductsToFavoritesUc
+}
+
+var (
+	removeProductsFromFavoritesUc   favorites.RemoveProductsFromFavoritesUseCase
+	removeProductsFromFavoritesOnce sync.Once
+)
+
+func RemoveProductsFromFavoritesUseCase() favorites.RemoveProductsFromFavoritesUseCase {
+	removeProductsFromFavoritesOnce.Do(func() {
+		removeProductsFromFavoritesUc = usecase.NewRemoveProductsFromFavoritesUseCase(
+			FavoriteRepository(),
+			usecase.BatchOptions{
+				MaxBatchSize: config.GetInt("FAVORITES_MAX_BATCH_SIZE"),
+			})
+	})
+
+	return removeProductsFromFavoritesUc
+}
+
+var (
+	updateFavoriteUc   favorites.UpdateFavoriteUseCase
+	updateFavoriteOnce sync.Once
+)
+
+func UpdateFavoriteUseCase() favorites.UpdateFavoriteUseCase {
+	updateFavoriteOnce.Do(func() {
+		updateFavoriteUc = usecase.NewUpdateFavoriteUseCase(FavoriteRepository())
+	})
+
+	return updateFavoriteUc
+}
+
+var (
+	createFavoriteListUc   favorites.CreateFavoriteListUseCase
+	createFavoriteListOnce sync.Once
+)
+
+func CreateFavoriteListUseCase() favorites.CreateFavoriteListUseCase {
+	createFavoriteListOnce.Do(func() {
+		createFavoriteListUc = usecase.NewCreateFavoriteListUseCase(IDGenerator(), FavoriteRepository())
+	})
+
+	return createFavoriteListUc
+}
+
+var (
+	getClientFavoriteListsUc   favorites.GetClientFavoriteListsUseCase
+	getClientFavoriteListsOnce sync.Once
+)
+
+func GetClientFavoriteListsUseCase() favorites.GetClientFavoriteListsUseCase {
+	getClientFavoriteListsOnce.Do(func() {
+		getClientFavoriteListsUc = usecase.NewGetClientFavoriteListsUseCase(FavoriteRepository())
+	})
+
+	return getClientFavoriteListsUc
+}
+
+var (
+	getFavoriteListUc   favorites.GetFavoriteListUseCase
+	getFavoriteListOnce sync.Once
+)
+
+func GetFavoriteListUseCase() favorites.GetFavoriteListUseCase {
+	getFavoriteListOnce.Do(func() {
+		getFavoriteListUc = usecase.NewGetFavoriteListUseCase(FavoriteRepository(), ProductRepository())
+	})
+
+	return getFavoriteListUc
+}
+
+var (
+	renameFavoriteListUc   favorites.RenameFavoriteListUseCase
+	renameFavoriteListOnce sync.Once
+)
+
+func RenameFavoriteListUseCase() favorites.RenameFavoriteListUseCase {
+	renameFavoriteListOnce.Do(func() {
+		renameFavoriteListUc = usecase.NewRenameFavoriteListUseCase(FavoriteRepository())
+	})
+
+	return renameFavoriteListUc
+}
+
+var (
+	deleteFavoriteListUc   favorites.DeleteFavoriteListUseCase
+	deleteFavoriteListOnce sync.Once
+)
+
+func DeleteFavoriteListUseCase() favorites.DeleteFavoriteListUseCase {
+	deleteFavoriteListOnce.Do(func() {
+		deleteFavoriteListUc = usecase.NewDeleteFavoriteListUseCase(FavoriteRepository())
+	})
+
+	return deleteFavoriteListUc
+}
+
+var (
+	addProductToListUc   favorites.AddProductToListUseCase
+	addProductToListOnce sync.Once
+)
+
+func AddProductToListUseCase() favorites.AddProductToListUseCase {
+	addProductToListOnce.Do(func() {
+		addProductToListUc = usecase.NewAddProductToListUseCase(ProductRepository(), FavoriteRepository())
+	})
+
+	return addProductToListUc
+}
+
+var (
+	removeProductFromListUc   favorites.RemoveProductFromListUseCase
+	removeProductFromListOnce sync.Once
+)
+
+func RemoveProductFromListUseCase() favorites.RemoveProductFromListUseCase {
+	removeProductFromListOnce.Do(func() {
+		removeProductFromListUc = usecase.NewRemoveProductFromListUseCase(FavoriteRepository())
+	})
+
+	return removeProductFromListUc
+}
+
+var (
+	getFavoritesTrashUc   favorites.GetFavoritesTrashUseCase
+	getFavoritesTrashOnce sync.Once
+)
+
+func GetFavoritesTrashUseCase() favorites.GetFavoritesTrashUseCase {
+	getFavoritesTrashOnce.Do(func() {
+		getFavoritesTrashUc = usecase.NewGetFavoritesTrashUseCase(FavoriteRepository(), ProductRepository(), trashOptions())
+	})
+
+	return getFavoritesTrashUc
+}
+
+var (
+	getFavoritesHistoryUc   favorites.GetFavoritesHistoryUseCase
+	getFavoritesHistoryOnce sync.Once
+)
+
+func GetFavoritesHistoryUseCase() favorites.GetFavoritesHistoryUseCase {
+	getFavoritesHistoryOnce.Do(func() {
+		getFavoritesHistoryUc = usecase.NewGetFavoritesHistoryUseCase(FavoriteRepository())
+	})
+
+	return getFavoritesHistoryUc
+}
+
+var (
+	getFavoritesChangesUc   favorites.GetFavoritesChangesUseCase
+	getFavoritesChangesOnce sync.Once
+)
+
+func GetFavoritesChangesUseCase() favorites.GetFavoritesChangesUseCase {
+	getFavoritesChangesOnce.Do(func() {
+		getFavoritesChangesUc = usecase.NewGetFavoritesChangesUseCase(FavoriteRepository(), ProductRepository(), trashOptions())
+	})
+
+	return getFavoritesChangesUc
+}
+
+var (
+	reorderFavoritesUc   favorites.ReorderFavoritesUseCase
+	reorderFavoritesOnce sync.Once
+)
+
+func ReorderFavoritesUseCase() favorites.ReorderFavoritesUseCase {
+	reorderFavoritesOnce.Do(func() {
+		reorderFavoritesUc = usecase.NewReorderFavoritesUseCase(FavoriteRepository())
+	})
+
+	return reorderFavoritesUc
+}
+
+var (
+	restoreFavoriteUc   favorites.RestoreFavoriteUseCase
+	restoreFavoriteOnce sync.Once
+)
+
+func RestoreFavoriteUseCase() favorites.RestoreFavoriteUseCase {
+	restoreFavoriteOnce.Do(func() {
+		restoreFavoriteUc = usecase.NewRestoreFavoriteUseCase(FavoriteRepository(), UserRepository(), trashOptions(), quotaOptions())
+	})
+
+	return restoreFavoriteUc
+}
+
+var (
+	purgeFavoritesTrashUc   favorites.PurgeFavoritesTrashUseCase
+	purgeFavoritesTrashOnce sync.Once
+)
+
+func PurgeFavoritesTrashUseCase() favorites.PurgeFavoritesTrashUseCase {
+	purgeFavoritesTrashOnce.Do(func() {
+		purgeFavoritesTrashUc = usecase.NewPurgeFavoritesTrashUseCase(FavoriteRepository(), trashOptions())
+	})
+
+	return purgeFavoritesTrashUc
+}
+
+var (
+	archiveUnavailableFavoritesUc   favorites.ArchiveUnavailableFavoritesUseCase
+	archiveUnavailableFavoritesOnce sync.Once
+)
+
+func ArchiveUnavailableFavoritesUseCase() favorites.ArchiveUnavailableFavoritesUseCase {
+	archiveUnavailableFavoritesOnce.Do(func() {
+		archiveUnavailableFavoritesUc = usecase.NewArchiveUnavailableFavoritesUseCase(FavoriteRepository(), ProductRepository())
+	})
+
+	return archiveUnavailableFavoritesUc
+}
+
+var (
+	getFavoritesStatsUc   favorites.GetFavoritesStatsUseCase
+	getFavoritesStatsOnce sync.Once
+)
+
+func GetFavoritesStatsUseCase() favorites.GetFavoritesStatsUseCase {
+	getFavoritesStatsOnce.Do(func() {
+		getFavoritesStatsUc = usecase.NewGetFavoritesStatsUseCase(
+			FavoriteRepository(),
+			ProductRepository(),
+			Cache(),
+			usecase.StatsOptions{
+				CacheDuration: config.GetDuration("FAVORITES_STATS_CACHE_DURATION"),
+			})
+	})
+
+	return getFavoritesStatsUc
+}
+
+var (
+	exportClientFavoritesUc   favorites.ExportClientFavoritesUseCase
+	exportClientFavoritesOnce sync.Once
+)
+
+func ExportClientFavoritesUseCase() favorites.ExportClientFavoritesUseCase {
+	exportClientFavoritesOnce.Do(func() {
+		exportClientFavoritesUc = usecase.NewExportClientFavoritesUseCase(
+			FavoriteRepository(),
+			ProductRepository(),
+			usecase.ExportOptions{
+				BatchSize: config.GetInt("FAVORITES_EXPORT_BATCH_SIZE"),
+			})
+	})
+
+	return exportClientFavoritesUc
+}
+
+var (
+	importFavoritesUc   favorites.ImportFavoritesUseCase
+	importFavoritesOnce sync.Once
+)
+
+func ImportFavoritesUseCase() favorites.ImportFavoritesUseCase {
+	importFavoritesOnce.Do(func() {
+		importFavoritesUc = usecase.NewImportFavoritesUseCase(
+			ProductRepository(),
+			FavoriteRepository(),
+			UserRepository(),
+			Cache(),
+			BackgroundJobs(),
+			importOptions(),
+			quotaOptions())
+	})
+
+	return importFavoritesUc
+}
+
+var (
+	getImportJobUc   favorites.GetImportJobUseCase
+	getImportJobOnce sync.Once
+)
+
+func GetImportJobUseCase() favorites.GetImportJobUseCase {
+	getImportJobOnce.Do(func() {
+		getImportJobUc = usecase.NewGetImportJobUseCase(Cache(), importOptions())
+	})
+
+	return getImportJobUc
+}
+
+var (
+	createFavoritesShareUc   favorites.CreateFavoritesShareUseCase
+	createFavoritesShareOnce sync.Once
+)
+
+func CreateFavoritesShareUseCase() favorites.CreateFavoritesShareUseCase {
+	createFavoritesShareOnce.Do(func() {
+		createFavoritesShareUc = usecase.NewCreateFavoritesShareUseCase(FavoriteRepository())
+	})
+
+	return createFavoritesShareUc
+}
+
+var (
+	getFavoritesSharesUc   favorites.GetFavoritesSharesUseCase
+	getFavoritesSharesOnce sync.Once
+)
+
+func GetFavoritesSharesUseCase() favorites.GetFavoritesSharesUseCase {
+	getFavoritesSharesOnce.Do(func() {
+		getFavoritesSharesUc = usecase.NewGetFavoritesSharesUseCase(FavoriteRepository())
+	})
+
+	return getFavoritesSharesUc
+}
+
+var (
+	revokeFavoritesShareUc   favorites.RevokeFavoritesShareUseCase
+	revokeFavoritesShareOnce sync.Once
+)
+
+func RevokeFavoritesShareUseCase() favorites.RevokeFavoritesShareUseCase {
+	revokeFavoritesShareOnce.Do(func() {
+		revokeFavoritesShareUc = usecase.NewRevokeFavoritesShareUseCase(FavoriteRepository())
+	})
+
+	return revokeFavoritesShareUc
+}
+
+var (
+	getSharedFavoritesUc   favorites.GetSharedFavoritesUseCase
+	getSharedFavoritesOnce sync.Once
+)
+
+func GetSharedFavoritesUseCase() favorites.GetSharedFavoritesUseCase {
+	getSharedFavoritesOnce.Do(func() {
+		getSharedFavoritesUc = usecase.NewGetSharedFavoritesUseCase(FavoriteRepository(), ProductRepository())
+	})
+
+	return getSharedFavoritesUc
+}
+
+var (
+	getFavoritesQuotaUc   favorites.GetFavoritesQuotaUseCase
+	getFavoritesQuotaOnce sync.Once
+)
+
+func GetFavoritesQuotaUseCase() favorites.GetFavoritesQuotaUseCase {
+	getFavoritesQuotaOnce.Do(func() {
+		getFavoritesQuotaUc = usecase.NewGetFavoritesQuotaUseCase(FavoriteRepository(), UserRepository(), quotaOptions())
+	})
+
+	return getFavoritesQuotaUc
+}
+
+var (
+	setFavoritesQuotaUc   favorites.SetFavoritesQuotaUseCase
+	setFavoritesQuotaOnce sync.Once
+)
+
+func SetFavoritesQuotaUseCase() favorites.SetFavoritesQuotaUseCase {
+	setFavoritesQuotaOnce.Do(func() {
+		setFavoritesQuotaUc = usecase.NewSetFavoritesQuotaUseCase(FavoriteRepository(), UserRepository(), quotaOptions())
+	})
+
+	return setFavoritesQuotaUc
+}
+
+var (
+	removeFavoritesQuotaUc   favorites.RemoveFavoritesQuotaUseCase
+	removeFavoritesQuotaOnce sync.Once
+)
+
+func RemoveFavoritesQuotaUseCase() favorites.RemoveFavoritesQuotaUseCase {
+	removeFavoritesQuotaOnce.Do(func() {
+		removeFavoritesQuotaUc = usecase.NewRemoveFavoritesQuotaUseCase(FavoriteRepository())
+	})
+
+	return removeFavoritesQuotaUc
+}
+
+var (
+	getRecommendationsUc   favorites.GetRecommendationsUseCase
+	getRecommendationsOnce sync.Once
+)
+
+func GetRecommendationsUseCase() favorites.GetRecommendationsUseCase {
+	getRecommendationsOnce.Do(func() {
+		getRecommendationsUc = usecase.NewGetRecommendationsUseCase(FavoriteRepository(), ProductRepository(), Cache(), recommendationsOptions())
+	})
+
+	return getRecommendationsUc
+}
+
+var (
+	refreshRecommendationsUc   favorites.RefreshRecommendationsUseCase
+	refreshRecommendationsOnce sync.Once
+)
+
+func RefreshRecommendationsUseCase() favorites.RefreshRecommendationsUseCase {
+	refreshRecommendationsOnce.Do(func() {
+		refreshRecommendationsUc = usecase.NewRefreshRecommendationsUseCase(FavoriteRepository(), recommendationsOptions())
+	})
+
+	return refreshRecommendationsUc
+}
+
+func trashOptions() usecase.TrashOptions {
+	return usecase.TrashOptions{
+		Retention: config.GetDuration("FAVORITES_TRASH_RETENTION"),
+	}
+}
+
+func recommendationsOptions() usecase.RecommendationsOptions {
+	return usecase.RecommendationsOptions{
+		CacheDuration: config.GetDuration("FAVORITES_RECOMMENDATIONS_CACHE_DURATION"),
+		MaxSimilar:    config.GetInt("FAVORITES_RECOMMENDATIONS_MAX_SIMILAR"),
+	}
+}
+
+func quotaOptions() usecase.QuotaOptions {
+	return usecase.QuotaOptions{
+		ByRole: map[role.Role]int{
+			role.RoleClient: config.GetInt("FAVORITES_QUOTA_CLIENT"),
+			role.RoleAdmin:  config.GetInt("FAVORITES_QUOTA_ADMIN"),
+		},
+	}
+}
+
+func importOptions() usecase.ImportOptions {
+	return usecase.ImportOptions{
+		MaxRows:     config.GetInt("FAVORITES_IMPORT_MAX_ROWS"),
+		SyncMaxRows: config.GetInt("FAVORITES_IMPORT_SYNC_MAX_ROWS"),
+		BatchSize:   config.GetInt("FAVORITES_IMPORT_BATCH_SIZE"),
+		JobTTL:      config.GetDuration("FAVORITES_IMPORT_JOB_TTL"),
+		JobTimeout:  config.GetDuration("FAVORITES_IMPORT_JOB_TIMEOUT"),
+	}
+}
@@ -1,31 +1,116 @@
 package ioc
 
 import (
+	"context"
+	"fmt"
 	"strings"
 	"sync"
 
 	"github.com/uesleicarvalhoo/aiqfome/config"
 	"github.com/uesleicarvalhoo/aiqfome/internal/infra/requester"
 	"github.com/uesleicarvalhoo/aiqfome/product"
+	"github.com/uesleicarvalhoo/aiqfome/product/cached"
 	"github.com/uesleicarvalhoo/aiqfome/product/fakestoreapi"
+	"github.com/uesleicarvalhoo/aiqfome/product/postgres"
+)
+
+const (
+	ProductSourceUpstream = "upstream"
+	ProductSourceMirror   = "mirror"
 )
 
 var (
 	productRepo     product.Repository
+	productCache    *cached.Repository
 	productRepoOnce sync.Once
 )
 
+// ProductRepository reads the products from upstream or from the local mirror, as set on PRODUCTS_SOURCE
 func ProductRepository() product.Repository {
 	productRepoOnce.Do(func() {
-		productRepo = fakestoreapi.NewRepository(
-			requester.New(HttpClient()),
+		var source product.Reader
+
+		switch kind := config.GetString("PRODUCTS_SOURCE"); kind {
+		case ProductSourceUpstream:
+			source = UpstreamProductRepository()
+		case ProductSourceMirror:
+			source = ProductMirror()
+		default:
+			panic(fmt.Sprintf("unknown products source: %s", kind))
+		}
+
+		productCache = cached.NewRepository(source, Cache(), cached.Options{
+			ProductDuration: config.GetDuration("PRODUCTS_CACHE_DURATION"),
+			CatalogDuration: config.GetDuration("PRODUCTS_CATALOG_CACHE_DURATION"),
+			StaleDuration:   config.GetDuration("PRODUCTS_STALE_DURATION"),
+			RevalidateAfter: config.GetDuration("PRODUCTS_CACHE_REVALIDATE_AFTER"),
+			// Upstream downloads the whole catalog on FindMultiple unless it finds each product
+			NextFetchesCatalog: config.GetString("PRODUCTS_SOURCE") == ProductSourceUpstream &&
+				fakestoreapi.Strategy(config.GetString("FAKE_STORE_API_FIND_MULTIPLE")) != fakestoreapi.StrategyPerID,
+		})
+		productRepo = productCache
+	})
+
+	return productRepo
+}
+
+// ProductCacheStats returns the hits, misses and stale reads of the products cache since the start
+func ProductCacheStats() cached.Stats {
+	ProductRepository()
+
+	return productCache.Stats()
+}
+
+// RevalidateProductCache refreshes the cached products that are getting old
+func RevalidateProductCache(ctx context.Context) (int, error) {
+	ProductRepository()
+
+	return productCache.Revalidate(ctx)
+}
+
+var (
+	upstreamProductRepo     product.Repository
+	upstreamProductRepoOnce sync.Once
+)
+
+func UpstreamProductRepository() product.Repository {
+	upstreamProductRepoOnce.Do(func() {
+		var err error
+		upstreamProductRepo, err = fakestoreapi.NewRepository(
+			requester.New(HttpClient(), requester.Options{
+				MaxRetries:       config.GetInt("HTTP_CLIENT_MAX_RETRIES"),
+				BaseBackoff:      config.GetDuration("HTTP_CLIENT_RETRY_BACKOFF"),
+				MaxBackoff:       config.GetDuration("HTTP_CLIENT_RETRY_MAX_BACKOFF"),
+				FailureThreshold: config.GetInt("HTTP_CLIENT_BREAKER_THRESHOLD"),
+				OpenDuration:     config.GetDuration("HTTP_CLIENT_BREAKER_OPEN_DURATION"),
+			}),
 			fakestoreapi.Options{
 				BaseUrl:         strings.TrimSuffix(config.GetString("FAKE_STORE_API_URL"), "/"),
 				GetByIdEndpoint: config.GetString("FAKE_STORE_API_GET_BY_ID_ENDPOINT"),
 				GetAllEndpoint:  config.GetString("FAKE_STORE_API_GET_ALL"),
+				// "all" downloads the whole catalog, "per_id" finds each product in parallel
+				FindMultipleStrategy: fakestoreapi.Strategy(config.GetString("FAKE_STORE_API_FIND_MULTIPLE")),
+				MaxConcurrency:       config.GetInt("FAKE_STORE_API_MAX_CONCURRENCY"),
+				FindMultipleTimeout:  config.GetDuration("FAKE_STORE_API_FIND_TIMEOUT"),
 			},
 		)
+		if err != nil {
+			panic(fmt.Sprintf("failed to setup products repository: %s", err))
+		}
 	})
 
-	return productRepo
+	return upstreamProductRepo
+}
+
+var (
+	productMirror     product.Mirror
+	productMirrorOnce sync.Once
+)
+
+func ProductMirror() product.Mirror {
+	productMirrorOnce.Do(func() {
+		productMirror = postgres.NewRepository(Database())
+	})
+
+	return productMirror
 }
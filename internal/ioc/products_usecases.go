@@ -0,0 +1,35 @@
+package ioc
+
+import (
+	"sync"
+
+	"github.com/uesleicarvalhoo/aiqfome/config"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/products"
+	"github.com/uesleicarvalhoo/aiqfome/internal/app/products/usecase"
+)
+
+var (
+	syncProductsUc   products.SyncProductsUseCase
+	syncProductsOnce sync.Once
+)
+
+func SyncProductsUseCase() products.SyncProductsUseCase {
+	syncProductsOnce.Do(func() {
+		syncProductsUc = usecase.NewSyncProductsUseCase(UpstreamProductRepository(), ProductMirror())
+	})
+
+	return syncProductsUc
+}
+
+var (
+	getProductsSyncStatusUc   products.GetProductsSyncStatusUseCase
+	getProductsSyncStatusOnce sync.Once
+)
+
+func GetProductsSyncStatusUseCase() products.GetProductsSyncStatusUseCase {
+	getProductsSyncStatusOnce.Do(func() {
+		getProductsSyncStatusUc = usecase.NewGetProductsSyncStatusUseCase(ProductMirror(), config.GetString("PRODUCTS_SOURCE"))
+	})
+
+	return getProductsSyncStatusUc
+}
@@ -0,0 +1,21 @@
+package ioc
+
+import (
+	"sync"
+
+	"github.com/uesleicarvalhoo/aiqfome/internal/worker"
+)
+
+var (
+	backgroundJobs     *worker.Background
+	backgroundJobsOnce sync.Once
+)
+
+// BackgroundJobs runs the tasks started by requests, like the imports of big files, it is drained on shutdown
+func BackgroundJobs() *worker.Background {
+	backgroundJobsOnce.Do(func() {
+		backgroundJobs = worker.NewBackground()
+	})
+
+	return backgroundJobs
+}
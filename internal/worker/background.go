@@ -0,0 +1,86 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/uesleicarvalhoo/aiqfome/pkg/logger"
+)
+
+var ErrStopped = errors.New("background worker stopped")
+
+// Background runs the one-off tasks started by requests, they are tracked so the shutdown
+// can wait for them to finish and cancel the ones still running after its deadline
+type Background struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu      sync.Mutex
+	stopped bool
+	wg      sync.WaitGroup
+}
+
+func NewBackground() *Background {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &Background{
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// Go runs the task keeping the values of the context but not its cancelation, so it outlives the request.
+// It fails with ErrStopped once the shutdown started
+func (b *Background) Go(ctx context.Context, name string, task func(ctx context.Context) error) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.stopped {
+		return ErrStopped
+	}
+
+	b.wg.Add(1)
+	go func() {
+		defer b.wg.Done()
+
+		ctx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		defer cancel()
+
+		stop := context.AfterFunc(b.ctx, cancel)
+		defer stop()
+
+		if err := task(ctx); err != nil {
+			logger.ErrorF(ctx, "error while running background task", logger.Fields{
+				"task":  name,
+				"error": err.Error(),
+			})
+		}
+	}()
+
+	return nil
+}
+
+// Shutdown stops accepting tasks and waits for the running ones until the context is done,
+// then cancels them and waits for them to return
+func (b *Background) Shutdown(ctx context.Context) {
+	b.mu.Lock()
+	b.stopped = true
+	b.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		b.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return
+	case <-ctx.Done():
+	}
+
+	logger.WarnF(ctx, "canceling the background tasks still running", logger.Fields{})
+	b.cancel()
+	<-done
+}
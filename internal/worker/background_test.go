@@ -0,0 +1,80 @@
+package worker_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uesleicarvalhoo/aiqfome/internal/worker"
+)
+
+func TestBackground_Shutdown(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when the tasks finish before the deadline they aren't canceled", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		var finished atomic.Bool
+		b := worker.NewBackground()
+
+		reqCtx, cancelReq := context.WithCancel(context.Background())
+		err := b.Go(reqCtx, "test", func(ctx context.Context) error {
+			time.Sleep(20 * time.Millisecond)
+			finished.Store(ctx.Err() == nil)
+			return nil
+		})
+		cancelReq()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		// Action
+		b.Shutdown(ctx)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.True(t, finished.Load(), "the task outlives the request and isn't canceled")
+	})
+
+	t.Run("when the deadline is exceeded the tasks are canceled and waited", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		var canceled atomic.Bool
+		b := worker.NewBackground()
+
+		err := b.Go(context.Background(), "test", func(ctx context.Context) error {
+			<-ctx.Done()
+			time.Sleep(10 * time.Millisecond)
+			canceled.Store(true)
+			return ctx.Err()
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		// Action
+		b.Shutdown(ctx)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.True(t, canceled.Load())
+	})
+
+	t.Run("when the shutdown started new tasks are refused", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		b := worker.NewBackground()
+		b.Shutdown(context.Background())
+
+		// Action
+		err := b.Go(context.Background(), "test", func(context.Context) error { return nil })
+
+		// Assert
+		assert.ErrorIs(t, err, worker.ErrStopped)
+	})
+}
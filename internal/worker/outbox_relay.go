@@ -0,0 +1,69 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/uesleicarvalhoo/aiqfome/event"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/logger"
+)
+
+// OutboxRelay publishes the pending events of the outbox in the order they were written,
+// it stops at the first failure so the next run starts again from the same event
+type OutboxRelay struct {
+	outbox       event.Repository
+	publisher    event.Publisher
+	batchSize    int
+	batchTimeout time.Duration
+	retention    time.Duration
+}
+
+func NewOutboxRelay(outbox event.Repository, publisher event.Publisher, batchSize int, batchTimeout, retention time.Duration) *OutboxRelay {
+	return &OutboxRelay{
+		outbox:       outbox,
+		publisher:    publisher,
+		batchSize:    batchSize,
+		batchTimeout: batchTimeout,
+		retention:    retention,
+	}
+}
+
+func (r *OutboxRelay) Relay(ctx context.Context) error {
+	// The outbox keeps the batch locked while publishing, so a slow publisher can't hold it longer than the batch timeout,
+	// the events left behind stay pending for the next run
+	deadline := time.Now().Add(r.batchTimeout)
+	publish := func(ctx context.Context, e event.Event) error {
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		return r.publisher.Publish(ctx, e)
+	}
+
+	n, err := r.outbox.PublishPending(ctx, r.batchSize, publish)
+	if n > 0 {
+		logger.Debug(ctx, "%d events published", n)
+	}
+
+	return err
+}
+
+// Purge deletes the events published longer than the retention ago
+func (r *OutboxRelay) Purge(ctx context.Context) error {
+	n, err := r.outbox.PurgePublished(ctx, time.Now().Add(-r.retention))
+	if err != nil {
+		return err
+	}
+
+	if n > 0 {
+		logger.InfoF(ctx, "published events purged", logger.Fields{
+			"purged":    n,
+			"retention": r.retention.String(),
+		})
+	}
+
+	return nil
+}
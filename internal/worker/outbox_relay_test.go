@@ -0,0 +1,178 @@
+package worker_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/uesleicarvalhoo/aiqfome/event"
+	fixtureEvent "github.com/uesleicarvalhoo/aiqfome/event/fixture"
+	mocksEvent "github.com/uesleicarvalhoo/aiqfome/event/mocks"
+	"github.com/uesleicarvalhoo/aiqfome/internal/worker"
+)
+
+// pending publishes the events like the outbox does, stopping at the first failure
+func pending(ee ...event.Event) func(context.Context, int, func(context.Context, event.Event) error) (int, error) {
+	return func(ctx context.Context, _ int, publish func(context.Context, event.Event) error) (int, error) {
+		for i, e := range ee {
+			if err := publish(ctx, e); err != nil {
+				return i, err
+			}
+		}
+
+		return len(ee), nil
+	}
+}
+
+func TestOutboxRelay_Relay(t *testing.T) {
+	t.Parallel()
+
+	first := fixtureEvent.AnyEvent().Build()
+	second := fixtureEvent.AnyEvent().WithType("ClientSignedUp").Build()
+
+	testCases := []struct {
+		about          string
+		setupOutbox    func(m *mocksEvent.Repository)
+		setupPublisher func(m *mocksEvent.Publisher)
+		expectedErr    string
+	}{
+		{
+			about: "when the outbox fails",
+			setupOutbox: func(m *mocksEvent.Repository) {
+				m.On("PublishPending", mock.Anything, 10, mock.Anything).Return(0, errors.New("db error"))
+			},
+			expectedErr: "db error",
+		},
+		{
+			about: "when there is nothing to publish",
+			setupOutbox: func(m *mocksEvent.Repository) {
+				m.On("PublishPending", mock.Anything, 10, mock.Anything).Return(pending())
+			},
+		},
+		{
+			about: "when publish fails the next events are kept pending",
+			setupOutbox: func(m *mocksEvent.Repository) {
+				m.On("PublishPending", mock.Anything, 10, mock.Anything).Return(pending(first, second))
+			},
+			setupPublisher: func(m *mocksEvent.Publisher) {
+				m.On("Publish", mock.Anything, first).Return(errors.New("publisher unavailable"))
+			},
+			expectedErr: "publisher unavailable",
+		},
+		{
+			about: "when all events are published",
+			setupOutbox: func(m *mocksEvent.Repository) {
+				m.On("PublishPending", mock.Anything, 10, mock.Anything).Return(pending(first, second))
+			},
+			setupPublisher: func(m *mocksEvent.Publisher) {
+				m.On("Publish", mock.Anything, first).Return(nil).Once()
+				m.On("Publish", mock.Anything, second).Return(nil).Once()
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			outbox := mocksEvent.NewRepository(t)
+			if tc.setupOutbox != nil {
+				tc.setupOutbox(outbox)
+			}
+
+			publisher := mocksEvent.NewPublisher(t)
+			if tc.setupPublisher != nil {
+				tc.setupPublisher(publisher)
+			}
+
+			relay := worker.NewOutboxRelay(outbox, publisher, 10, time.Minute, time.Hour)
+
+			// Action
+			err := relay.Relay(context.Background())
+
+			// Assert
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestOutboxRelay_Relay_BatchTimeout(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	first := fixtureEvent.AnyEvent().Build()
+	second := fixtureEvent.AnyEvent().WithType("ClientSignedUp").Build()
+
+	outbox := mocksEvent.NewRepository(t)
+	outbox.On("PublishPending", mock.Anything, 10, mock.Anything).Return(pending(first, second))
+
+	publisher := mocksEvent.NewPublisher(t)
+	publisher.On("Publish", mock.Anything, first).Return(nil).Run(func(args mock.Arguments) {
+		<-args.Get(0).(context.Context).Done()
+	}).Once()
+
+	relay := worker.NewOutboxRelay(outbox, publisher, 10, 20*time.Millisecond, time.Hour)
+
+	// Action
+	err := relay.Relay(context.Background())
+
+	// Assert
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestOutboxRelay_Purge(t *testing.T) {
+	t.Parallel()
+
+	retention := 24 * time.Hour
+
+	testCases := []struct {
+		about       string
+		purgeErr    error
+		expectedErr string
+	}{
+		{
+			about:       "when the outbox fails",
+			purgeErr:    errors.New("db error"),
+			expectedErr: "db error",
+		},
+		{
+			about: "when the events published before the retention are purged",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			outbox := mocksEvent.NewRepository(t)
+			outbox.On("PurgePublished", mock.Anything, mock.MatchedBy(func(before time.Time) bool {
+				return time.Since(before) >= retention && time.Since(before) < retention+time.Minute
+			})).Return(2, tc.purgeErr)
+
+			relay := worker.NewOutboxRelay(outbox, mocksEvent.NewPublisher(t), 10, time.Minute, retention)
+
+			// Action
+			err := relay.Purge(context.Background())
+
+			// Assert
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
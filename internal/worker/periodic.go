@@ -0,0 +1,47 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/uesleicarvalhoo/aiqfome/pkg/logger"
+)
+
+type Task func(ctx context.Context) error
+
+// Periodic runs the task every interval until the context is done, failures are logged and don't stop the worker
+type Periodic struct {
+	name     string
+	interval time.Duration
+	task     Task
+}
+
+func NewPeriodic(name string, interval time.Duration, task Task) *Periodic {
+	return &Periodic{
+		name:     name,
+		interval: interval,
+		task:     task,
+	}
+}
+
+func (p *Periodic) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	logger.Debug(ctx, "worker %s running every %s", p.name, p.interval)
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Debug(context.Background(), "worker %s stopped", p.name)
+			return
+		case <-ticker.C:
+			if err := p.task(ctx); err != nil {
+				logger.ErrorF(ctx, "error while running worker task", logger.Fields{
+					"worker": p.name,
+					"error":  err.Error(),
+				})
+			}
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package worker_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uesleicarvalhoo/aiqfome/internal/worker"
+)
+
+func TestPeriodic_Run(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		about   string
+		taskErr error
+	}{
+		{
+			about: "when task succeeds",
+		},
+		{
+			about:   "when task fails the worker keeps running",
+			taskErr: errors.New("task error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			var runs atomic.Int32
+			ctx, cancel := context.WithCancel(context.Background())
+
+			w := worker.NewPeriodic("test", time.Millisecond, func(ctx context.Context) error {
+				if runs.Add(1) == 3 {
+					cancel()
+				}
+
+				return tc.taskErr
+			})
+
+			// Action
+			done := make(chan struct{})
+			go func() {
+				w.Run(ctx)
+				close(done)
+			}()
+
+			// Assert
+			select {
+			case <-done:
+				assert.GreaterOrEqual(t, runs.Load(), int32(3))
+			case <-time.After(time.Second):
+				cancel()
+				t.Fatal("worker didn't stop after the context was cancelled")
+			}
+		})
+	}
+}
@@ -0,0 +1,6 @@
+package cache
+
+import "errors"
+
+// ErrNotFound is returned by Get when the key doesn't exist or expired
+var ErrNotFound = errors.New("data not found")
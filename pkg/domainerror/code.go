@@ -25,6 +25,8 @@ const (
 
 	// Favorites
 	ProductAlreadyIsFavorite ErrorCode = "FAV001"
+	ProductAlreadyInList     ErrorCode = "FAV002"
+	FavoritesQuotaExceeded   ErrorCode = "FAV003"
 )
 
 func (ec ErrorCode) String() string {
@@ -52,6 +54,8 @@ var httpStatusByCodes = map[ErrorCode]int{
 
 	// Favorites
 	ProductAlreadyIsFavorite: http.StatusConflict,
+	ProductAlreadyInList:     http.StatusConflict,
+	FavoritesQuotaExceeded:   http.StatusConflict,
 }
 
 func StatusCode(code ErrorCode) int {
@@ -0,0 +1,398 @@
+package cached
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uesleicarvalhoo/aiqfome/pkg/cache"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/logger"
+	"github.com/uesleicarvalhoo/aiqfome/product"
+)
+
+const (
+	catalogKey      = "products:catalog"
+	staleCatalogKey = "products:stale:catalog"
+)
+
+type Options struct {
+	// ProductDuration is how long each product found by Find and FindMultiple is kept
+	ProductDuration time.Duration
+	// CatalogDuration is how long the whole catalog returned by All is kept
+	CatalogDuration time.Duration
+	// StaleDuration is how long the last known good copy of each product and of the catalog is kept
+	// to be served when the next repository fails, 0 disables it
+	StaleDuration time.Duration
+	// RevalidateAfter is the age from which Revalidate reads a product again, 0 disables it
+	RevalidateAfter time.Duration
+	// NextFetchesCatalog is set when the next repository downloads the whole catalog on FindMultiple,
+	// the missing products are then read through All so the catalog is cached as well
+	NextFetchesCatalog bool
+}
+
+type Stats struct {
+	Hits   int64 `json:"hits"`
+	Misses int64 `json:"misses"`
+	Stale  int64 `json:"stale"`
+}
+
+// Repository is a product.Repository that keeps the products read from another one on the cache
+type Repository struct {
+	next   product.Reader
+	cache  cache.Cache
+	opts   Options
+	hits   atomic.Int64
+	misses atomic.Int64
+	stale  atomic.Int64
+
+	mu      sync.Mutex
+	tracked map[int]*tracked
+}
+
+// tracked is when a product was read from the next repository and when it was last asked for
+type tracked struct {
+	fetchedAt   time.Time
+	requestedAt time.Time
+}
+
+func NewRepository(next product.Reader, c cache.Cache, opts Options) *Repository {
+	return &Repository{
+		next:    next,
+		cache:   c,
+		opts:    opts,
+		tracked: make(map[int]*tracked),
+	}
+}
+
+func (r *Repository) Find(ctx context.Context, id int) (product.Product, error) {
+	key := productKey(id)
+
+	var p product.Product
+	if r.fromCache(ctx, key, &p) {
+		r.touch(id)
+		return p, nil
+	}
+
+	p, err := r.next.Find(ctx, id)
+	if err != nil {
+		if _, ok := err.(*product.ErrNotFound); !ok {
+			if pp, _ := r.fromStale(ctx, []int{id}, err); len(pp) == 1 {
+				return pp[0], nil
+			}
+		}
+
+		return product.Product{}, err
+	}
+
+	r.remember(ctx, p)
+
+	return p, nil
+}
+
+// FindMultiple reads each product from the cache, then from the cached catalog, and only asks the next repository for the missing ones
+func (r *Repository) FindMultiple(ctx context.Context, ids []int) ([]product.Product, error) {
+	found := make([]product.Product, 0, len(ids))
+	missing := make([]int, 0)
+
+	for _, id := range ids {
+		var p product.Product
+		if r.read(ctx, productKey(id), &p) {
+			r.touch(id)
+			found = append(found, p)
+			continue
+		}
+
+		missing = append(missing, id)
+	}
+
+	if len(missing) > 0 {
+		var catalog []product.Product
+		if r.read(ctx, catalogKey, &catalog) {
+			pp, _ := product.Pick(catalog, missing)
+			found = append(found, pp...)
+			missing = slices.DeleteFunc(missing, func(id int) bool {
+				return slices.ContainsFunc(pp, func(p product.Product) bool { return p.ID == id })
+			})
+		}
+	}
+
+	r.hits.Add(int64(len(found)))
+	r.misses.Add(int64(len(missing)))
+
+	if len(missing) > 0 {
+		pp, err := r.fromNext(ctx, missing)
+		if err != nil {
+			if _, ok := err.(*product.ErrProductsNotFound); !ok {
+				stale, noCopy := r.fromStale(ctx, missing, err)
+				if len(stale) == 0 {
+					return nil, err
+				}
+
+				pp, _ := product.Pick(append(found, stale...), ids)
+				if len(noCopy) > 0 {
+					return pp, &product.ErrProductsNotFound{IDs: noCopy, Cause: err}
+				}
+
+				return pp, nil
+			}
+		}
+
+		for _, p := range pp {
+			r.remember(ctx, p)
+		}
+
+		found = append(found, pp...)
+	}
+
+	return product.Pick(found, ids)
+}
+
+func (r *Repository) All(ctx context.Context) ([]product.Product, error) {
+	var catalog []product.Product
+	if r.fromCache(ctx, catalogKey, &catalog) {
+		return catalog, nil
+	}
+
+	catalog, err := r.next.All(ctx)
+	if err != nil {
+		if stale, ok := r.staleCatalog(ctx, err); ok {
+			return stale, nil
+		}
+
+		return nil, err
+	}
+
+	r.rememberCatalog(ctx, catalog)
+
+	return catalog, nil
+}
+
+// Revalidate reads again the products fetched more than RevalidateAfter ago, so they are refreshed before
+// expiring, the ones not asked for since they would have expired are dropped instead
+func (r *Repository) Revalidate(ctx context.Context) (int, error) {
+	ids := r.aging(time.Now())
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	pp, err := r.fromNext(ctx, ids)
+	if err != nil {
+		nfErr, ok := err.(*product.ErrProductsNotFound)
+		if !ok {
+			return 0, err
+		}
+
+		r.forget(nfErr.IDs)
+	}
+
+	for _, p := range pp {
+		r.remember(ctx, p)
+	}
+
+	return len(pp), nil
+}
+
+// Stats returns how many reads were served by the cache, how many went to the next repository
+// and how many products were served stale because it failed
+func (r *Repository) Stats() Stats {
+	return Stats{
+		Hits:   r.hits.Load(),
+		Misses: r.misses.Load(),
+		Stale:  r.stale.Load(),
+	}
+}
+
+// fromNext reads the products from the next repository, through the whole catalog when it downloads it anyway
+// so the catalog is cached too
+func (r *Repository) fromNext(ctx context.Context, ids []int) ([]product.Product, error) {
+	if !r.opts.NextFetchesCatalog {
+		return r.next.FindMultiple(ctx, ids)
+	}
+
+	catalog, err := r.next.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	r.rememberCatalog(ctx, catalog)
+
+	return product.Pick(catalog, ids)
+}
+
+func (r *Repository) fromCache(ctx context.Context, key string, v any) bool {
+	if !r.read(ctx, key, v) {
+		r.misses.Add(1)
+		return false
+	}
+
+	r.hits.Add(1)
+	return true
+}
+
+// fromStale returns the last known good copy of the products that have one, marked as stale, and the ids without it
+func (r *Repository) fromStale(ctx context.Context, ids []int, cause error) ([]product.Product, []int) {
+	if r.opts.StaleDuration <= 0 {
+		return nil, ids
+	}
+
+	pp := make([]product.Product, 0, len(ids))
+	noCopy := make([]int, 0)
+	for _, id := range ids {
+		var p product.Product
+		if !r.read(ctx, staleKey(id), &p) {
+			noCopy = append(noCopy, id)
+			continue
+		}
+
+		p.Stale = true
+		pp = append(pp, p)
+	}
+
+	if len(pp) > 0 {
+		logger.WarnF(ctx, "serving stale products", logger.Fields{
+			"product_ids":  ids,
+			"without_copy": noCopy,
+			"error":        cause.Error(),
+		})
+
+		r.stale.Add(int64(len(pp)))
+	}
+
+	return pp, noCopy
+}
+
+// staleCatalog returns the last known good copy of the catalog, marked as stale
+func (r *Repository) staleCatalog(ctx context.Context, cause error) ([]product.Product, bool) {
+	if r.opts.StaleDuration <= 0 {
+		return nil, false
+	}
+
+	var catalog []product.Product
+	if !r.read(ctx, staleCatalogKey, &catalog) {
+		return nil, false
+	}
+
+	for i := range catalog {
+		catalog[i].Stale = true
+	}
+
+	logger.WarnF(ctx, "serving stale catalog", logger.Fields{
+		"error": cause.Error(),
+	})
+
+	r.stale.Add(int64(len(catalog)))
+	return catalog, true
+}
+
+func (r *Repository) read(ctx context.Context, key string, v any) bool {
+	data, err := r.cache.Get(ctx, key)
+	if err != nil || data == nil {
+		return false
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		logger.ErrorF(ctx, "failed to unmarshal products from cache", logger.Fields{
+			"key":   key,
+			"error": err.Error(),
+		})
+
+		return false
+	}
+
+	return true
+}
+
+// remember caches a product just read from the next repository, along with its last known good copy
+func (r *Repository) remember(ctx context.Context, p product.Product) {
+	r.toCache(ctx, productKey(p.ID), p, r.opts.ProductDuration)
+
+	if r.opts.StaleDuration > 0 {
+		r.toCache(ctx, staleKey(p.ID), p, r.opts.StaleDuration)
+	}
+
+	if r.opts.RevalidateAfter > 0 {
+		now := time.Now()
+
+		r.mu.Lock()
+		r.tracked[p.ID] = &tracked{fetchedAt: now, requestedAt: now}
+		r.mu.Unlock()
+	}
+}
+
+func (r *Repository) rememberCatalog(ctx context.Context, catalog []product.Product) {
+	r.toCache(ctx, catalogKey, catalog, r.opts.CatalogDuration)
+
+	if r.opts.StaleDuration > 0 {
+		r.toCache(ctx, staleCatalogKey, catalog, r.opts.StaleDuration)
+	}
+}
+
+func (r *Repository) touch(id int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if t, ok := r.tracked[id]; ok {
+		t.requestedAt = time.Now()
+	}
+}
+
+func (r *Repository) forget(ids []int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, id := range ids {
+		delete(r.tracked, id)
+	}
+}
+
+func (r *Repository) aging(now time.Time) []int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	unused := max(r.opts.StaleDuration, r.opts.ProductDuration)
+
+	ids := make([]int, 0)
+	for id, t := range r.tracked {
+		if now.Sub(t.requestedAt) >= unused {
+			delete(r.tracked, id)
+			continue
+		}
+
+		if now.Sub(t.fetchedAt) >= r.opts.RevalidateAfter {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+func (r *Repository) toCache(ctx context.Context, key string, v any, expiration time.Duration) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		logger.ErrorF(ctx, "failed to marshal products", logger.Fields{
+			"key":   key,
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	if err := r.cache.Set(ctx, key, data, expiration); err != nil {
+		logger.ErrorF(ctx, "failed to save products on cache", logger.Fields{
+			"key":   key,
+			"error": err.Error(),
+		})
+	}
+}
+
+func productKey(id int) string {
+	return fmt.Sprintf("products:%d", id)
+}
+
+func staleKey(id int) string {
+	return fmt.Sprintf("products:stale:%d", id)
+}
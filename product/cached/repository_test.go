@@ -0,0 +1,562 @@
+package cached_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	cacheMocks "github.com/uesleicarvalhoo/aiqfome/pkg/cache/mocks"
+	"github.com/uesleicarvalhoo/aiqfome/product"
+	"github.com/uesleicarvalhoo/aiqfome/product/cached"
+	"github.com/uesleicarvalhoo/aiqfome/product/fixture"
+	prodMocks "github.com/uesleicarvalhoo/aiqfome/product/mocks"
+)
+
+var opts = cached.Options{ProductDuration: time.Minute, CatalogDuration: time.Hour}
+
+func TestRepository_Find(t *testing.T) {
+	t.Parallel()
+
+	p := fixture.AnyProduct().WithID(1).Build()
+	data, err := json.Marshal(p)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		about          string
+		setupCache     func(m *cacheMocks.Cache)
+		setupNext      func(m *prodMocks.Reader)
+		expectedErr    string
+		expectedResult product.Product
+		expectedStats  cached.Stats
+	}{
+		{
+			about: "when the product is on cache",
+			setupCache: func(m *cacheMocks.Cache) {
+				m.On("Get", mock.Anything, "products:1").Return(data, nil)
+			},
+			expectedResult: p,
+			expectedStats:  cached.Stats{Hits: 1},
+		},
+		{
+			about: "when the product isn't on cache it is read and saved",
+			setupCache: func(m *cacheMocks.Cache) {
+				m.On("Get", mock.Anything, "products:1").Return(nil, errors.New("data not found"))
+				m.On("Set", mock.Anything, "products:1", data, time.Minute).Return(nil)
+			},
+			setupNext: func(m *prodMocks.Reader) {
+				m.On("Find", mock.Anything, 1).Return(p, nil)
+			},
+			expectedResult: p,
+			expectedStats:  cached.Stats{Misses: 1},
+		},
+		{
+			about: "when the cache has an invalid value",
+			setupCache: func(m *cacheMocks.Cache) {
+				m.On("Get", mock.Anything, "products:1").Return([]byte("{"), nil)
+				m.On("Set", mock.Anything, "products:1", data, time.Minute).Return(errors.New("cache down"))
+			},
+			setupNext: func(m *prodMocks.Reader) {
+				m.On("Find", mock.Anything, 1).Return(p, nil)
+			},
+			expectedResult: p,
+			expectedStats:  cached.Stats{Misses: 1},
+		},
+		{
+			about: "when the product isn't found it isn't saved",
+			setupCache: func(m *cacheMocks.Cache) {
+				m.On("Get", mock.Anything, "products:1").Return(nil, errors.New("data not found"))
+			},
+			setupNext: func(m *prodMocks.Reader) {
+				m.On("Find", mock.Anything, 1).Return(product.Product{}, &product.ErrNotFound{ID: 1})
+			},
+			expectedErr:   "product '1' not found",
+			expectedStats: cached.Stats{Misses: 1},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			c := cacheMocks.NewCache(t)
+			if tc.setupCache != nil {
+				tc.setupCache(c)
+			}
+
+			next := prodMocks.NewReader(t)
+			if tc.setupNext != nil {
+				tc.setupNext(next)
+			}
+
+			repo := cached.NewRepository(next, c, opts)
+
+			// Action
+			res, err := repo.Find(context.Background(), 1)
+
+			// Assert
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedResult, res)
+			}
+
+			assert.Equal(t, tc.expectedStats, repo.Stats())
+		})
+	}
+}
+
+func TestRepository_FindMultiple(t *testing.T) {
+	t.Parallel()
+
+	p1 := fixture.AnyProduct().WithID(1).Build()
+	p2 := fixture.AnyProduct().WithID(2).Build()
+
+	data1, err := json.Marshal(p1)
+	require.NoError(t, err)
+
+	data2, err := json.Marshal(p2)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		about          string
+		ids            []int
+		setupCache     func(m *cacheMocks.Cache)
+		setupNext      func(m *prodMocks.Reader)
+		expectedErr    string
+		expectedResult []product.Product
+		expectedStats  cached.Stats
+	}{
+		{
+			about: "when all the products are on cache",
+			ids:   []int{2, 1},
+			setupCache: func(m *cacheMocks.Cache) {
+				m.On("Get", mock.Anything, "products:1").Return(data1, nil)
+				m.On("Get", mock.Anything, "products:2").Return(data2, nil)
+			},
+			expectedResult: []product.Product{p2, p1},
+			expectedStats:  cached.Stats{Hits: 2},
+		},
+		{
+			about: "when some products aren't on cache only they are read and saved",
+			ids:   []int{2, 1, 3},
+			setupCache: func(m *cacheMocks.Cache) {
+				m.On("Get", mock.Anything, "products:catalog").Return(nil, errors.New("data not found"))
+				m.On("Get", mock.Anything, "products:2").Return(data2, nil)
+				m.On("Get", mock.Anything, "products:1").Return(nil, errors.New("data not found"))
+				m.On("Get", mock.Anything, "products:3").Return(nil, errors.New("data not found"))
+				m.On("Set", mock.Anything, "products:1", data1, time.Minute).Return(nil)
+			},
+			setupNext: func(m *prodMocks.Reader) {
+				m.On("FindMultiple", mock.Anything, []int{1, 3}).
+					Return([]product.Product{p1}, &product.ErrProductsNotFound{IDs: []int{3}})
+			},
+			expectedErr:    "products not found: [3]",
+			expectedResult: []product.Product{p2, p1},
+			expectedStats:  cached.Stats{Hits: 1, Misses: 2},
+		},
+		{
+			about: "when reading the products fails",
+			ids:   []int{1},
+			setupCache: func(m *cacheMocks.Cache) {
+				m.On("Get", mock.Anything, "products:catalog").Return(nil, errors.New("data not found"))
+				m.On("Get", mock.Anything, "products:1").Return(nil, errors.New("data not found"))
+			},
+			setupNext: func(m *prodMocks.Reader) {
+				m.On("FindMultiple", mock.Anything, []int{1}).Return(nil, errors.New("service down"))
+			},
+			expectedErr:   "service down",
+			expectedStats: cached.Stats{Misses: 1},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			c := cacheMocks.NewCache(t)
+			if tc.setupCache != nil {
+				tc.setupCache(c)
+			}
+
+			next := prodMocks.NewReader(t)
+			if tc.setupNext != nil {
+				tc.setupNext(next)
+			}
+
+			repo := cached.NewRepository(next, c, opts)
+
+			// Action
+			res, err := repo.FindMultiple(context.Background(), tc.ids)
+
+			// Assert
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tc.expectedResult, res)
+			assert.Equal(t, tc.expectedStats, repo.Stats())
+		})
+	}
+}
+
+func TestRepository_FindMultiple_Catalog(t *testing.T) {
+	t.Parallel()
+
+	p1 := fixture.AnyProduct().WithID(1).Build()
+	p2 := fixture.AnyProduct().WithID(2).Build()
+	p3 := fixture.AnyProduct().WithID(3).Build()
+
+	data1, err := json.Marshal(p1)
+	require.NoError(t, err)
+
+	data2, err := json.Marshal(p2)
+	require.NoError(t, err)
+
+	catalog, err := json.Marshal([]product.Product{p1, p2, p3})
+	require.NoError(t, err)
+
+	t.Run("when the missing products are on the cached catalog the next repository isn't asked", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		c := cacheMocks.NewCache(t)
+		c.On("Get", mock.Anything, "products:1").Return(data1, nil)
+		c.On("Get", mock.Anything, "products:3").Return(nil, errors.New("data not found"))
+		c.On("Get", mock.Anything, "products:4").Return(nil, errors.New("data not found"))
+		c.On("Get", mock.Anything, "products:catalog").Return(catalog, nil)
+
+		next := prodMocks.NewReader(t)
+		next.On("FindMultiple", mock.Anything, []int{4}).
+			Return([]product.Product{}, &product.ErrProductsNotFound{IDs: []int{4}})
+
+		repo := cached.NewRepository(next, c, opts)
+
+		// Action
+		res, err := repo.FindMultiple(context.Background(), []int{3, 1, 4})
+
+		// Assert
+		assert.EqualError(t, err, "products not found: [4]")
+		assert.Equal(t, []product.Product{p3, p1}, res)
+		assert.Equal(t, cached.Stats{Hits: 2, Misses: 1}, repo.Stats())
+	})
+
+	t.Run("when the next repository fetches the catalog it is read through all and cached", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		c := cacheMocks.NewCache(t)
+		c.On("Get", mock.Anything, "products:2").Return(nil, errors.New("data not found"))
+		c.On("Get", mock.Anything, "products:catalog").Return(nil, errors.New("data not found"))
+		c.On("Set", mock.Anything, "products:catalog", catalog, time.Hour).Return(nil)
+		c.On("Set", mock.Anything, "products:2", data2, time.Minute).Return(nil)
+
+		next := prodMocks.NewReader(t)
+		next.On("All", mock.Anything).Return([]product.Product{p1, p2, p3}, nil).Once()
+
+		repo := cached.NewRepository(next, c, cached.Options{
+			ProductDuration:    time.Minute,
+			CatalogDuration:    time.Hour,
+			NextFetchesCatalog: true,
+		})
+
+		// Action
+		res, err := repo.FindMultiple(context.Background(), []int{2})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []product.Product{p2}, res)
+		assert.Equal(t, cached.Stats{Misses: 1}, repo.Stats())
+	})
+}
+
+func TestRepository_Stale(t *testing.T) {
+	t.Parallel()
+
+	p1 := fixture.AnyProduct().WithID(1).Build()
+	p2 := fixture.AnyProduct().WithID(2).Build()
+
+	data1, err := json.Marshal(p1)
+	require.NoError(t, err)
+
+	data2, err := json.Marshal(p2)
+	require.NoError(t, err)
+
+	stale1, stale2 := p1, p2
+	stale1.Stale, stale2.Stale = true, true
+
+	staleOpts := cached.Options{ProductDuration: time.Minute, StaleDuration: time.Hour}
+
+	testCases := []struct {
+		about          string
+		ids            []int
+		setupCache     func(m *cacheMocks.Cache)
+		setupNext      func(m *prodMocks.Reader)
+		expectedErr    string
+		expectedResult []product.Product
+		expectedStats  cached.Stats
+	}{
+		{
+			about: "when the products are read the last known good copy is saved",
+			ids:   []int{1},
+			setupCache: func(m *cacheMocks.Cache) {
+				m.On("Get", mock.Anything, "products:catalog").Return(nil, errors.New("data not found"))
+				m.On("Get", mock.Anything, "products:1").Return(nil, errors.New("data not found"))
+				m.On("Set", mock.Anything, "products:1", data1, time.Minute).Return(nil)
+				m.On("Set", mock.Anything, "products:stale:1", data1, time.Hour).Return(nil)
+			},
+			setupNext: func(m *prodMocks.Reader) {
+				m.On("FindMultiple", mock.Anything, []int{1}).Return([]product.Product{p1}, nil)
+			},
+			expectedResult: []product.Product{p1},
+			expectedStats:  cached.Stats{Misses: 1},
+		},
+		{
+			about: "when the next repository fails the stale copies are served",
+			ids:   []int{2, 1},
+			setupCache: func(m *cacheMocks.Cache) {
+				m.On("Get", mock.Anything, "products:catalog").Return(nil, errors.New("data not found"))
+				m.On("Get", mock.Anything, "products:2").Return(data2, nil)
+				m.On("Get", mock.Anything, "products:1").Return(nil, errors.New("data not found"))
+				m.On("Get", mock.Anything, "products:stale:1").Return(data1, nil)
+			},
+			setupNext: func(m *prodMocks.Reader) {
+				m.On("FindMultiple", mock.Anything, []int{1}).Return(nil, errors.New("circuit open"))
+			},
+			expectedResult: []product.Product{p2, stale1},
+			expectedStats:  cached.Stats{Hits: 1, Misses: 1, Stale: 1},
+		},
+		{
+			about: "when some product has no stale copy it is reported as not found",
+			ids:   []int{1, 2},
+			setupCache: func(m *cacheMocks.Cache) {
+				m.On("Get", mock.Anything, "products:catalog").Return(nil, errors.New("data not found"))
+				m.On("Get", mock.Anything, "products:1").Return(nil, errors.New("data not found"))
+				m.On("Get", mock.Anything, "products:2").Return(nil, errors.New("data not found"))
+				m.On("Get", mock.Anything, "products:stale:1").Return(data1, nil)
+				m.On("Get", mock.Anything, "products:stale:2").Return(nil, errors.New("data not found"))
+			},
+			setupNext: func(m *prodMocks.Reader) {
+				m.On("FindMultiple", mock.Anything, []int{1, 2}).Return(nil, errors.New("circuit open"))
+			},
+			expectedErr:    "products not found: [2]",
+			expectedResult: []product.Product{stale1},
+			expectedStats:  cached.Stats{Misses: 2, Stale: 1},
+		},
+		{
+			about: "when no product has a stale copy the error is returned",
+			ids:   []int{1, 2},
+			setupCache: func(m *cacheMocks.Cache) {
+				m.On("Get", mock.Anything, "products:catalog").Return(nil, errors.New("data not found"))
+				m.On("Get", mock.Anything, "products:1").Return(nil, errors.New("data not found"))
+				m.On("Get", mock.Anything, "products:2").Return(nil, errors.New("data not found"))
+				m.On("Get", mock.Anything, "products:stale:1").Return(nil, errors.New("data not found"))
+				m.On("Get", mock.Anything, "products:stale:2").Return(nil, errors.New("data not found"))
+			},
+			setupNext: func(m *prodMocks.Reader) {
+				m.On("FindMultiple", mock.Anything, []int{1, 2}).Return(nil, errors.New("circuit open"))
+			},
+			expectedErr:   "circuit open",
+			expectedStats: cached.Stats{Misses: 2},
+		},
+		{
+			about: "when the products aren't found the stale copies aren't served",
+			ids:   []int{1, 2},
+			setupCache: func(m *cacheMocks.Cache) {
+				m.On("Get", mock.Anything, "products:catalog").Return(nil, errors.New("data not found"))
+				m.On("Get", mock.Anything, "products:1").Return(nil, errors.New("data not found"))
+				m.On("Get", mock.Anything, "products:2").Return(nil, errors.New("data not found"))
+				m.On("Set", mock.Anything, "products:2", data2, time.Minute).Return(nil)
+				m.On("Set", mock.Anything, "products:stale:2", data2, time.Hour).Return(nil)
+			},
+			setupNext: func(m *prodMocks.Reader) {
+				m.On("FindMultiple", mock.Anything, []int{1, 2}).
+					Return([]product.Product{p2}, &product.ErrProductsNotFound{IDs: []int{1}})
+			},
+			expectedErr:    "products not found: [1]",
+			expectedResult: []product.Product{p2},
+			expectedStats:  cached.Stats{Misses: 2},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			c := cacheMocks.NewCache(t)
+			tc.setupCache(c)
+
+			next := prodMocks.NewReader(t)
+			tc.setupNext(next)
+
+			repo := cached.NewRepository(next, c, staleOpts)
+
+			// Action
+			res, err := repo.FindMultiple(context.Background(), tc.ids)
+
+			// Assert
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tc.expectedResult, res)
+			assert.Equal(t, tc.expectedStats, repo.Stats())
+		})
+	}
+
+	t.Run("when finding a single product fails the stale copy is served", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		c := cacheMocks.NewCache(t)
+		c.On("Get", mock.Anything, "products:1").Return(nil, errors.New("data not found"))
+		c.On("Get", mock.Anything, "products:stale:1").Return(data1, nil)
+
+		next := prodMocks.NewReader(t)
+		next.On("Find", mock.Anything, 1).Return(product.Product{}, errors.New("service down"))
+
+		repo := cached.NewRepository(next, c, staleOpts)
+
+		// Action
+		res, err := repo.Find(context.Background(), 1)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, stale1, res)
+		assert.Equal(t, cached.Stats{Misses: 1, Stale: 1}, repo.Stats())
+	})
+
+	t.Run("when reading the catalog fails the stale copy is served", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		catalog, err := json.Marshal([]product.Product{p1, p2})
+		require.NoError(t, err)
+
+		c := cacheMocks.NewCache(t)
+		c.On("Get", mock.Anything, "products:catalog").Return(nil, errors.New("data not found")).Once()
+		c.On("Set", mock.Anything, "products:catalog", catalog, time.Duration(0)).Return(nil).Once()
+		c.On("Set", mock.Anything, "products:stale:catalog", catalog, time.Hour).Return(nil).Once()
+		c.On("Get", mock.Anything, "products:catalog").Return(nil, errors.New("data not found")).Once()
+		c.On("Get", mock.Anything, "products:stale:catalog").Return(catalog, nil).Once()
+
+		next := prodMocks.NewReader(t)
+		next.On("All", mock.Anything).Return([]product.Product{p1, p2}, nil).Once()
+		next.On("All", mock.Anything).Return(nil, errors.New("service down")).Once()
+
+		repo := cached.NewRepository(next, c, staleOpts)
+
+		_, err = repo.All(context.Background())
+		require.NoError(t, err)
+
+		// Action
+		res, err := repo.All(context.Background())
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []product.Product{stale1, stale2}, res)
+		assert.Equal(t, cached.Stats{Misses: 2, Stale: 2}, repo.Stats())
+	})
+}
+
+func TestRepository_Revalidate(t *testing.T) {
+	t.Parallel()
+
+	p1 := fixture.AnyProduct().WithID(1).Build()
+	p2 := fixture.AnyProduct().WithID(2).Build()
+
+	data1, err := json.Marshal(p1)
+	require.NoError(t, err)
+
+	data2, err := json.Marshal(p2)
+	require.NoError(t, err)
+
+	revalidateOpts := cached.Options{ProductDuration: time.Minute, RevalidateAfter: time.Nanosecond}
+
+	t.Run("when nothing was read there is nothing to revalidate", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		repo := cached.NewRepository(prodMocks.NewReader(t), cacheMocks.NewCache(t), revalidateOpts)
+
+		// Action
+		n, err := repo.Revalidate(context.Background())
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 0, n)
+	})
+
+	t.Run("when the products get old they are read again and the removed ones are dropped", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		c := cacheMocks.NewCache(t)
+		c.On("Get", mock.Anything, mock.Anything).Return(nil, errors.New("data not found"))
+		c.On("Set", mock.Anything, "products:1", data1, time.Minute).Return(nil)
+		c.On("Set", mock.Anything, "products:2", data2, time.Minute).Return(nil)
+
+		next := prodMocks.NewReader(t)
+		next.On("FindMultiple", mock.Anything, []int{1, 2}).Return([]product.Product{p1, p2}, nil).Once()
+		next.On("FindMultiple", mock.Anything, mock.Anything).
+			Return([]product.Product{p1}, &product.ErrProductsNotFound{IDs: []int{2}}).Once()
+		next.On("FindMultiple", mock.Anything, []int{1}).Return([]product.Product{p1}, nil).Once()
+
+		repo := cached.NewRepository(next, c, revalidateOpts)
+
+		_, err := repo.FindMultiple(context.Background(), []int{1, 2})
+		require.NoError(t, err)
+
+		// Action
+		first, firstErr := repo.Revalidate(context.Background())
+		second, secondErr := repo.Revalidate(context.Background())
+
+		// Assert
+		assert.NoError(t, firstErr)
+		assert.Equal(t, 1, first)
+		assert.NoError(t, secondErr)
+		assert.Equal(t, 1, second)
+		assert.ElementsMatch(t, []int{1, 2}, next.Calls[1].Arguments.Get(1))
+	})
+
+	t.Run("when the next repository fails the error is returned", func(t *testing.T) {
+		t.Parallel()
+
+		// Arrange
+		c := cacheMocks.NewCache(t)
+		c.On("Get", mock.Anything, "products:1").Return(nil, errors.New("data not found"))
+		c.On("Set", mock.Anything, "products:1", data1, time.Minute).Return(nil)
+
+		next := prodMocks.NewReader(t)
+		next.On("Find", mock.Anything, 1).Return(p1, nil)
+		next.On("FindMultiple", mock.Anything, []int{1}).Return(nil, errors.New("service down"))
+
+		repo := cached.NewRepository(next, c, revalidateOpts)
+
+		_, err := repo.Find(context.Background(), 1)
+		require.NoError(t, err)
+
+		// Action
+		n, err := repo.Revalidate(context.Background())
+
+		// Assert
+		assert.EqualError(t, err, "service down")
+		assert.Equal(t, 0, n)
+	})
+}
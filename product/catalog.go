@@ -0,0 +1,31 @@
+package product
+
+// Pick returns the products of the catalog with the given ids, in the same order,
+// the missing ids are reported through ErrProductsNotFound
+func Pick(catalog []Product, ids []int) ([]Product, error) {
+	byID := make(map[int]Product, len(catalog))
+	for _, p := range catalog {
+		byID[p.ID] = p
+	}
+
+	notFound := []int{}
+
+	pp := make([]Product, 0, len(ids))
+	for _, id := range ids {
+		p, ok := byID[id]
+		if !ok {
+			notFound = append(notFound, id)
+			continue
+		}
+
+		pp = append(pp, p)
+	}
+
+	if len(notFound) > 0 {
+		return pp, &ErrProductsNotFound{
+			IDs: notFound,
+		}
+	}
+
+	return pp, nil
+}
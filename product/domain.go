@@ -8,6 +8,8 @@ type Product struct {
 	Category    string  `json:"category"`
 	ImageUrl    string  `json:"image"`
 	Rating      Rating  `json:"rating"`
+	// Stale is set when the upstream failed and the last known good copy of the product was served
+	Stale bool `json:"stale,omitempty"`
 }
 
 type Rating struct {
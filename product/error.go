@@ -1,9 +1,15 @@
 package product
 
 import (
+	"errors"
 	"fmt"
 )
 
+var (
+	ErrSyncNotFound = errors.New("product sync not found")
+	ErrEmptyCatalog = errors.New("empty catalog")
+)
+
 type ErrNotFound struct {
 	ID int
 }
@@ -14,6 +20,8 @@ func (e *ErrNotFound) Error() string {
 
 type ErrProductsNotFound struct {
 	IDs []int
+	// Cause is the failure of the source when the products couldn't be read, rather than being missing
+	Cause error
 }
 
 func (e *ErrProductsNotFound) Error() string {
@@ -3,35 +3,71 @@ package fakestoreapi
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
 	"net/url"
-	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/uesleicarvalhoo/aiqfome/internal/infra/requester"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/domainerror"
 	"github.com/uesleicarvalhoo/aiqfome/product"
+	"golang.org/x/sync/errgroup"
+)
+
+// Strategy is how FindMultiple reads the products
+type Strategy string
+
+const (
+	// StrategyFetchAll downloads the whole catalog and picks the products from it, fine while the catalog is small
+	StrategyFetchAll Strategy = "all"
+	// StrategyPerID finds each product in parallel
+	StrategyPerID Strategy = "per_id"
 )
 
 type Options struct {
 	BaseUrl         string
 	GetAllEndpoint  string
 	GetByIdEndpoint string
+	// FindMultipleStrategy defaults to StrategyFetchAll
+	FindMultipleStrategy Strategy
+	// MaxConcurrency is how many products StrategyPerID finds at a time, at least 1
+	MaxConcurrency int
+	// FindMultipleTimeout is the deadline of all the requests of StrategyPerID, 0 means no deadline
+	FindMultipleTimeout time.Duration
 }
 
 type repository struct {
-	baseUrl         string
-	requester       requester.Requester
-	getAllEndpoint  string
-	getByIdEndpoint string
+	baseUrl             string
+	requester           requester.Requester
+	getAllEndpoint      string
+	getByIdEndpoint     string
+	strategy            Strategy
+	maxConcurrency      int
+	findMultipleTimeout time.Duration
 }
 
-func NewRepository(rq requester.Requester, opts Options) product.Repository {
-	return &repository{
-		baseUrl:         opts.BaseUrl,
-		getAllEndpoint:  opts.GetAllEndpoint,
-		getByIdEndpoint: opts.GetByIdEndpoint,
-		requester:       rq,
+// NewRepository fails on an unknown FindMultipleStrategy, so a typo doesn't fall back to downloading the catalog
+func NewRepository(rq requester.Requester, opts Options) (product.Repository, error) {
+	switch opts.FindMultipleStrategy {
+	case "":
+		opts.FindMultipleStrategy = StrategyFetchAll
+	case StrategyFetchAll, StrategyPerID:
+	default:
+		return nil, fmt.Errorf("unknown find multiple strategy: %q", opts.FindMultipleStrategy)
 	}
+
+	return &repository{
+		baseUrl:             opts.BaseUrl,
+		getAllEndpoint:      opts.GetAllEndpoint,
+		getByIdEndpoint:     opts.GetByIdEndpoint,
+		requester:           rq,
+		strategy:            opts.FindMultipleStrategy,
+		maxConcurrency:      max(opts.MaxConcurrency, 1),
+		findMultipleTimeout: opts.FindMultipleTimeout,
+	}, nil
 }
 
 func (r *repository) Find(ctx context.Context, id int) (product.Product, error) {
@@ -40,8 +76,13 @@ func (r *repository) Find(ctx context.Context, id int) (product.Product, error)
 		return product.Product{}, err
 	}
 
-	res, _, err := r.requester.Get(ctx, endpoint, nil)
+	res, err := r.get(ctx, endpoint)
 	if err != nil {
+		var sErr *requester.ErrUnexpectedStatus
+		if errors.As(err, &sErr) && sErr.StatusCode == http.StatusNotFound {
+			return product.Product{}, &product.ErrNotFound{ID: id}
+		}
+
 		return product.Product{}, err
 	}
 
@@ -58,12 +99,82 @@ func (r *repository) Find(ctx context.Context, id int) (product.Product, error)
 }
 
 func (r *repository) FindMultiple(ctx context.Context, ids []int) ([]product.Product, error) {
+	var (
+		found []product.Product
+		err   error
+	)
+
+	switch r.strategy {
+	case StrategyPerID:
+		found, err = r.findEach(ctx, ids)
+	case StrategyFetchAll:
+		found, err = r.All(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return product.Pick(found, ids)
+}
+
+// findEach finds the products with up to maxConcurrency requests at a time, the missing ones are left out
+func (r *repository) findEach(ctx context.Context, ids []int) ([]product.Product, error) {
+	if r.findMultipleTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.findMultipleTimeout)
+		defer cancel()
+	}
+
+	unique := make([]int, 0, len(ids))
+	seen := make(map[int]bool, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+
+	found := make([]*product.Product, len(unique))
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(r.maxConcurrency)
+
+	for i, id := range unique {
+		g.Go(func() error {
+			p, err := r.Find(gctx, id)
+			if err != nil {
+				if _, ok := err.(*product.ErrNotFound); ok {
+					return nil
+				}
+				return err
+			}
+
+			found[i] = &p
+			return nil
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	pp := make([]product.Product, 0, len(found))
+	for _, p := range found {
+		if p != nil {
+			pp = append(pp, *p)
+		}
+	}
+
+	return pp, nil
+}
+
+func (r *repository) All(ctx context.Context) ([]product.Product, error) {
 	endpoint, err := url.JoinPath(r.baseUrl, r.getAllEndpoint)
 	if err != nil {
 		return []product.Product{}, err
 	}
 
-	res, _, err := r.requester.Get(ctx, endpoint, nil)
+	res, err := r.get(ctx, endpoint)
 	if err != nil {
 		return nil, err
 	}
@@ -73,26 +184,31 @@ func (r *repository) FindMultiple(ctx context.Context, ids []int) ([]product.Pro
 		return nil, err
 	}
 
-	notFound := []int{}
+	return found, nil
+}
 
-	pp := make([]product.Product, 0, len(ids))
-	for _, id := range ids {
-		idx := slices.IndexFunc(found, func(p product.Product) bool {
-			return p.ID == id
+// get maps the open circuit and the error answers of the store to dependency errors, so callers know it is worth trying again later
+func (r *repository) get(ctx context.Context, endpoint string) ([]byte, error) {
+	res, status, err := r.requester.Get(ctx, endpoint, nil)
+	if cErr, ok := err.(*requester.ErrCircuitOpen); ok {
+		return nil, domainerror.Wrap(err, domainerror.DependecyError, "serviço de produtos indisponível, tente novamente mais tarde", map[string]any{
+			"host": cErr.Host,
 		})
-		if idx < 0 {
-			notFound = append(notFound, id)
-			continue
-		}
+	}
 
-		pp = append(pp, found[idx])
+	if _, ok := err.(*requester.ErrUnexpectedStatus); err != nil && !ok {
+		return nil, err
 	}
 
-	if len(notFound) > 0 {
-		return []product.Product{}, &product.ErrProductsNotFound{
-			IDs: notFound,
+	if status < http.StatusOK || status >= http.StatusMultipleChoices {
+		if err == nil {
+			err = &requester.ErrUnexpectedStatus{URL: endpoint, StatusCode: status}
 		}
+
+		return nil, domainerror.Wrap(err, domainerror.DependecyError, "serviço de produtos respondeu com erro", map[string]any{
+			"status": status,
+		})
 	}
 
-	return pp, nil
+	return res, nil
 }
@@ -0,0 +1,304 @@
+package fakestoreapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uesleicarvalhoo/aiqfome/internal/infra/requester"
+	rqMocks "github.com/uesleicarvalhoo/aiqfome/internal/infra/requester/mocks"
+	"github.com/uesleicarvalhoo/aiqfome/pkg/domainerror"
+	"github.com/uesleicarvalhoo/aiqfome/product"
+	"github.com/uesleicarvalhoo/aiqfome/product/fakestoreapi"
+	"github.com/uesleicarvalhoo/aiqfome/product/fixture"
+)
+
+// fakeStore answers the requests of the products endpoint and keeps how many of them ran at the same time
+type fakeStore struct {
+	products map[int]product.Product
+	delay    time.Duration
+	fail     bool
+
+	mu          sync.Mutex
+	inFlight    int
+	maxInFlight int
+	calls       atomic.Int64
+}
+
+func (s *fakeStore) Get(ctx context.Context, url string, _ map[string]string) ([]byte, int, error) {
+	s.calls.Add(1)
+
+	s.mu.Lock()
+	s.inFlight++
+	s.maxInFlight = max(s.maxInFlight, s.inFlight)
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		s.inFlight--
+		s.mu.Unlock()
+	}()
+
+	select {
+	case <-time.After(s.delay):
+	case <-ctx.Done():
+		return nil, 0, ctx.Err()
+	}
+
+	if s.fail {
+		return nil, 500, errors.New("service down")
+	}
+
+	id, err := strconv.Atoi(url[strings.LastIndex(url, "/")+1:])
+	if err != nil {
+		return nil, 400, err
+	}
+
+	p, ok := s.products[id]
+	if !ok {
+		return nil, 200, nil
+	}
+
+	data, err := json.Marshal(p)
+	return data, 200, err
+}
+
+func TestRepository_FindMultiple_PerID(t *testing.T) {
+	t.Parallel()
+
+	p1 := fixture.AnyProduct().WithID(1).Build()
+	p2 := fixture.AnyProduct().WithID(2).Build()
+	p3 := fixture.AnyProduct().WithID(3).Build()
+
+	catalog := map[int]product.Product{1: p1, 2: p2, 3: p3}
+
+	testCases := []struct {
+		about               string
+		ids                 []int
+		store               *fakeStore
+		opts                fakestoreapi.Options
+		expectedErr         string
+		expectedResult      []product.Product
+		expectedCalls       int64
+		expectedMaxInFlight int
+	}{
+		{
+			about:               "when all the products are found they keep the requested order",
+			ids:                 []int{3, 1, 2},
+			store:               &fakeStore{products: catalog},
+			expectedResult:      []product.Product{p3, p1, p2},
+			expectedCalls:       3,
+			expectedMaxInFlight: 1,
+		},
+		{
+			about:               "when an id is repeated it is only requested once",
+			ids:                 []int{2, 2, 1},
+			store:               &fakeStore{products: catalog},
+			expectedResult:      []product.Product{p2, p2, p1},
+			expectedCalls:       2,
+			expectedMaxInFlight: 1,
+		},
+		{
+			about:               "when some products aren't found they are aggregated",
+			ids:                 []int{4, 1, 5},
+			store:               &fakeStore{products: catalog},
+			expectedErr:         "products not found: [4 5]",
+			expectedResult:      []product.Product{p1},
+			expectedCalls:       3,
+			expectedMaxInFlight: 1,
+		},
+		{
+			about:       "when the store fails",
+			ids:         []int{1, 2},
+			store:       &fakeStore{products: catalog, fail: true},
+			expectedErr: "service down",
+		},
+		{
+			about:               "when the requests are limited by the max concurrency",
+			ids:                 []int{1, 2, 3, 1, 2, 3},
+			store:               &fakeStore{products: catalog, delay: 20 * time.Millisecond},
+			opts:                fakestoreapi.Options{MaxConcurrency: 2},
+			expectedResult:      []product.Product{p1, p2, p3, p1, p2, p3},
+			expectedCalls:       3,
+			expectedMaxInFlight: 2,
+		},
+		{
+			about:       "when the deadline is exceeded",
+			ids:         []int{1, 2},
+			store:       &fakeStore{products: catalog, delay: time.Second},
+			opts:        fakestoreapi.Options{FindMultipleTimeout: 10 * time.Millisecond},
+			expectedErr: context.DeadlineExceeded.Error(),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			opts := tc.opts
+			opts.BaseUrl = "http://fakestore"
+			opts.GetByIdEndpoint = "/products/{id}"
+			opts.FindMultipleStrategy = fakestoreapi.StrategyPerID
+
+			repo, err := fakestoreapi.NewRepository(tc.store, opts)
+			require.NoError(t, err)
+
+			// Action
+			res, err := repo.FindMultiple(context.Background(), tc.ids)
+
+			// Assert
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, tc.expectedResult, res)
+
+			if tc.expectedCalls > 0 {
+				assert.Equal(t, tc.expectedCalls, tc.store.calls.Load())
+				assert.LessOrEqual(t, tc.store.maxInFlight, tc.expectedMaxInFlight)
+			}
+		})
+	}
+}
+
+func TestRepository_FindMultiple_FetchAll(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	catalog := []product.Product{
+		fixture.AnyProduct().WithID(1).Build(),
+		fixture.AnyProduct().WithID(2).Build(),
+	}
+	data, err := json.Marshal(catalog)
+	require.NoError(t, err)
+
+	rq := rqMocks.NewRequester(t)
+	rq.On("Get", mock.Anything, "http://fakestore/products", mock.Anything).Return(data, 200, nil).Once()
+
+	repo, err := fakestoreapi.NewRepository(rq, fakestoreapi.Options{
+		BaseUrl:        "http://fakestore",
+		GetAllEndpoint: "/products",
+	})
+	require.NoError(t, err)
+
+	// Action
+	res, err := repo.FindMultiple(context.Background(), []int{2, 3, 1})
+
+	// Assert
+	assert.EqualError(t, err, "products not found: [3]")
+	assert.Equal(t, []product.Product{catalog[1], catalog[0]}, res)
+}
+
+func TestRepository_Find_CircuitOpen(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	rq := rqMocks.NewRequester(t)
+	rq.On("Get", mock.Anything, "http://fakestore/products/1", mock.Anything).
+		Return(nil, 0, &requester.ErrCircuitOpen{Host: "fakestore"})
+
+	repo, err := fakestoreapi.NewRepository(rq, fakestoreapi.Options{
+		BaseUrl:         "http://fakestore",
+		GetByIdEndpoint: "/products/{id}",
+	})
+	require.NoError(t, err)
+
+	// Action
+	_, err = repo.Find(context.Background(), 1)
+
+	// Assert
+	var dErr *domainerror.Error
+	require.ErrorAs(t, err, &dErr)
+	assert.Equal(t, domainerror.DependecyError, dErr.Code)
+	assert.Equal(t, "serviço de produtos indisponível, tente novamente mais tarde", dErr.Message)
+	assert.Equal(t, map[string]any{"host": "fakestore"}, dErr.Details)
+}
+
+func TestRepository_Find_Status(t *testing.T) {
+	t.Parallel()
+
+	url := "http://fakestore/products/1"
+
+	testCases := []struct {
+		about          string
+		status         int
+		err            error
+		expectedErr    string
+		expectedStatus int
+	}{
+		{
+			about:       "when the store answers not found",
+			status:      404,
+			err:         &requester.ErrUnexpectedStatus{URL: url, StatusCode: 404},
+			expectedErr: "product '1' not found",
+		},
+		{
+			about:          "when the store answers with an error",
+			status:         502,
+			err:            &requester.ErrUnexpectedStatus{URL: url, StatusCode: 502},
+			expectedErr:    "[AQF004] serviço de produtos respondeu com erro",
+			expectedStatus: 502,
+		},
+		{
+			about:          "when the store answers with an error page without failing",
+			status:         500,
+			expectedErr:    "[AQF004] serviço de produtos respondeu com erro",
+			expectedStatus: 500,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.about, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			rq := rqMocks.NewRequester(t)
+			rq.On("Get", mock.Anything, url, mock.Anything).Return([]byte("<html>error</html>"), tc.status, tc.err)
+
+			repo, err := fakestoreapi.NewRepository(rq, fakestoreapi.Options{
+				BaseUrl:         "http://fakestore",
+				GetByIdEndpoint: "/products/{id}",
+			})
+			require.NoError(t, err)
+
+			// Action
+			_, err = repo.Find(context.Background(), 1)
+
+			// Assert
+			assert.ErrorContains(t, err, tc.expectedErr)
+
+			if tc.expectedStatus != 0 {
+				var dErr *domainerror.Error
+				require.ErrorAs(t, err, &dErr)
+				assert.Equal(t, map[string]any{"status": tc.expectedStatus}, dErr.Details)
+			}
+		})
+	}
+}
+
+func TestNewRepository_UnknownStrategy(t *testing.T) {
+	t.Parallel()
+
+	// Action
+	_, err := fakestoreapi.NewRepository(rqMocks.NewRequester(t), fakestoreapi.Options{
+		FindMultipleStrategy: "perid",
+	})
+
+	// Assert
+	assert.EqualError(t, err, `unknown find multiple strategy: "perid"`)
+}
@@ -0,0 +1,200 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	product "github.com/uesleicarvalhoo/aiqfome/product"
+
+	time "time"
+)
+
+// Mirror is an autogenerated mock type for the Mirror type
+type Mirror struct {
+	mock.Mock
+}
+
+// All provides a mock function with given fields: ctx
+func (_m *Mirror) All(ctx context.Context) ([]product.Product, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for All")
+	}
+
+	var r0 []product.Product
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]product.Product, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []product.Product); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]product.Product)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Find provides a mock function with given fields: ctx, id
+func (_m *Mirror) Find(ctx context.Context, id int) (product.Product, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Find")
+	}
+
+	var r0 product.Product
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (product.Product, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) product.Product); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(product.Product)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindMultiple provides a mock function with given fields: ctx, ids
+func (_m *Mirror) FindMultiple(ctx context.Context, ids []int) ([]product.Product, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindMultiple")
+	}
+
+	var r0 []product.Product
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int) ([]product.Product, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int) []product.Product); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]product.Product)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LastSync provides a mock function with given fields: ctx
+func (_m *Mirror) LastSync(ctx context.Context) (product.Sync, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LastSync")
+	}
+
+	var r0 product.Sync
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (product.Sync, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) product.Sync); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(product.Sync)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SaveSync provides a mock function with given fields: ctx, s
+func (_m *Mirror) SaveSync(ctx context.Context, s product.Sync) error {
+	ret := _m.Called(ctx, s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveSync")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, product.Sync) error); ok {
+		r0 = rf(ctx, s)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Sync provides a mock function with given fields: ctx, catalog, at
+func (_m *Mirror) Sync(ctx context.Context, catalog []product.Product, at time.Time) (int, int, error) {
+	ret := _m.Called(ctx, catalog, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Sync")
+	}
+
+	var r0 int
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, []product.Product, time.Time) (int, int, error)); ok {
+		return rf(ctx, catalog, at)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []product.Product, time.Time) int); ok {
+		r0 = rf(ctx, catalog, at)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []product.Product, time.Time) int); ok {
+		r1 = rf(ctx, catalog, at)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, []product.Product, time.Time) error); ok {
+		r2 = rf(ctx, catalog, at)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// NewMirror creates a new instance of Mirror. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMirror(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Mirror {
+	mock := &Mirror{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -14,6 +14,36 @@ type Reader struct {
 	mock.Mock
 }
 
+// All provides a mock function with given fields: ctx
+func (_m *Reader) All(ctx context.Context) ([]product.Product, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for All")
+	}
+
+	var r0 []product.Product
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]product.Product, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []product.Product); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]product.Product)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Find provides a mock function with given fields: ctx, id
 func (_m *Reader) Find(ctx context.Context, id int) (product.Product, error) {
 	ret := _m.Called(ctx, id)
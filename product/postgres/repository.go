@@ -0,0 +1,228 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/jackc/pgtype"
+	"github.com/uesleicarvalhoo/aiqfome/product"
+)
+
+const selectProducts = `
+	SELECT
+		id, title, price, description, category, image_url, rating_rate, rating_count
+	FROM products
+	`
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) product.Mirror {
+	return &repository{
+		db: db,
+	}
+}
+
+func (r *repository) Find(ctx context.Context, id int) (product.Product, error) {
+	query := selectProducts + `WHERE id = $1 AND removed_at IS NULL`
+
+	p, err := scanProduct(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return product.Product{}, &product.ErrNotFound{ID: id}
+		}
+		return product.Product{}, err
+	}
+
+	return p, nil
+}
+
+func (r *repository) FindMultiple(ctx context.Context, ids []int) ([]product.Product, error) {
+	query := selectProducts + `WHERE id = ANY($1) AND removed_at IS NULL`
+
+	found, err := r.query(ctx, query, int4Array(ids))
+	if err != nil {
+		return nil, err
+	}
+
+	return product.Pick(found, ids)
+}
+
+func (r *repository) All(ctx context.Context) ([]product.Product, error) {
+	query := selectProducts + `WHERE removed_at IS NULL ORDER BY id`
+
+	return r.query(ctx, query)
+}
+
+func (r *repository) Sync(ctx context.Context, catalog []product.Product, at time.Time) (int, int, error) {
+	upsert := `
+	INSERT INTO products(
+		id, title, price, description, category, image_url, rating_rate, rating_count, synced_at
+	) VALUES (
+		$1, $2, $3, $4, $5, $6, $7, $8, $9
+	)
+	ON CONFLICT (id) DO UPDATE
+		SET
+			title = EXCLUDED.title,
+			price = EXCLUDED.price,
+			description = EXCLUDED.description,
+			category = EXCLUDED.category,
+			image_url = EXCLUDED.image_url,
+			rating_rate = EXCLUDED.rating_rate,
+			rating_count = EXCLUDED.rating_count,
+			synced_at = EXCLUDED.synced_at,
+			removed_at = NULL
+	WHERE
+		products.removed_at IS NOT NULL
+		OR (products.title, products.price, products.description, products.category, products.image_url, products.rating_rate, products.rating_count)
+			IS DISTINCT FROM
+			(EXCLUDED.title, EXCLUDED.price, EXCLUDED.description, EXCLUDED.category, EXCLUDED.image_url, EXCLUDED.rating_rate, EXCLUDED.rating_count)
+	`
+
+	remove := `
+	UPDATE products
+		SET removed_at = $2
+	WHERE removed_at IS NULL AND NOT (id = ANY($1))
+	`
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer tx.Rollback() //nolint: errcheck
+
+	stmt, err := tx.PrepareContext(ctx, upsert)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer stmt.Close()
+
+	upserted := 0
+	ids := make([]int, 0, len(catalog))
+	for _, p := range catalog {
+		res, err := stmt.ExecContext(ctx, p.ID, p.Title, p.Price, p.Description, p.Category, p.ImageUrl, p.Rating.Rate, p.Rating.Count, at)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		n, err := res.RowsAffected()
+		if err != nil {
+			return 0, 0, err
+		}
+
+		upserted += int(n)
+		ids = append(ids, p.ID)
+	}
+
+	res, err := tx.ExecContext(ctx, remove, int4Array(ids), at)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	removed, err := res.RowsAffected()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return upserted, int(removed), tx.Commit()
+}
+
+func (r *repository) SaveSync(ctx context.Context, s product.Sync) error {
+	query := `
+	INSERT INTO product_syncs(
+		started_at, finished_at, upserted, removed, error
+	) VALUES (
+		$1, $2, $3, $4, $5
+	)
+	`
+
+	_, err := r.db.ExecContext(ctx, query, s.StartedAt, s.FinishedAt, s.Upserted, s.Removed, s.Error)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repository) LastSync(ctx context.Context) (product.Sync, error) {
+	query := `
+		SELECT
+			started_at, finished_at, upserted, removed, error
+		FROM product_syncs
+		ORDER BY started_at DESC
+		LIMIT 1
+	`
+
+	var s product.Sync
+	if err := r.db.QueryRowContext(ctx, query).Scan(
+		&s.StartedAt,
+		&s.FinishedAt,
+		&s.Upserted,
+		&s.Removed,
+		&s.Error,
+	); err != nil {
+		if err == sql.ErrNoRows {
+			return product.Sync{}, product.ErrSyncNotFound
+		}
+		return product.Sync{}, err
+	}
+
+	return s, nil
+}
+
+func (r *repository) query(ctx context.Context, query string, args ...any) ([]product.Product, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	pp := make([]product.Product, 0)
+	for rows.Next() {
+		p, err := scanProduct(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		pp = append(pp, p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return pp, nil
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanProduct(s scanner) (product.Product, error) {
+	var p product.Product
+	err := s.Scan(
+		&p.ID,
+		&p.Title,
+		&p.Price,
+		&p.Description,
+		&p.Category,
+		&p.ImageUrl,
+		&p.Rating.Rate,
+		&p.Rating.Count,
+	)
+
+	return p, err
+}
+
+func int4Array(ii []int) pgtype.Int4Array {
+	var arr pgtype.Int4Array
+	if ii == nil {
+		ii = []int{}
+	}
+
+	_ = arr.Set(ii)
+
+	return arr
+}
@@ -0,0 +1,138 @@
+package postgres_test
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uesleicarvalhoo/aiqfome/internal/infra/database"
+	"github.com/uesleicarvalhoo/aiqfome/product"
+	"github.com/uesleicarvalhoo/aiqfome/product/fixture"
+	"github.com/uesleicarvalhoo/aiqfome/product/postgres"
+	"github.com/uesleicarvalhoo/aiqfome/test"
+)
+
+type TestSuitePostgresRepository struct {
+	suite.Suite
+	ctx       context.Context
+	db        *sql.DB
+	container *test.PostgresContainer
+	repo      product.Mirror
+}
+
+func TestProductRepository(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip()
+	}
+
+	suite.Run(t, new(TestSuitePostgresRepository))
+}
+
+func (s *TestSuitePostgresRepository) SetupTest() {
+	var err error
+
+	s.ctx = context.Background()
+
+	s.container, err = test.SetupPostgres(s.ctx)
+	if err != nil {
+		s.T().Fatalf("failed to setup postgres container: %s", err)
+		return
+	}
+
+	s.T().Cleanup(func() {
+		_ = s.container.Terminate(s.ctx)
+	})
+
+	db, err := database.NewPostgresWithMigration(
+		database.Options{
+			User:              s.container.Username,
+			Password:          s.container.Password,
+			Host:              s.container.Host,
+			Port:              strconv.Itoa(s.container.Port),
+			Name:              s.container.Database,
+			PoolSize:          10,
+			ConnMaxTTL:        0,
+			TimeoutSeconds:    10,
+			LockTimeoutMillis: 0,
+		},
+	)
+	if err != nil {
+		s.T().Fatalf("failed to connect to database: %s", err)
+		return
+	}
+
+	s.db = db
+	s.repo = postgres.NewRepository(s.db)
+}
+
+func (s *TestSuitePostgresRepository) TestSync() {
+	p1 := fixture.AnyProduct().WithID(1).Build()
+	p2 := fixture.AnyProduct().WithID(2).Build()
+	p3 := fixture.AnyProduct().WithID(3).Build()
+
+	s.T().Run("when the mirror is empty", func(t *testing.T) {
+		_, err := s.repo.Find(s.ctx, 1)
+		assert.ErrorAs(t, err, new(*product.ErrNotFound))
+
+		_, err = s.repo.LastSync(s.ctx)
+		assert.ErrorIs(t, err, product.ErrSyncNotFound)
+	})
+
+	s.T().Run("when the catalog is synced the products are created", func(t *testing.T) {
+		upserted, removed, err := s.repo.Sync(s.ctx, []product.Product{p1, p2, p3}, time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, 3, upserted)
+		assert.Equal(t, 0, removed)
+
+		found, err := s.repo.Find(s.ctx, 2)
+		require.NoError(t, err)
+		assert.Equal(t, p2, found)
+	})
+
+	s.T().Run("when synced again only the changes are counted and the missing products are removed", func(t *testing.T) {
+		p1.Title = "Novo título"
+
+		upserted, removed, err := s.repo.Sync(s.ctx, []product.Product{p1, p2}, time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, 1, upserted)
+		assert.Equal(t, 1, removed)
+
+		pp, err := s.repo.FindMultiple(s.ctx, []int{1, 3})
+		assert.ErrorAs(t, err, new(*product.ErrProductsNotFound))
+		require.Len(t, pp, 1)
+		assert.Equal(t, "Novo título", pp[0].Title)
+
+		all, err := s.repo.All(s.ctx)
+		require.NoError(t, err)
+		assert.Len(t, all, 2)
+	})
+
+	s.T().Run("when a removed product comes back it is restored", func(t *testing.T) {
+		upserted, removed, err := s.repo.Sync(s.ctx, []product.Product{p1, p2, p3}, time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, 1, upserted)
+		assert.Equal(t, 0, removed)
+
+		_, err = s.repo.Find(s.ctx, 3)
+		assert.NoError(t, err)
+	})
+
+	s.T().Run("when syncs are saved the last one is returned", func(t *testing.T) {
+		startedAt := time.Now().UTC().Truncate(time.Millisecond)
+		require.NoError(t, s.repo.SaveSync(s.ctx, product.Sync{StartedAt: startedAt.Add(-time.Hour), FinishedAt: startedAt.Add(-time.Hour), Error: "service down"}))
+		require.NoError(t, s.repo.SaveSync(s.ctx, product.Sync{StartedAt: startedAt, FinishedAt: startedAt.Add(time.Second), Upserted: 3}))
+
+		last, err := s.repo.LastSync(s.ctx)
+		require.NoError(t, err)
+		assert.True(t, startedAt.Equal(last.StartedAt))
+		assert.Equal(t, 3, last.Upserted)
+		assert.False(t, last.Failed())
+	})
+}
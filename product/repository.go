@@ -1,12 +1,30 @@
 package product
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type Reader interface {
 	Find(ctx context.Context, id int) (Product, error)
+	// FindMultiple returns the products found even when some of them are missing,
+	// in that case the missing ids are reported through ErrProductsNotFound
 	FindMultiple(ctx context.Context, ids []int) ([]Product, error)
+	// All returns the whole catalog
+	All(ctx context.Context) ([]Product, error)
 }
 
 type Repository interface {
 	Reader
 }
+
+// Mirror is a local copy of the catalog of another Reader, kept up to date by Sync
+type Mirror interface {
+	Repository
+	// Sync upserts the products of the catalog and marks the ones missing from it as removed, in a single transaction.
+	// It returns how many products were created or changed and how many were removed
+	Sync(ctx context.Context, catalog []Product, at time.Time) (int, int, error)
+	SaveSync(ctx context.Context, s Sync) error
+	// LastSync fails with ErrSyncNotFound when the mirror was never synced
+	LastSync(ctx context.Context) (Sync, error)
+}
@@ -0,0 +1,16 @@
+package product
+
+import "time"
+
+// Sync is a run of the mirror sync, Error is set when the catalog couldn't be pulled or saved
+type Sync struct {
+	StartedAt  time.Time
+	FinishedAt time.Time
+	Upserted   int
+	Removed    int
+	Error      string
+}
+
+func (s Sync) Failed() bool {
+	return s.Error != ""
+}
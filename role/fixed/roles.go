@@ -22,6 +22,10 @@ func NewRepository() role.Repository {
 					Resource: role.ResourceFavorites,
 					Action:   role.ActionManage,
 				},
+				{
+					Resource: role.ResourceProducts,
+					Action:   role.ActionManage,
+				},
 			},
 			role.RoleClient: {
 				{
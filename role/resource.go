@@ -6,11 +6,12 @@ const (
 	ResourceMe        Resource = "me"
 	ResourceClient    Resource = "client"
 	ResourceFavorites Resource = "favorite"
+	ResourceProducts  Resource = "product"
 )
 
 func (r Resource) IsValid() bool {
 	switch r {
-	case ResourceClient, ResourceFavorites, ResourceMe:
+	case ResourceClient, ResourceFavorites, ResourceMe, ResourceProducts:
 		return true
 	default:
 		return false
@@ -0,0 +1,13 @@
+package test
+
+import (
+	"github.com/stretchr/testify/mock"
+	"github.com/uesleicarvalhoo/aiqfome/event"
+)
+
+// MatchEvent matches an event of the given type on mock expectations
+func MatchEvent(t event.Type) any {
+	return mock.MatchedBy(func(e event.Event) bool {
+		return e.Type == t
+	})
+}
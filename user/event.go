@@ -0,0 +1,14 @@
+package user
+
+import "github.com/uesleicarvalhoo/aiqfome/event"
+
+const (
+	EventSignedUp event.Type = "ClientSignedUp"
+	EventUpdated  event.Type = "ClientUpdated"
+	EventDeleted  event.Type = "ClientDeleted"
+)
+
+// Event returns an event of the given type with the user as payload, the password hash is never encoded
+func (u User) Event(t event.Type) event.Event {
+	return event.New(t, u.ID.String(), u)
+}
@@ -6,6 +6,8 @@ import (
 	context "context"
 
 	mock "github.com/stretchr/testify/mock"
+	event "github.com/uesleicarvalhoo/aiqfome/event"
+
 	user "github.com/uesleicarvalhoo/aiqfome/user"
 )
 
@@ -14,17 +16,24 @@ type Writer struct {
 	mock.Mock
 }
 
-// Create provides a mock function with given fields: ctx, c
-func (_m *Writer) Create(ctx context.Context, c user.User) error {
-	ret := _m.Called(ctx, c)
+// Create provides a mock function with given fields: ctx, c, ee
+func (_m *Writer) Create(ctx context.Context, c user.User, ee ...event.Event) error {
+	_va := make([]interface{}, len(ee))
+	for _i := range ee {
+		_va[_i] = ee[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, c)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, user.User) error); ok {
-		r0 = rf(ctx, c)
+	if rf, ok := ret.Get(0).(func(context.Context, user.User, ...event.Event) error); ok {
+		r0 = rf(ctx, c, ee...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -32,17 +41,24 @@ func (_m *Writer) Create(ctx context.Context, c user.User) error {
 	return r0
 }
 
-// Delete provides a mock function with given fields: ctx, c
-func (_m *Writer) Delete(ctx context.Context, c user.User) error {
-	ret := _m.Called(ctx, c)
+// Delete provides a mock function with given fields: ctx, c, ee
+func (_m *Writer) Delete(ctx context.Context, c user.User, ee ...event.Event) error {
+	_va := make([]interface{}, len(ee))
+	for _i := range ee {
+		_va[_i] = ee[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, c)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Delete")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, user.User) error); ok {
-		r0 = rf(ctx, c)
+	if rf, ok := ret.Get(0).(func(context.Context, user.User, ...event.Event) error); ok {
+		r0 = rf(ctx, c, ee...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -50,17 +66,24 @@ func (_m *Writer) Delete(ctx context.Context, c user.User) error {
 	return r0
 }
 
-// Update provides a mock function with given fields: ctx, c
-func (_m *Writer) Update(ctx context.Context, c user.User) error {
-	ret := _m.Called(ctx, c)
+// Update provides a mock function with given fields: ctx, c, ee
+func (_m *Writer) Update(ctx context.Context, c user.User, ee ...event.Event) error {
+	_va := make([]interface{}, len(ee))
+	for _i := range ee {
+		_va[_i] = ee[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, c)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Update")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, user.User) error); ok {
-		r0 = rf(ctx, c)
+	if rf, ok := ret.Get(0).(func(context.Context, user.User, ...event.Event) error); ok {
+		r0 = rf(ctx, c, ee...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -5,6 +5,8 @@ import (
 	"database/sql"
 	"time"
 
+	"github.com/uesleicarvalhoo/aiqfome/event"
+	eventPostgres "github.com/uesleicarvalhoo/aiqfome/event/postgres"
 	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
 	"github.com/uesleicarvalhoo/aiqfome/user"
 )
@@ -120,7 +122,7 @@ func (r *repository) Paginate(ctx context.Context, page, pageSize int) ([]user.U
 	return uu, total, nil
 }
 
-func (r *repository) Create(ctx context.Context, c user.User) error {
+func (r *repository) Create(ctx context.Context, c user.User, ee ...event.Event) error {
 	query := `
 		INSERT INTO users (
 			id, name, email, password_hash, role, active, created_at
@@ -129,35 +131,39 @@ func (r *repository) Create(ctx context.Context, c user.User) error {
 		)
 	`
 
-	_, err := r.db.ExecContext(ctx, query, c.ID, c.Name, c.Email, c.PasswordHash, c.Role, c.Active, c.CreatedAt)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return r.exec(ctx, ee, query, c.ID, c.Name, c.Email, c.PasswordHash, c.Role, c.Active, c.CreatedAt)
 }
 
-func (r *repository) Delete(ctx context.Context, u user.User) error {
-	query := `DELETE FROM clientes WHERE id = $1`
-
-	_, err := r.db.ExecContext(ctx, query, u.ID)
-	if err != nil {
-		return err
-	}
+func (r *repository) Delete(ctx context.Context, u user.User, ee ...event.Event) error {
+	query := `DELETE FROM users WHERE id = $1`
 
-	return nil
+	return r.exec(ctx, ee, query, u.ID)
 }
 
-func (r *repository) Update(ctx context.Context, u user.User) error {
+func (r *repository) Update(ctx context.Context, u user.User, ee ...event.Event) error {
 	query := `UPDATE users
 		SET name = $2, email = $3, password_hash = $4, role = $5, active = $6, updated_at = $7
 		WHERE id = $1
 	`
 
-	_, err := r.db.ExecContext(ctx, query, u.ID, u.Name, u.Email, u.PasswordHash, u.Role, u.Active, time.Now())
+	return r.exec(ctx, ee, query, u.ID, u.Name, u.Email, u.PasswordHash, u.Role, u.Active, time.Now())
+}
+
+// exec runs the query and writes the events to the outbox in a single transaction
+func (r *repository) exec(ctx context.Context, ee []event.Event, query string, args ...any) error {
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
+	defer tx.Rollback() //nolint: errcheck
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return err
+	}
+
+	if err := eventPostgres.Save(ctx, tx, ee); err != nil {
+		return err
+	}
 
-	return nil
+	return tx.Commit()
 }
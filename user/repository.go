@@ -3,6 +3,7 @@ package user
 import (
 	"context"
 
+	"github.com/uesleicarvalhoo/aiqfome/event"
 	"github.com/uesleicarvalhoo/aiqfome/pkg/uuid"
 )
 
@@ -12,10 +13,11 @@ type Reader interface {
 	Paginate(ctx context.Context, page, pageSize int) ([]User, int, error)
 }
 
+// Writer writes the events to the outbox in the same transaction of the change
 type Writer interface {
-	Create(ctx context.Context, c User) error
-	Delete(ctx context.Context, c User) error
-	Update(ctx context.Context, c User) error
+	Create(ctx context.Context, c User, ee ...event.Event) error
+	Delete(ctx context.Context, c User, ee ...event.Event) error
+	Update(ctx context.Context, c User, ee ...event.Event) error
 }
 
 type Repository interface {